@@ -85,6 +85,8 @@ var (
 		{Account: nft.ModuleName},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
+		{Account: posmoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
+		{Account: posmoduletypes.FeeEscrowName},
 	}
 
 	// blocked account addresses
@@ -95,6 +97,8 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		posmoduletypes.ModuleName,
+		posmoduletypes.FeeEscrowName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,9 +27,26 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryParams(),
 		CmdQueryRecord(),
+		CmdQueryRecordVersions(),
 		CmdQueryRecords(),
 		CmdQueryValidatorRecords(),
+		CmdQueryRecordVotes(),
+		CmdQueryRecordCommits(),
+		CmdQueryRecordChunkProof(),
+		CmdQueryAvailabilityChallenges(),
+		CmdQueryRecordDisputes(),
+		CmdQueryRecordTypes(),
+		CmdQueryRecordUpload(),
+		CmdQueryEpochs(),
+		CmdQueryEpochSummary(),
+		CmdQueryEpochSummaries(),
+		CmdQueryValidatorEpochSummaries(),
+		CmdQueryRewardPool(),
+		CmdQueryRewardPayouts(),
 		CmdQueryValidatorStats(),
+		CmdQueryRecordSigningInfo(),
+		CmdQueryValidatorSlashes(),
+		CmdQuerySlashes(),
 	)
 
 	return cmd
@@ -74,8 +93,49 @@ func CmdQueryRecord() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			decode, err := cmd.Flags().GetBool(flagDecode)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Record(context.Background(), &types.QueryRecordRequest{
-				Id: args[0],
+				Id:     args[0],
+				Decode: decode,
+			})
+			if err != nil {
+				return err
+			}
+
+			// Print the decoded payload as is, e.g. to pipe it into a file
+			if decode {
+				return clientCtx.PrintBytes(res.DecodedData)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagDecode, false, "Print the decoded record data instead of the record")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryRecordVersions implements the record-versions query command
+func CmdQueryRecordVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-versions [record-id]",
+		Short: "Query every version of a record, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecordVersions(context.Background(), &types.QueryRecordVersionsRequest{
+				RecordId: args[0],
 			})
 			if err != nil {
 				return err
@@ -94,16 +154,40 @@ func CmdQueryRecords() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "records",
 		Short: "Query all records",
-		Args:  cobra.NoArgs,
+		Long: `Query all records, one page at a time.
+Results can be narrowed by status, epoch range and block-height range.
+
+Example:
+  posd query pos records --status verified --min-epoch 10 --max-epoch 20 --limit 50`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, err := readRecordFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{})
+			res, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+				Pagination:     pageReq,
+				Status:         filter.status,
+				HasEpoch:       filter.hasEpoch,
+				MinEpoch:       filter.minEpoch,
+				MaxEpoch:       filter.maxEpoch,
+				HasBlockHeight: filter.hasHeight,
+				MinBlockHeight: filter.minHeight,
+				MaxBlockHeight: filter.maxHeight,
+			})
 			if err != nil {
 				return err
 			}
@@ -112,7 +196,9 @@ func CmdQueryRecords() *cobra.Command {
 		},
 	}
 
+	addRecordFilterFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
 	return cmd
 }
 
@@ -128,10 +214,499 @@ func CmdQueryValidatorRecords() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			filter, err := readRecordFilterFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.ValidatorRecords(context.Background(), &types.QueryValidatorRecordsRequest{
 				ValidatorAddress: args[0],
+				Pagination:       pageReq,
+				Status:           filter.status,
+				HasEpoch:         filter.hasEpoch,
+				MinEpoch:         filter.minEpoch,
+				MaxEpoch:         filter.maxEpoch,
+				HasBlockHeight:   filter.hasHeight,
+				MinBlockHeight:   filter.minHeight,
+				MaxBlockHeight:   filter.maxHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addRecordFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-records")
+	return cmd
+}
+
+// CmdQueryRecordVotes implements the record-votes query command
+func CmdQueryRecordVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-votes [record-id] [verifier]",
+		Short: "Query the verifier votes cast on a record, or a single verifier's vote",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 2 {
+				res, err := queryClient.RecordVote(context.Background(), &types.QueryRecordVoteRequest{
+					RecordId: args[0],
+					Verifier: args[1],
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecordVotes(context.Background(), &types.QueryRecordVotesRequest{
+				RecordId:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record-votes")
+	return cmd
+}
+
+// CmdQueryRecordCommits implements the record-commits query command
+func CmdQueryRecordCommits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-commits [record-id]",
+		Short: "Query the open commit-reveal verification commits on a record",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecordCommits(context.Background(), &types.QueryRecordCommitsRequest{
+				RecordId:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record-commits")
+	return cmd
+}
+
+// CmdQueryRecordChunkProof implements the record-chunk-proof query command
+func CmdQueryRecordChunkProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-chunk-proof [record-id] [chunk-index]",
+		Short: "Query a chunk of a record with its merkle inclusion proof",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chunk index: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecordChunkProof(context.Background(), &types.QueryRecordChunkProofRequest{
+				RecordId: args[0],
+				Index:    index,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryAvailabilityChallenges implements the availability-challenges query command
+func CmdQueryAvailabilityChallenges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "availability-challenges [challenge-id]",
+		Short: "Query data availability challenges, or a single challenge by id",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				id, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid challenge id: %w", err)
+				}
+
+				res, err := queryClient.AvailabilityChallenge(context.Background(), &types.QueryAvailabilityChallengeRequest{Id: id})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			recordID, err := cmd.Flags().GetString(flagRecordID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AvailabilityChallenges(context.Background(), &types.QueryAvailabilityChallengesRequest{
+				RecordId:   recordID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagRecordID, "", "Only return challenges of this record")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "availability-challenges")
+	return cmd
+}
+
+// CmdQueryRecordDisputes implements the record-disputes query command
+func CmdQueryRecordDisputes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-disputes [record-id]",
+		Short: "Query record disputes, or the dispute filed against a record",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.RecordDispute(context.Background(), &types.QueryRecordDisputeRequest{RecordId: args[0]})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRecordDisputesRequest{Pagination: pageReq}
+
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			if status != "" {
+				if req.Status, err = types.ParseDisputeStatus(status); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.RecordDisputes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "Only return disputes with this status (open, upheld, dismissed, voided)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record-disputes")
+	return cmd
+}
+
+// CmdQueryRecordTypes implements the record-types query command
+func CmdQueryRecordTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-types [record-type-id]",
+		Short: "Query registered record types, or a record type by id",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.RecordType(context.Background(), &types.QueryRecordTypeRequest{Id: args[0]})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecordTypes(context.Background(), &types.QueryRecordTypesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record-types")
+	return cmd
+}
+
+// CmdQueryRecordUpload implements the record-upload query command
+func CmdQueryRecordUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-upload [upload-id]",
+		Short: "Query an open chunked record upload",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upload id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecordUpload(context.Background(), &types.QueryRecordUploadRequest{Id: uploadID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryEpochs implements the epochs query command
+func CmdQueryEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Short: "Query the current epoch, or the epoch history with --all",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+
+			if !all {
+				res, err := queryClient.CurrentEpoch(context.Background(), &types.QueryCurrentEpochRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(&res.Epoch)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Epochs(context.Background(), &types.QueryEpochsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Query the current and past epochs")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epochs")
+	return cmd
+}
+
+// CmdQueryEpochSummary implements the epoch-summary query command
+func CmdQueryEpochSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-summary [epoch]",
+		Short: "Query the summary of an ended epoch and of its validators",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid epoch: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochSummary(context.Background(), &types.QueryEpochSummaryRequest{
+				Epoch:      epoch,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-summary")
+	return cmd
+}
+
+// CmdQueryEpochSummaries implements the epoch-summaries query command
+func CmdQueryEpochSummaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-summaries [start-epoch] [end-epoch]",
+		Short: "Query the summaries of a range of ended epochs",
+		Long: fmt.Sprintf(`Query the summaries of the ended epochs from start-epoch to end-epoch, both inclusive.
+The range spans at most %d epochs.`, types.MaxEpochSummaryRange),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start epoch: %w", err)
+			}
+			endEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end epoch: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochSummaries(context.Background(), &types.QueryEpochSummariesRequest{
+				StartEpoch: startEpoch,
+				EndEpoch:   endEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryValidatorEpochSummaries implements the validator-epoch-summaries query command
+func CmdQueryValidatorEpochSummaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-epoch-summaries [validator-address]",
+		Short: "Query the epoch history of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorEpochSummaries(context.Background(), &types.QueryValidatorEpochSummariesRequest{
+				ValidatorAddress: args[0],
+				Pagination:       pageReq,
 			})
 			if err != nil {
 				return err
@@ -142,6 +717,72 @@ func CmdQueryValidatorRecords() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-epoch-summaries")
+	return cmd
+}
+
+// CmdQueryRewardPool implements the reward-pool query command
+func CmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool",
+		Short: "Query the reward pool and the payouts pending at the end of the current epoch",
+		Long: `Query the reward pool and the payouts pending at the end of the current epoch.
+Pending payouts assume every validator with verified records in the epoch stays eligible.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(context.Background(), &types.QueryRewardPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryRewardPayouts implements the reward-payouts query command
+func CmdQueryRewardPayouts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-payouts [validator-address]",
+		Short: "Query the past reward payouts of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPayouts(context.Background(), &types.QueryRewardPayoutsRequest{
+				ValidatorAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-payouts")
 	return cmd
 }
 
@@ -173,3 +814,169 @@ func CmdQueryValidatorStats() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryRecordSigningInfo implements the record-signing-info query command
+func CmdQueryRecordSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-signing-info [validator-address]",
+		Short: "Query the epochs a validator submitted records in over the signing window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecordSigningInfo(context.Background(), &types.QueryRecordSigningInfoRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryValidatorSlashes implements the validator-slashes query command
+func CmdQueryValidatorSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-slashes [validator-address]",
+		Short: "Query the slash history of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorSlashes(context.Background(), &types.QueryValidatorSlashesRequest{
+				ValidatorAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-slashes")
+	return cmd
+}
+
+// CmdQuerySlashes implements the slashes query command
+func CmdQuerySlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes [min-height] [max-height]",
+		Short: "Query the slashes applied in a range of block heights",
+		Long: fmt.Sprintf(`Query the slashes applied from min-height to max-height, both inclusive.
+The range spans at most %d blocks.`, types.MaxSlashHeightRange),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid min height: %w", err)
+			}
+			maxHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Slashes(context.Background(), &types.QuerySlashesRequest{
+				MinHeight: minHeight,
+				MaxHeight: maxHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagStatus         = "status"
+	flagAll            = "all"
+	flagMinEpoch       = "min-epoch"
+	flagMaxEpoch       = "max-epoch"
+	flagMinBlockHeight = "min-block-height"
+	flagMaxBlockHeight = "max-block-height"
+	flagRecordID       = "record-id"
+	flagDecode         = "decode"
+)
+
+// recordFilterFlags holds the record list filters read from the command line
+type recordFilterFlags struct {
+	status    types.RecordStatus
+	hasEpoch  bool
+	minEpoch  uint64
+	maxEpoch  uint64
+	hasHeight bool
+	minHeight uint64
+	maxHeight uint64
+}
+
+// addRecordFilterFlags adds the record list filter flags to a query command
+func addRecordFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagStatus, "", "Only return records with this status (pending, verified, rejected, expired, superseded)")
+	cmd.Flags().Uint64(flagMinEpoch, 0, "Only return records submitted in or after this epoch")
+	cmd.Flags().Uint64(flagMaxEpoch, math.MaxUint64, "Only return records submitted in or before this epoch")
+	cmd.Flags().Uint64(flagMinBlockHeight, 0, "Only return records submitted at or after this height")
+	cmd.Flags().Uint64(flagMaxBlockHeight, math.MaxUint64, "Only return records submitted at or before this height")
+}
+
+// readRecordFilterFlags reads the record list filter flags from a query command
+func readRecordFilterFlags(cmd *cobra.Command) (recordFilterFlags, error) {
+	var (
+		filter recordFilterFlags
+		err    error
+	)
+
+	if statusStr, _ := cmd.Flags().GetString(flagStatus); statusStr != "" {
+		filter.status, err = types.ParseRecordStatus(statusStr)
+		if err != nil {
+			return filter, err
+		}
+	}
+
+	// A range applies once either of its bounds is given
+	if cmd.Flags().Changed(flagMinEpoch) || cmd.Flags().Changed(flagMaxEpoch) {
+		filter.hasEpoch = true
+		filter.minEpoch, _ = cmd.Flags().GetUint64(flagMinEpoch)
+		filter.maxEpoch, _ = cmd.Flags().GetUint64(flagMaxEpoch)
+	}
+	if cmd.Flags().Changed(flagMinBlockHeight) || cmd.Flags().Changed(flagMaxBlockHeight) {
+		filter.hasHeight = true
+		filter.minHeight, _ = cmd.Flags().GetUint64(flagMinBlockHeight)
+		filter.maxHeight, _ = cmd.Flags().GetUint64(flagMaxBlockHeight)
+	}
+
+	return filter, nil
+}
@@ -1,18 +1,29 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NeomSense/PoS/x/pos/offchain"
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
+const (
+	flagOffChainDir = "off-chain-dir"
+	flagRecordType  = "record-type"
+	flagEncoding    = "encoding"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,7 +36,18 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdSubmitRecord(),
+		CmdAmendRecord(),
 		CmdVerifyRecord(),
+		CmdCommitVerification(),
+		CmdRevealVerification(),
+		CmdChallengeAvailability(),
+		CmdRespondAvailabilityChallenge(),
+		CmdDisputeRecord(),
+		CmdBeginRecordUpload(),
+		CmdUploadRecordChunk(),
+		CmdFinalizeRecord(),
+		CmdFundRewardPool(),
+		CmdUnjailForRecords(),
 	)
 
 	return cmd
@@ -38,42 +60,158 @@ func CmdSubmitRecord() *cobra.Command {
 		Short: "Submit a new record as a validator",
 		Long: `Submit a new proof-of-record as a validator.
 The data-file should contain the record data, and merkle-root is the merkle root hash of the data.
+The data is split into 1024 byte chunks hashed into an RFC 6962 merkle tree. When merkle-root
+is omitted it is computed from the data file. With --record-type the data must satisfy the
+JSON schema of the registered record type. With --encoding the data is compressed before it is
+submitted and merkle-root must be computed over the compressed data. The record fee set in the
+module params is escrowed from the validator operator account and paid to the verifiers. When
+rejected records forfeit their bond, the record bond is escrowed too and returned unless the
+record is rejected.
 
 Example:
   posd tx pos submit-record ./my-record.json abc123def456... --from validator1`,
-		Args: cobra.ExactArgs(2),
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// Read data from file
-			dataFile := args[0]
-			data, err := os.ReadFile(dataFile)
+			var merkleRoot string
+			if len(args) == 2 {
+				merkleRoot = args[1]
+			}
+
+			// Get validator address from the from flag
+			msg, err := readRecordSubmission(cmd, clientCtx.GetFromAddress().String(), args[0], merkleRoot)
 			if err != nil {
-				return fmt.Errorf("failed to read data file: %w", err)
+				return err
 			}
 
-			merkleRoot := args[1]
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			// Get validator address from the from flag
-			validatorAddr := clientCtx.GetFromAddress().String()
+	addRecordSubmissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
-			msg := &types.MsgSubmitRecord{
-				ValidatorAddress: validatorAddr,
-				Data:             data,
-				MerkleRoot:       merkleRoot,
+// CmdAmendRecord implements the amend-record command
+func CmdAmendRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-record [record-id] [data-file] [merkle-root]",
+		Short: "Submit a new version of a pending or rejected record",
+		Long: `Submit the data in data-file as a new version of record-id. The new record supersedes the
+prior one and is verified on its own; it does not count against the per-epoch record limit.
+The data is submitted as with submit-record and takes the same flags.
+
+Example:
+  posd tx pos amend-record abc123 ./my-record-v2.json --from validator1`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var merkleRoot string
+			if len(args) == 3 {
+				merkleRoot = args[2]
+			}
+
+			submission, err := readRecordSubmission(cmd, clientCtx.GetFromAddress().String(), args[1], merkleRoot)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAmendRecord{
+				ValidatorAddress: submission.ValidatorAddress,
+				SupersedesId:     args[0],
+				Data:             submission.Data,
+				MerkleRoot:       submission.MerkleRoot,
+				DataLocator:      submission.DataLocator,
+				DataSize:         submission.DataSize,
+				RecordType:       submission.RecordType,
+				ContentEncoding:  submission.ContentEncoding,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addRecordSubmissionFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// addRecordSubmissionFlags adds the flags read by readRecordSubmission
+func addRecordSubmissionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagOffChainDir, "", "Store the data off chain in this directory and submit only its locator")
+	cmd.Flags().String(flagRecordType, "", "Registered record type the data conforms to")
+	cmd.Flags().String(flagEncoding, "", "Compress the data before submitting it (gzip, zstd)")
+}
+
+// readRecordSubmission builds the record submission of the data in dataFile,
+// encoding it or storing it off chain as requested by the command flags. The
+// merkle root is computed from the submitted data when merkleRoot is empty.
+func readRecordSubmission(cmd *cobra.Command, validatorAddr, dataFile, merkleRoot string) (*types.MsgSubmitRecord, error) {
+	data, err := os.ReadFile(dataFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data file: %w", err)
+	}
+
+	encodingFlag, err := cmd.Flags().GetString(flagEncoding)
+	if err != nil {
+		return nil, err
+	}
+	encoding, err := types.ParseContentEncoding(encodingFlag)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = types.EncodeRecordData(encoding, data); err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+
+	if merkleRoot == "" {
+		merkleRoot = types.RecordMerkleRoot(data)
+	}
+
+	recordType, err := cmd.Flags().GetString(flagRecordType)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgSubmitRecord{
+		ValidatorAddress: validatorAddr,
+		Data:             data,
+		MerkleRoot:       merkleRoot,
+		RecordType:       recordType,
+		ContentEncoding:  encoding,
+	}
+
+	// Keep the data off chain and only submit its locator
+	offChainDir, err := cmd.Flags().GetString(flagOffChainDir)
+	if err != nil {
+		return nil, err
+	}
+	if offChainDir != "" {
+		store, err := offchain.NewDirStore(offChainDir)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.DataLocator, err = store.Put(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store data off chain: %w", err)
+		}
+		msg.DataSize = uint64(len(data))
+		msg.Data = nil
+	}
+
+	return msg, nil
+}
+
 // CmdVerifyRecord implements the verify-record command
 func CmdVerifyRecord() *cobra.Command {
 	cmd := &cobra.Command{
@@ -109,3 +247,399 @@ Example:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdCommitVerification implements the commit-verification command
+func CmdCommitVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-verification [record-id] [approved] [salt-hex]",
+		Short: "Commit to a sealed verification vote on a record",
+		Long: `Commit to a verification vote on a record when commit-reveal voting is enabled.
+The vote is sealed with the hex encoded salt, which must be kept and passed to
+reveal-verification once the reveal phase opens.
+
+Example:
+  posd tx pos commit-verification abc123 true 5f3c9a... --from verifier1`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID := args[0]
+			approved := args[1] == "true"
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid salt: %w", err)
+			}
+
+			verifierAddr := sdk.ValAddress(clientCtx.GetFromAddress()).String()
+
+			msg := &types.MsgCommitVerification{
+				Verifier:   verifierAddr,
+				RecordId:   recordID,
+				Commitment: types.VerificationCommitment(recordID, verifierAddr, approved, salt),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevealVerification implements the reveal-verification command
+func CmdRevealVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-verification [record-id] [approved] [salt-hex]",
+		Short: "Reveal a previously committed verification vote on a record",
+		Long: `Reveal a verification vote committed with commit-verification.
+The approved value and salt must match the ones used for the commit.
+
+Example:
+  posd tx pos reveal-verification abc123 true 5f3c9a... --from verifier1`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid salt: %w", err)
+			}
+
+			msg := &types.MsgRevealVerification{
+				Verifier: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				RecordId: args[0],
+				Approved: args[1] == "true",
+				Salt:     salt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdChallengeAvailability implements the challenge-availability command
+func CmdChallengeAvailability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-availability [record-id]",
+		Short: "Challenge the data availability of an off-chain record",
+		Long: `Challenge the submitter of an off-chain record to prove its data is still available.
+The submitter must answer before the challenge deadline or be slashed.
+
+Example:
+  posd tx pos challenge-availability abc123 --from verifier1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgChallengeAvailability{
+				Challenger: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				RecordId:   args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRespondAvailabilityChallenge implements the respond-availability-challenge command
+func CmdRespondAvailabilityChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "respond-availability-challenge [challenge-id] [data-file]",
+		Short: "Answer an availability challenge with a proof of the challenged chunk",
+		Long: `Answer an availability challenge on one of your off-chain records.
+The challenged chunk and its merkle proof are computed from the data file.
+
+Example:
+  posd tx pos respond-availability-challenge 7 ./my-record.json --from validator1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			challengeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid challenge id: %w", err)
+			}
+
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read data file: %w", err)
+			}
+
+			res, err := types.NewQueryClient(clientCtx).AvailabilityChallenge(
+				context.Background(),
+				&types.QueryAvailabilityChallengeRequest{Id: challengeID},
+			)
+			if err != nil {
+				return err
+			}
+
+			chunk, proof, err := types.RecordChunkProof(data, res.Challenge.ChunkIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRespondAvailabilityChallenge{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				ChallengeId:      challengeID,
+				Chunk:            chunk,
+				Proof:            &proof,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDisputeRecord implements the dispute-record command
+func CmdDisputeRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-record [record-id] [reason]",
+		Short: "Dispute a record under optimistic verification",
+		Long: `Dispute a record before its dispute window ends so that it is settled by a verifier vote
+instead of being verified automatically. The dispute bond set in the module params is escrowed;
+it is returned if the record is rejected and burned if the record is verified.
+
+Example:
+  posd tx pos dispute-record abc123 "merkle root does not match published data" --from alice`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDisputeRecord{
+				Disputer: clientCtx.GetFromAddress().String(),
+				RecordId: args[0],
+			}
+			if len(args) == 2 {
+				msg.Reason = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFundRewardPool implements the fund-reward-pool command
+func CmdFundRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-pool [amount]",
+		Short: "Deposit coins into the reward pool paid out to validators",
+		Long: `Deposit coins into the reward pool. As each epoch ends, the pool is paid out to the
+validators found eligible, in proportion to the records they got verified in the epoch.
+
+Example:
+  posd tx pos fund-reward-pool 1000000stake --from alice`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := &types.MsgFundRewardPool{
+				Depositor: clientCtx.GetFromAddress().String(),
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUnjailForRecords implements the unjail-for-records command
+func CmdUnjailForRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-for-records",
+		Short: "Unjail a validator jailed for missing its record requirement",
+		Long: `Unjail the validator of the signer after it was jailed for missing its record requirement.
+The validator must have been jailed for at least unjail_min_jail_seconds and got
+unjail_verified_records records verified since; it can keep submitting records while jailed.
+
+Example:
+  posd tx pos unjail-for-records --from validator`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnjailForRecords{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdBeginRecordUpload implements the begin-record-upload command
+func CmdBeginRecordUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "begin-record-upload [data-file]",
+		Short: "Open a chunked upload of a record too large for a single transaction",
+		Long: `Open a chunked upload of the record in data-file. The merkle root and size of the upload
+are computed from the file, which is uploaded as is: with --encoding the file must already be
+compressed. Upload the file in order with upload-record-chunk, then submit it with finalize-record.
+
+Example:
+  posd tx pos begin-record-upload ./large-record.json --from validator1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read data file: %w", err)
+			}
+
+			recordType, err := cmd.Flags().GetString(flagRecordType)
+			if err != nil {
+				return err
+			}
+
+			encodingFlag, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+			encoding, err := types.ParseContentEncoding(encodingFlag)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBeginRecordUpload{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				MerkleRoot:       types.RecordMerkleRoot(data),
+				TotalSize:        uint64(len(data)),
+				RecordType:       recordType,
+				ContentEncoding:  encoding,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecordType, "", "Registered record type the data conforms to")
+	cmd.Flags().String(flagEncoding, "", "Compression the data file is already encoded with (gzip, zstd)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUploadRecordChunk implements the upload-record-chunk command
+func CmdUploadRecordChunk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-record-chunk [upload-id] [index] [chunk-file]",
+		Short: "Upload the next chunk of an open record upload",
+		Long: `Upload the contents of chunk-file as chunk index of an open record upload.
+Chunks must be uploaded in order starting at index 0.
+
+Example:
+  split -b 200000 -d large-record.json chunk-
+  posd tx pos upload-record-chunk 1 0 ./chunk-00 --from validator1`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upload id: %w", err)
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chunk index: %w", err)
+			}
+
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to read chunk file: %w", err)
+			}
+
+			msg := &types.MsgUploadRecordChunk{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				UploadId:         uploadID,
+				Index:            index,
+				Data:             data,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFinalizeRecord implements the finalize-record command
+func CmdFinalizeRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-record [upload-id]",
+		Short: "Submit the record assembled by a complete upload",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uploadID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid upload id: %w", err)
+			}
+
+			msg := &types.MsgFinalizeRecord{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				UploadId:         uploadID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// ChallengeAvailability opens a data availability challenge on a pending or
+// verified off-chain record. The submitter must answer with the record data or
+// a proof of a chunk picked from the block header hash before the challenge
+// deadline. The challenge bond is escrowed in the module account until the
+// challenge is closed.
+func (k Keeper) ChallengeAvailability(ctx context.Context, recordID, challenger string) (types.AvailabilityChallenge, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	if !record.IsOffChain() {
+		return types.AvailabilityChallenge{}, types.ErrRecordDataOnChain.Wrapf("record %s", recordID)
+	}
+
+	// Rejected, expired and superseded records hold no data worth keeping
+	if record.Status != types.RecordStatusPending && record.Status != types.RecordStatusVerified {
+		return types.AvailabilityChallenge{}, types.ErrRecordNotChallengeable.Wrapf("record %s is %s", recordID, record.Status)
+	}
+
+	if challenger == record.ValidatorAddress {
+		return types.AvailabilityChallenge{}, types.ErrSelfChallenge.Wrapf("validator %s submitted record %s", challenger, recordID)
+	}
+
+	if has, err := k.OpenChallenges.Has(ctx, recordID); err != nil {
+		return types.AvailabilityChallenge{}, err
+	} else if has {
+		return types.AvailabilityChallenge{}, types.ErrChallengeOpen.Wrapf("record %s", recordID)
+	}
+
+	challengerAddr, err := sdk.ValAddressFromBech32(challenger)
+	if err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	// Escrow the bond until the challenge is closed
+	if params.ChallengeBond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			sdk.AccAddress(challengerAddr),
+			types.ModuleName,
+			sdk.NewCoins(params.ChallengeBond),
+		); err != nil {
+			return types.AvailabilityChallenge{}, err
+		}
+	}
+
+	id, err := k.AvailabilityChallengeSeq.Next(ctx)
+	if err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	challenge := types.AvailabilityChallenge{
+		Id:             id,
+		RecordId:       recordID,
+		Challenger:     challenger,
+		ChunkIndex:     challengeChunkIndex(recordID, id, sdkCtx.HeaderHash(), record.ChunkCount()),
+		BlockHeight:    height,
+		DeadlineHeight: height + params.AvailabilityChallengePeriodBlocks,
+		Status:         types.ChallengeStatusOpen,
+		Bond:           params.ChallengeBond,
+	}
+
+	if err := k.AvailabilityChallenges.Set(ctx, id, challenge); err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	if err := k.OpenChallenges.Set(ctx, recordID, id); err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	if err := k.ChallengeQueue.Set(ctx, collections.Join(challenge.DeadlineHeight, id)); err != nil {
+		return types.AvailabilityChallenge{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChallenge,
+			sdk.NewAttribute(types.AttributeKeyChallengeID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyChallenger, challenger),
+			sdk.NewAttribute(types.AttributeKeyChunkIndex, fmt.Sprintf("%d", challenge.ChunkIndex)),
+			sdk.NewAttribute("deadline_height", fmt.Sprintf("%d", challenge.DeadlineHeight)),
+			sdk.NewAttribute(types.AttributeKeyBond, challenge.Bond.String()),
+		),
+	)
+
+	return challenge, nil
+}
+
+// RespondAvailabilityChallenge answers an open availability challenge. The
+// response is either the full record data or the challenged chunk together
+// with its inclusion proof under the record's merkle root.
+func (k Keeper) RespondAvailabilityChallenge(
+	ctx context.Context,
+	challengeID uint64,
+	validatorAddr string,
+	data []byte,
+	chunk []byte,
+	proof *types.MerkleProof,
+) error {
+	challenge, err := k.GetAvailabilityChallenge(ctx, challengeID)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if challenge.Status != types.ChallengeStatusOpen || height >= challenge.DeadlineHeight {
+		return types.ErrChallengeClosed.Wrapf("challenge %d", challengeID)
+	}
+
+	record, err := k.GetRecord(ctx, challenge.RecordId)
+	if err != nil {
+		return err
+	}
+
+	if validatorAddr != record.ValidatorAddress {
+		return types.ErrNotValidator.Wrapf("only the submitter of record %s can answer challenge %d", record.Id, challengeID)
+	}
+
+	if err := verifyChallengeResponse(record, challenge, data, chunk, proof); err != nil {
+		return err
+	}
+
+	challenge.Status = types.ChallengeStatusAnswered
+	if err := k.closeChallenge(ctx, challenge); err != nil {
+		return err
+	}
+
+	if err := k.settleChallengeBond(ctx, challenge); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChallengeAnswer,
+			sdk.NewAttribute(types.AttributeKeyChallengeID, fmt.Sprintf("%d", challengeID)),
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+		),
+	)
+
+	return nil
+}
+
+// ProcessChallengeQueue fails every open availability challenge whose deadline
+// is at or before the current height, returns the challenge bonds and slashes
+// the record submitters.
+func (k Keeper) ProcessChallengeQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[uint64, uint64]
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(height+1, uint64(0)))
+	err := k.ChallengeQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		challenge, err := k.GetAvailabilityChallenge(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		challenge.Status = types.ChallengeStatusFailed
+		if err := k.closeChallenge(ctx, challenge); err != nil {
+			return err
+		}
+
+		if err := k.settleChallengeBond(ctx, challenge); err != nil {
+			return err
+		}
+
+		record, err := k.GetRecord(ctx, challenge.RecordId)
+		if err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChallengeFailed,
+				sdk.NewAttribute(types.AttributeKeyChallengeID, fmt.Sprintf("%d", challenge.Id)),
+				sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+				sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			),
+		)
+
+		if err := k.SlashValidatorForUnavailableData(ctx, record.ValidatorAddress, record.Id); err != nil {
+			// Log error but keep processing the queue
+			sdkCtx.Logger().Error(
+				"failed to slash validator for unavailable data",
+				"validator", record.ValidatorAddress,
+				"record_id", record.Id,
+				"error", err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetAvailabilityChallenge returns an availability challenge by id
+func (k Keeper) GetAvailabilityChallenge(ctx context.Context, id uint64) (types.AvailabilityChallenge, error) {
+	challenge, err := k.AvailabilityChallenges.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.AvailabilityChallenge{}, types.ErrChallengeNotFound.Wrapf("challenge %d not found", id)
+		}
+		return types.AvailabilityChallenge{}, err
+	}
+	return challenge, nil
+}
+
+// closeChallenge stores a challenge that is no longer open and removes it from
+// the open challenges and the deadline queue
+func (k Keeper) closeChallenge(ctx context.Context, challenge types.AvailabilityChallenge) error {
+	if err := k.AvailabilityChallenges.Set(ctx, challenge.Id, challenge); err != nil {
+		return err
+	}
+
+	if err := k.OpenChallenges.Remove(ctx, challenge.RecordId); err != nil {
+		return err
+	}
+
+	return k.ChallengeQueue.Remove(ctx, collections.Join(challenge.DeadlineHeight, challenge.Id))
+}
+
+// settleChallengeBond settles the bond of a closed challenge. The challenger
+// gets the bond back when the submitter failed to answer and forfeits it when
+// the challenge was answered.
+func (k Keeper) settleChallengeBond(ctx context.Context, challenge types.AvailabilityChallenge) error {
+	bond := sdk.NewCoins(challenge.Bond)
+	if bond.IsZero() {
+		return nil
+	}
+
+	if challenge.Status == types.ChallengeStatusAnswered {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, bond)
+	}
+
+	challengerAddr, err := sdk.ValAddressFromBech32(challenge.Challenger)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(challengerAddr), bond)
+}
+
+// verifyChallengeResponse checks that a response proves the availability of
+// the challenged record data
+func verifyChallengeResponse(
+	record types.Record,
+	challenge types.AvailabilityChallenge,
+	data []byte,
+	chunk []byte,
+	proof *types.MerkleProof,
+) error {
+	if len(data) != 0 {
+		if uint64(len(data)) != record.DataSize {
+			return types.ErrInvalidChallengeProof.Wrapf("data size %d does not match record size %d", len(data), record.DataSize)
+		}
+		if err := types.ValidateRecordMerkleRoot(data, record.MerkleRoot); err != nil {
+			return types.ErrInvalidChallengeProof.Wrap(err.Error())
+		}
+		return nil
+	}
+
+	if proof == nil {
+		return types.ErrInvalidChallengeProof.Wrap("either data or a chunk proof is required")
+	}
+
+	if proof.Total != record.ChunkCount() || proof.Index != challenge.ChunkIndex {
+		return types.ErrInvalidChallengeProof.Wrapf(
+			"proof is for chunk %d of %d, expected chunk %d of %d",
+			proof.Index,
+			proof.Total,
+			challenge.ChunkIndex,
+			record.ChunkCount(),
+		)
+	}
+
+	if err := proof.Verify(record.MerkleRoot, chunk); err != nil {
+		return types.ErrInvalidChallengeProof.Wrap(err.Error())
+	}
+	return nil
+}
+
+// challengeChunkIndex picks the chunk a challenge asks for from the record id,
+// the challenge id and the block header hash, so the submitter cannot know it
+// in advance
+func challengeChunkIndex(recordID string, challengeID uint64, headerHash []byte, chunks uint64) uint64 {
+	if chunks == 0 {
+		return 0
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(recordID)
+	_ = binary.Write(&buf, binary.BigEndian, challengeID)
+	buf.Write(headerHash)
+
+	sum := sha256.Sum256(buf.Bytes())
+	return binary.BigEndian.Uint64(sum[:8]) % chunks
+}
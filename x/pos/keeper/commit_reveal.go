@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// CommitVerification stores a verifier's sealed vote on a pending record during
+// the record's commit phase and schedules the end of the reveal phase.
+func (k Keeper) CommitVerification(
+	ctx context.Context,
+	recordID string,
+	verifier stakingtypes.Validator,
+	commitment []byte,
+) (types.VerificationCommit, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.VerificationCommit{}, err
+	}
+
+	if !params.CommitRevealEnabled {
+		return types.VerificationCommit{}, types.ErrCommitRevealDisabled.Wrap("use MsgVerifyRecord")
+	}
+
+	if len(commitment) != sha256.Size {
+		return types.VerificationCommit{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"commitment must be %d bytes, got %d",
+			sha256.Size,
+			len(commitment),
+		)
+	}
+
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return types.VerificationCommit{}, err
+	}
+
+	if record.Status != types.RecordStatusPending {
+		return types.VerificationCommit{}, types.ErrRecordAlreadyVerified.Wrapf(
+			"record %s is already %s",
+			recordID,
+			record.Status.String(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	commitEnd := record.BlockHeight + params.CommitPeriodBlocks
+	if height >= commitEnd {
+		return types.VerificationCommit{}, types.ErrCommitPhaseClosed.Wrapf(
+			"commits for record %s closed at height %d",
+			recordID,
+			commitEnd,
+		)
+	}
+
+	verifierAddr := verifier.GetOperator()
+	if err := k.checkVerifierConflicts(ctx, record, verifierAddr); err != nil {
+		return types.VerificationCommit{}, err
+	}
+
+	key := collections.Join(recordID, verifierAddr)
+	if has, err := k.VerificationCommits.Has(ctx, key); err != nil {
+		return types.VerificationCommit{}, err
+	} else if has {
+		return types.VerificationCommit{}, types.ErrDuplicateVote.Wrapf(
+			"%s has already committed to a vote on record %s",
+			verifierAddr,
+			recordID,
+		)
+	}
+
+	commit := types.VerificationCommit{
+		RecordId:          recordID,
+		Verifier:          verifierAddr,
+		Commitment:        commitment,
+		Power:             verifier.GetBondedTokens(),
+		BlockHeight:       height,
+		RevealStartHeight: commitEnd,
+		RevealEndHeight:   commitEnd + params.RevealPeriodBlocks,
+	}
+
+	if err := k.VerificationCommits.Set(ctx, key, commit); err != nil {
+		return types.VerificationCommit{}, err
+	}
+
+	if err := k.RevealQueue.Set(ctx, collections.Join(commit.RevealEndHeight, recordID)); err != nil {
+		return types.VerificationCommit{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordCommit,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyVerifier, verifierAddr),
+			sdk.NewAttribute("reveal_start_height", fmt.Sprintf("%d", commit.RevealStartHeight)),
+			sdk.NewAttribute("reveal_end_height", fmt.Sprintf("%d", commit.RevealEndHeight)),
+		),
+	)
+
+	return commit, nil
+}
+
+// RevealVerification opens a committed vote during the reveal phase. The vote
+// is stored with the stake the verifier had when committing and is counted when
+// the reveal phase ends.
+func (k Keeper) RevealVerification(
+	ctx context.Context,
+	recordID string,
+	verifier string,
+	approved bool,
+	salt []byte,
+) error {
+	key := collections.Join(recordID, verifier)
+	commit, err := k.VerificationCommits.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrCommitNotFound.Wrapf("no commit from %s on record %s", verifier, recordID)
+		}
+		return err
+	}
+
+	if commit.Revealed {
+		return types.ErrDuplicateVote.Wrapf("%s has already revealed its vote on record %s", verifier, recordID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if height < commit.RevealStartHeight || height >= commit.RevealEndHeight {
+		return types.ErrRevealPhaseNotOpen.Wrapf(
+			"reveals for record %s are accepted at heights [%d, %d)",
+			recordID,
+			commit.RevealStartHeight,
+			commit.RevealEndHeight,
+		)
+	}
+
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	if record.Status != types.RecordStatusPending {
+		return types.ErrRecordAlreadyVerified.Wrapf(
+			"record %s is already %s",
+			recordID,
+			record.Status.String(),
+		)
+	}
+
+	if !bytes.Equal(types.VerificationCommitment(recordID, verifier, approved, salt), commit.Commitment) {
+		return types.ErrCommitmentMismatch.Wrapf("reveal from %s on record %s", verifier, recordID)
+	}
+
+	commit.Revealed = true
+	if err := k.VerificationCommits.Set(ctx, key, commit); err != nil {
+		return err
+	}
+
+	if err := k.castVote(ctx, recordID, verifier, commit.Power, approved); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordReveal,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyVerifier, verifier),
+			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", approved)),
+		),
+	)
+
+	return nil
+}
+
+// ProcessRevealQueue closes every reveal phase that ended at or before the
+// current height. Verifiers that committed on a still pending record without
+// revealing are slashed, the commits are pruned and the record is tallied from
+// the revealed votes only.
+func (k Keeper) ProcessRevealQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[uint64, string]
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(height+1, ""))
+	err := k.RevealQueue.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.RevealQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := k.closeRevealPhase(ctx, key.K2(), height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeRevealPhase settles the commits on a record whose reveal phase ended at
+// or before height and tallies the record once no open commits remain. Reveals
+// are refused once a record is no longer pending, so if the record was amended,
+// expired or verified in the meantime the commits are dropped without slashing.
+func (k Keeper) closeRevealPhase(ctx context.Context, recordID string, height uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil && !errors.Is(err, types.ErrRecordNotFound) {
+		return err
+	}
+	pending := err == nil && record.Status == types.RecordStatusPending
+
+	var (
+		ended     []types.VerificationCommit
+		remaining int
+	)
+	rng := collections.NewPrefixedPairRange[string, string](recordID)
+	err = k.VerificationCommits.Walk(ctx, rng, func(_ collections.Pair[string, string], commit types.VerificationCommit) (bool, error) {
+		if !pending || commit.RevealEndHeight <= height {
+			ended = append(ended, commit)
+		} else {
+			remaining++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range ended {
+		if pending && !commit.Revealed {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommitUnrevealed,
+					sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+					sdk.NewAttribute(types.AttributeKeyVerifier, commit.Verifier),
+				),
+			)
+
+			if err := k.SlashValidatorForUnrevealedCommit(ctx, commit.Verifier, recordID); err != nil {
+				sdkCtx.Logger().Error(
+					"failed to slash verifier for unrevealed commit",
+					"verifier", commit.Verifier,
+					"record_id", recordID,
+					"error", err,
+				)
+			}
+		}
+
+		if err := k.VerificationCommits.Remove(ctx, collections.Join(recordID, commit.Verifier)); err != nil {
+			return err
+		}
+	}
+
+	if !pending || remaining > 0 {
+		return nil
+	}
+
+	_, err = k.tallyRecord(ctx, record)
+	return err
+}
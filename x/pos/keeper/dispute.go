@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// DisputeRecord files a dispute against a record under optimistic verification
+// before its dispute window ends. The dispute bond is escrowed in the module
+// account and the record is no longer verified automatically; it is settled by
+// the verifier vote instead.
+func (k Keeper) DisputeRecord(ctx context.Context, recordID, disputer, reason string) (types.RecordDispute, error) {
+	disputerAddr, err := k.addressCodec.StringToBytes(disputer)
+	if err != nil {
+		return types.RecordDispute{}, fmt.Errorf("invalid disputer address: %w", err)
+	}
+
+	if len(reason) > types.MaxDisputeReasonLength {
+		return types.RecordDispute{}, types.ErrInvalidDisputeReason.Wrapf(
+			"reason is longer than %d bytes",
+			types.MaxDisputeReasonLength,
+		)
+	}
+
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return types.RecordDispute{}, err
+	}
+
+	if record.DisputeDeadlineHeight == 0 {
+		return types.RecordDispute{}, types.ErrNotOptimistic.Wrapf("record %s", recordID)
+	}
+
+	if record.Status != types.RecordStatusPending {
+		return types.RecordDispute{}, types.ErrRecordAlreadyVerified.Wrapf(
+			"record %s is already %s",
+			recordID,
+			record.Status.String(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if height >= record.DisputeDeadlineHeight {
+		return types.RecordDispute{}, types.ErrDisputeWindowClosed.Wrapf(
+			"record %s could be disputed until height %d",
+			recordID,
+			record.DisputeDeadlineHeight,
+		)
+	}
+
+	if has, err := k.Disputes.Has(ctx, recordID); err != nil {
+		return types.RecordDispute{}, err
+	} else if has {
+		return types.RecordDispute{}, types.ErrRecordDisputed.Wrapf("record %s", recordID)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.RecordDispute{}, err
+	}
+
+	// Escrow the bond until the record is settled
+	if params.DisputeBond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			disputerAddr,
+			types.ModuleName,
+			sdk.NewCoins(params.DisputeBond),
+		); err != nil {
+			return types.RecordDispute{}, err
+		}
+	}
+
+	dispute := types.RecordDispute{
+		RecordId:    recordID,
+		Disputer:    disputer,
+		Bond:        params.DisputeBond,
+		Reason:      reason,
+		BlockHeight: height,
+		Status:      types.DisputeStatusOpen,
+	}
+
+	if err := k.Disputes.Set(ctx, recordID, dispute); err != nil {
+		return types.RecordDispute{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordDisputed,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyDisputer, disputer),
+			sdk.NewAttribute(types.AttributeKeyBond, dispute.Bond.String()),
+		),
+	)
+
+	return dispute, nil
+}
+
+// GetRecordDispute returns the dispute filed against a record
+func (k Keeper) GetRecordDispute(ctx context.Context, recordID string) (types.RecordDispute, error) {
+	dispute, err := k.Disputes.Get(ctx, recordID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecordDispute{}, types.ErrDisputeNotFound.Wrapf("record %s has no dispute", recordID)
+		}
+		return types.RecordDispute{}, err
+	}
+	return dispute, nil
+}
+
+// settleDispute settles the open dispute on a record once the record is
+// finalized. The disputer gets the bond back when the record is rejected and
+// forfeits it when the record is verified.
+func (k Keeper) settleDispute(ctx context.Context, recordID string, approved bool) error {
+	dispute, err := k.Disputes.Get(ctx, recordID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if dispute.Status != types.DisputeStatusOpen {
+		return nil
+	}
+
+	bond := sdk.NewCoins(dispute.Bond)
+	if approved {
+		dispute.Status = types.DisputeStatusDismissed
+		if !bond.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, bond); err != nil {
+				return err
+			}
+		}
+	} else {
+		dispute.Status = types.DisputeStatusUpheld
+		if !bond.IsZero() {
+			disputerAddr, err := k.addressCodec.StringToBytes(dispute.Disputer)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, disputerAddr, bond); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := k.Disputes.Set(ctx, recordID, dispute); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyDisputer, dispute.Disputer),
+			sdk.NewAttribute(types.AttributeKeyStatus, dispute.Status.String()),
+		),
+	)
+
+	return nil
+}
+
+// voidDispute returns the bond of the open dispute on a record that expired
+// before it was settled
+func (k Keeper) voidDispute(ctx context.Context, recordID string) error {
+	dispute, err := k.Disputes.Get(ctx, recordID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if dispute.Status != types.DisputeStatusOpen {
+		return nil
+	}
+
+	if bond := sdk.NewCoins(dispute.Bond); !bond.IsZero() {
+		disputerAddr, err := k.addressCodec.StringToBytes(dispute.Disputer)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, disputerAddr, bond); err != nil {
+			return err
+		}
+	}
+
+	dispute.Status = types.DisputeStatusVoided
+	if err := k.Disputes.Set(ctx, recordID, dispute); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyDisputer, dispute.Disputer),
+			sdk.NewAttribute(types.AttributeKeyStatus, dispute.Status.String()),
+		),
+	)
+
+	return nil
+}
+
+// ProcessDisputeWindowQueue verifies the optimistic records whose dispute
+// window ended at or before the current height without being disputed.
+// Disputed records are left pending for the verifier vote.
+func (k Keeper) ProcessDisputeWindowQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[uint64, string]
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(height+1, ""))
+	err := k.DisputeWindowQueue.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.DisputeWindowQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		recordID := key.K2()
+		record, err := k.GetRecord(ctx, recordID)
+		if err != nil {
+			return err
+		}
+
+		if record.Status != types.RecordStatusPending {
+			continue
+		}
+
+		if disputed, err := k.Disputes.Has(ctx, recordID); err != nil {
+			return err
+		} else if disputed {
+			continue
+		}
+
+		if err := k.VerifyRecord(ctx, recordID, true); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoVerified,
+				sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+				sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// epochHooksHolder holds the hooks set with SetEpochHooks
+type epochHooksHolder struct {
+	hooks types.EpochHooks
+}
+
+// GetCurrentEpoch returns the current epoch. Until an epoch is stored the
+// chain is in epoch 0, started at genesis.
+func (k Keeper) GetCurrentEpoch(ctx context.Context) (types.EpochInfo, error) {
+	iter, err := k.Epochs.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.EpochInfo{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return types.EpochInfo{}, err
+		}
+		return types.NewEpochInfo(0, 0, 0, params), nil
+	}
+	return iter.Value()
+}
+
+// AdvanceEpoch ends the current epoch and starts the next one once the current
+// epoch is over, measured in blocks or block time as it was when the epoch
+// started; param changes apply from the next epoch. It runs at the start of
+// every block, so the first block of an epoch already belongs to it.
+func (k Keeper) AdvanceEpoch(ctx context.Context) error {
+	current, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if !current.HasEnded(height, sdkCtx.BlockTime().Unix()) {
+		return nil
+	}
+
+	if err := k.endEpoch(ctx, current); err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.startEpoch(ctx, types.NewEpochInfo(current.Number+1, height, sdkCtx.BlockTime().Unix(), params))
+}
+
+// endEpoch settles the per-epoch obligations and rewards of the ending epoch
+// and runs the BeforeEpochEnd hooks
+func (k Keeper) endEpoch(ctx context.Context, epoch types.EpochInfo) error {
+	// Validators are held to the records they submitted in the ending epoch
+	if err := k.CheckAllValidatorsEligibility(ctx); err != nil {
+		return err
+	}
+
+	// Eligible validators are paid for the records they got verified
+	rewardsPaid, err := k.distributeRewards(ctx, epoch)
+	if err != nil {
+		return err
+	}
+
+	if err := k.writeEpochSummary(ctx, epoch, rewardsPaid); err != nil {
+		return err
+	}
+
+	if hooks := k.epochHooks.hooks; hooks != nil {
+		if err := hooks.BeforeEpochEnd(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	// The implicit genesis epoch is only stored once it ends
+	if err := k.Epochs.Set(ctx, epoch.Number, epoch); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+			sdk.NewAttribute("start_height", fmt.Sprintf("%d", epoch.StartHeight)),
+		),
+	)
+
+	return nil
+}
+
+// startEpoch makes epoch the current epoch and runs the AfterEpochStart hooks
+func (k Keeper) startEpoch(ctx context.Context, epoch types.EpochInfo) error {
+	if err := k.Epochs.Set(ctx, epoch.Number, epoch); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+			sdk.NewAttribute("start_height", fmt.Sprintf("%d", epoch.StartHeight)),
+			sdk.NewAttribute("start_time", fmt.Sprintf("%d", epoch.StartTime)),
+			sdk.NewAttribute("mode", epoch.Mode.String()),
+		),
+	)
+
+	if hooks := k.epochHooks.hooks; hooks != nil {
+		return hooks.AfterEpochStart(ctx, epoch)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// ValidatorEpochSummaryIndexes defines the secondary indexes kept alongside
+// Keeper.ValidatorEpochSummaries
+type ValidatorEpochSummaryIndexes struct {
+	// ByValidator maps a validator address to the epochs it has a summary for
+	ByValidator *indexes.ReversePair[uint64, string, types.ValidatorEpochSummary]
+}
+
+// IndexesList implements collections.Indexes
+func (i ValidatorEpochSummaryIndexes) IndexesList() []collections.Index[collections.Pair[uint64, string], types.ValidatorEpochSummary] {
+	return []collections.Index[collections.Pair[uint64, string], types.ValidatorEpochSummary]{
+		i.ByValidator,
+	}
+}
+
+func newValidatorEpochSummaryIndexes(sb *collections.SchemaBuilder) ValidatorEpochSummaryIndexes {
+	return ValidatorEpochSummaryIndexes{
+		ByValidator: indexes.NewReversePair[types.ValidatorEpochSummary](
+			sb,
+			types.ValidatorEpochSummariesByValidatorKey,
+			"validator_epoch_summaries_by_validator",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+	}
+}
+
+// GetEpochSummary returns the summary of an ended epoch
+func (k Keeper) GetEpochSummary(ctx context.Context, epoch uint64) (types.EpochSummary, error) {
+	summary, err := k.EpochSummaries.Get(ctx, epoch)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.EpochSummary{}, types.ErrEpochSummaryNotFound.Wrapf("no summary for epoch %d", epoch)
+		}
+		return types.EpochSummary{}, err
+	}
+
+	return summary, nil
+}
+
+// updateEpochActivity applies update to what a validator did in the current epoch
+func (k Keeper) updateEpochActivity(ctx context.Context, validatorAddr string, update func(summary *types.ValidatorEpochSummary)) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(epoch.Number, validatorAddr)
+	summary, err := k.EpochActivity.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		summary = types.ValidatorEpochSummary{Epoch: epoch.Number, ValidatorAddress: validatorAddr}
+	} else if err != nil {
+		return err
+	}
+
+	update(&summary)
+	return k.EpochActivity.Set(ctx, key, summary)
+}
+
+// writeEpochSummary summarizes the activity of the ending epoch into the
+// summaries of the epoch and of its validators, with the rewards paid out as it ended
+func (k Keeper) writeEpochSummary(ctx context.Context, epoch types.EpochInfo, rewardsPaid sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	summary := types.EpochSummary{
+		Epoch:         epoch,
+		EndedHeight:   uint64(sdkCtx.BlockHeight()),
+		EndedTime:     sdkCtx.BlockTime().Unix(),
+		SlashedAmount: math.ZeroInt(),
+		RewardsPaid:   rewardsPaid,
+	}
+
+	// Collect the activity first so the store is not written while it is being iterated
+	var validators []types.ValidatorEpochSummary
+	rng := collections.NewPrefixedPairRange[uint64, string](epoch.Number)
+	err := k.EpochActivity.Walk(ctx, rng, func(_ collections.Pair[uint64, string], validator types.ValidatorEpochSummary) (bool, error) {
+		validators = append(validators, validator)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		summary.SubmittedRecords += validator.SubmittedRecords
+		summary.VerifiedRecords += validator.VerifiedRecords
+		summary.RejectedRecords += validator.RejectedRecords
+		summary.ExpiredRecords += validator.ExpiredRecords
+
+		switch validator.Eligibility {
+		case types.EligibilityEligible:
+			summary.EligibleValidators++
+		case types.EligibilityIneligible:
+			summary.IneligibleValidators++
+		}
+
+		for _, slash := range validator.Slashes {
+			summary.Slashes++
+			summary.SlashedAmount = summary.SlashedAmount.Add(slash.Amount)
+		}
+
+		if err := k.ValidatorEpochSummaries.Set(ctx, collections.Join(epoch.Number, validator.ValidatorAddress), validator); err != nil {
+			return err
+		}
+	}
+
+	if err := k.EpochActivity.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	if err := k.EpochSummaries.Set(ctx, epoch.Number, summary); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochSummary,
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+			sdk.NewAttribute("submitted_records", fmt.Sprintf("%d", summary.SubmittedRecords)),
+			sdk.NewAttribute("ineligible_validators", fmt.Sprintf("%d", summary.IneligibleValidators)),
+			sdk.NewAttribute("slashes", fmt.Sprintf("%d", summary.Slashes)),
+			sdk.NewAttribute("rewards_paid", summary.RewardsPaid.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestEpochSummaries(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	active := f.addBondedValidator(t)
+	idle := f.addBondedValidator(t)
+
+	ctx := f.beginBlock(t, 1)
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, active))
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, idle))
+
+	// epoch 0: two records submitted, one verified and one rejected
+	ctx = f.beginBlock(t, 2)
+	verifiedID, err := f.keeper.CreateRecord(ctx, active, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	rejectedID, err := f.keeper.CreateRecord(ctx, active, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+	ctx = f.beginBlock(t, 3)
+	require.NoError(t, f.keeper.VerifyRecord(ctx, verifiedID, true))
+	require.NoError(t, f.keeper.VerifyRecord(ctx, rejectedID, false))
+
+	// epoch 1: only the active validator submits, the idle one is slashed as it ends
+	_, err = f.keeper.CreateRecord(f.beginBlock(t, 10), active, "", recordData(3), types.RecordMerkleRoot(recordData(3)))
+	require.NoError(t, err)
+	f.beginBlock(t, 20)
+
+	res, err := qs.EpochSummary(f.ctx, &types.QueryEpochSummaryRequest{Epoch: 0})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Summary.Epoch.Number)
+	require.Equal(t, uint64(10), res.Summary.EndedHeight)
+	require.Equal(t, uint64(2), res.Summary.SubmittedRecords)
+	require.Equal(t, uint64(1), res.Summary.VerifiedRecords)
+	require.Equal(t, uint64(1), res.Summary.RejectedRecords)
+	require.Equal(t, uint64(2), res.Summary.EligibleValidators)
+	require.Zero(t, res.Summary.Slashes)
+	require.Len(t, res.Validators, 2)
+
+	res, err = qs.EpochSummary(f.ctx, &types.QueryEpochSummaryRequest{Epoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Summary.SubmittedRecords)
+	require.Equal(t, uint64(1), res.Summary.EligibleValidators)
+	require.Equal(t, uint64(1), res.Summary.IneligibleValidators)
+	require.Equal(t, uint64(1), res.Summary.Slashes)
+	require.True(t, res.Summary.SlashedAmount.IsPositive())
+
+	_, err = qs.EpochSummary(f.ctx, &types.QueryEpochSummaryRequest{Epoch: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	summaries, err := qs.EpochSummaries(f.ctx, &types.QueryEpochSummariesRequest{StartEpoch: 0, EndEpoch: 5})
+	require.NoError(t, err)
+	require.Len(t, summaries.Summaries, 2)
+
+	_, err = qs.EpochSummaries(f.ctx, &types.QueryEpochSummariesRequest{StartEpoch: 0, EndEpoch: types.MaxEpochSummaryRange})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	history, err := qs.ValidatorEpochSummaries(f.ctx, &types.QueryValidatorEpochSummariesRequest{ValidatorAddress: idle})
+	require.NoError(t, err)
+	require.Len(t, history.Summaries, 2)
+	require.Equal(t, types.EligibilityEligible, history.Summaries[0].Eligibility)
+	require.Equal(t, types.EligibilityIneligible, history.Summaries[1].Eligibility)
+	require.Len(t, history.Summaries[1].Slashes, 1)
+	require.Equal(t, "missing_records", history.Summaries[1].Slashes[0].Reason)
+	require.Equal(t, params.SlashFractionMissingRecord, history.Summaries[1].Slashes[0].Fraction)
+
+	history, err = qs.ValidatorEpochSummaries(f.ctx, &types.QueryValidatorEpochSummariesRequest{ValidatorAddress: active})
+	require.NoError(t, err)
+	require.Len(t, history.Summaries, 2)
+	require.Equal(t, uint64(2), history.Summaries[0].SubmittedRecords)
+	require.Equal(t, uint64(1), history.Summaries[1].SubmittedRecords)
+
+	// the activity of ended epochs is not kept once summarized
+	has, err := f.keeper.EpochActivity.Has(f.ctx, collections.Join(uint64(0), active))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+type epochHookCall struct {
+	hook  string
+	epoch uint64
+}
+
+type recordingEpochHooks struct {
+	calls []epochHookCall
+}
+
+func (h *recordingEpochHooks) BeforeEpochEnd(_ context.Context, epoch types.EpochInfo) error {
+	h.calls = append(h.calls, epochHookCall{"end", epoch.Number})
+	return nil
+}
+
+func (h *recordingEpochHooks) AfterEpochStart(_ context.Context, epoch types.EpochInfo) error {
+	h.calls = append(h.calls, epochHookCall{"start", epoch.Number})
+	return nil
+}
+
+func TestAdvanceEpoch(t *testing.T) {
+	f := initFixture(t)
+	hooks := &recordingEpochHooks{}
+	f.keeper.SetEpochHooks(hooks)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	idle := f.addBondedValidator(t)
+
+	// validators joining in epoch 0 are first required to submit in epoch 1
+	ctx := f.beginBlock(t, 1)
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, submitter))
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, idle))
+
+	f.beginBlock(t, 9)
+	require.Empty(t, hooks.calls)
+
+	ctx = f.beginBlock(t, 10)
+	require.Equal(t, []epochHookCall{{"end", 0}, {"start", 1}}, hooks.calls)
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	epoch, err := f.keeper.GetCurrentEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), epoch.Number)
+	require.Equal(t, uint64(10), epoch.StartHeight)
+	require.Equal(t, sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), epoch.StartTime)
+
+	recordID, err := f.keeper.CreateRecord(f.beginBlock(t, 12), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record.Epoch)
+
+	// only the validator that submitted nothing in epoch 1 is slashed as it ends
+	f.beginBlock(t, 20)
+	require.Len(t, f.stakingKeeper.slashes, 1)
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, submitter)
+	require.NoError(t, err)
+	require.True(t, stats.IsEligible)
+	require.Equal(t, uint64(1), stats.LastRecordEpoch)
+	require.Equal(t, uint64(2), stats.NextRequiredRecordEpoch)
+
+	stats, err = f.keeper.GetValidatorStats(f.ctx, idle)
+	require.NoError(t, err)
+	require.False(t, stats.IsEligible)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Epochs(f.ctx, &types.QueryEpochsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Epochs, 3)
+	require.Equal(t, uint64(0), res.Epochs[0].Number)
+	require.Equal(t, uint64(20), res.Epochs[2].StartHeight)
+
+	current, err := keeper.NewQueryServerImpl(f.keeper).CurrentEpoch(f.ctx, &types.QueryCurrentEpochRequest{})
+	require.NoError(t, err)
+	require.Equal(t, res.Epochs[2], current.Epoch)
+}
+
+func TestTimeMeasuredEpochs(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.EpochMode = types.EpochModeTime
+	params.EpochDurationSeconds = 60
+	params.RecordsPerEpoch = 1
+
+	genesisTime := sdk.UnwrapSDKContext(f.ctx).BlockTime()
+	at := func(height int64, seconds int64) context.Context {
+		ctx := sdk.UnwrapSDKContext(f.withBlock(height)).WithBlockTime(genesisTime.Add(time.Duration(seconds) * time.Second))
+		require.NoError(t, f.keeper.AdvanceEpoch(ctx))
+		return ctx
+	}
+
+	require.NoError(t, f.keeper.InitGenesis(f.withBlock(1), types.GenesisState{Params: params}))
+
+	// the record quota applies per epoch of block time, however many blocks it has
+	ctx := at(2, 30)
+	_, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	_, err = f.keeper.CreateRecord(at(500, 59), submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.ErrorIs(t, err, types.ErrEpochRecordsExceeded)
+
+	ctx = at(501, 60)
+	_, err = f.keeper.CreateRecord(ctx, submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+
+	epoch, err := f.keeper.GetCurrentEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), epoch.Number)
+	require.Equal(t, types.EpochModeTime, epoch.Mode)
+	require.Equal(t, epoch.StartTime+60, epoch.EndTime)
+
+	// switching to block measured epochs takes effect when the current epoch ends
+	params.EpochMode = types.EpochModeBlocks
+	params.EpochLength = 5
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	at(510, 100)
+	epoch, err = f.keeper.GetCurrentEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), epoch.Number)
+
+	at(511, 120)
+	epoch, err = f.keeper.GetCurrentEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), epoch.Number)
+	require.Equal(t, types.EpochModeBlocks, epoch.Mode)
+	require.Equal(t, uint64(516), epoch.EndHeight)
+
+	at(515, 10_000)
+	epoch, err = f.keeper.GetCurrentEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), epoch.Number)
+
+	at(516, 10_001)
+	epoch, err = f.keeper.GetCurrentEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), epoch.Number)
+}
@@ -2,15 +2,194 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, recordType := range genState.RecordTypes {
+		if err := k.SetRecordType(ctx, recordType); err != nil {
+			return err
+		}
+	}
+
+	// Start the first epoch at genesis unless the epoch history is imported
+	epochs := genState.Epochs
+	if len(epochs) == 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		epochs = []types.EpochInfo{
+			types.NewEpochInfo(0, uint64(sdkCtx.BlockHeight()), sdkCtx.BlockTime().Unix(), genState.Params),
+		}
+	}
+	for _, epoch := range epochs {
+		if err := k.Epochs.Set(ctx, epoch.Number, epoch); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range genState.Records {
+		if err := k.Records.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+		if err := k.queueGenesisRecord(ctx, genState.Params, record); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range genState.ValidatorRecordStats {
+		if err := k.ValidatorStats.Set(ctx, stats.ValidatorAddress, stats); err != nil {
+			return err
+		}
+	}
+
+	for _, vote := range genState.RecordVotes {
+		if err := k.RecordVotes.Set(ctx, collections.Join(vote.RecordId, vote.Verifier), vote); err != nil {
+			return err
+		}
+	}
+
+	// Commits are removed as their reveal phase closes, so every commit
+	// belongs to an open reveal phase
+	for _, commit := range genState.VerificationCommits {
+		if err := k.VerificationCommits.Set(ctx, collections.Join(commit.RecordId, commit.Verifier), commit); err != nil {
+			return err
+		}
+		if err := k.RevealQueue.Set(ctx, collections.Join(commit.RevealEndHeight, commit.RecordId)); err != nil {
+			return err
+		}
+	}
+
+	for _, challenge := range genState.AvailabilityChallenges {
+		if err := k.AvailabilityChallenges.Set(ctx, challenge.Id, challenge); err != nil {
+			return err
+		}
+		if challenge.Status != types.ChallengeStatusOpen {
+			continue
+		}
+		if err := k.OpenChallenges.Set(ctx, challenge.RecordId, challenge.Id); err != nil {
+			return err
+		}
+		if err := k.ChallengeQueue.Set(ctx, collections.Join(challenge.DeadlineHeight, challenge.Id)); err != nil {
+			return err
+		}
+	}
+	if err := k.AvailabilityChallengeSeq.Set(ctx, genState.NextAvailabilityChallengeId); err != nil {
+		return err
+	}
+
+	for _, dispute := range genState.Disputes {
+		if err := k.Disputes.Set(ctx, dispute.RecordId, dispute); err != nil {
+			return err
+		}
+	}
+
+	for _, upload := range genState.RecordUploads {
+		if err := k.RecordUploads.Set(ctx, upload.Id, upload); err != nil {
+			return err
+		}
+		if err := k.UploadQueue.Set(ctx, collections.Join(upload.DeadlineHeight, upload.Id)); err != nil {
+			return err
+		}
+	}
+	for _, chunk := range genState.UploadChunks {
+		if err := k.UploadChunks.Set(ctx, collections.Join(chunk.UploadId, chunk.Index), chunk.Data); err != nil {
+			return err
+		}
+	}
+	if err := k.RecordUploadSeq.Set(ctx, genState.NextRecordUploadId); err != nil {
+		return err
+	}
+
+	for _, summary := range genState.EpochSummaries {
+		if err := k.EpochSummaries.Set(ctx, summary.Epoch.Number, summary); err != nil {
+			return err
+		}
+	}
+	for _, summary := range genState.ValidatorEpochSummaries {
+		if err := k.ValidatorEpochSummaries.Set(ctx, collections.Join(summary.Epoch, summary.ValidatorAddress), summary); err != nil {
+			return err
+		}
+	}
+	for _, activity := range genState.EpochActivity {
+		if err := k.EpochActivity.Set(ctx, collections.Join(activity.Epoch, activity.ValidatorAddress), activity); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RewardPool.Set(ctx, genState.RewardPool); err != nil {
+		return err
+	}
+
+	for _, payout := range genState.RewardPayouts {
+		if err := k.RewardPayouts.Set(ctx, collections.Join(payout.Epoch, payout.ValidatorAddress), payout); err != nil {
+			return err
+		}
+	}
+
+	for _, info := range genState.RecordSigningInfos {
+		if err := k.RecordSigningInfos.Set(ctx, info.ValidatorAddress, info); err != nil {
+			return err
+		}
+	}
+	for _, missed := range genState.RecordMissedEpochs {
+		if err := k.RecordMissedEpochs.Set(ctx, collections.Join(missed.ValidatorAddress, missed.Epoch)); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range genState.SlashEvents {
+		if err := k.SlashEvents.Set(ctx, collections.Join(event.ValidatorAddress, event.Id), event); err != nil {
+			return err
+		}
+	}
+	if err := k.SlashEventSeq.Set(ctx, genState.NextSlashEventId); err != nil {
+		return err
+	}
+
 	return k.Params.Set(ctx, genState.Params)
 }
 
+// queueGenesisRecord rebuilds the queue entries and the epoch amendment count
+// of an imported record. Finalized records holding data are queued for pruning
+// keyed by their submission height, as their finalization height is not kept.
+func (k Keeper) queueGenesisRecord(ctx context.Context, params types.Params, record types.Record) error {
+	if record.Status == types.RecordStatusPending {
+		if record.DisputeDeadlineHeight > 0 {
+			if err := k.DisputeWindowQueue.Set(ctx, collections.Join(record.DisputeDeadlineHeight, record.Id)); err != nil {
+				return err
+			}
+		}
+		if params.VerificationDeadlineSeconds > 0 {
+			if err := k.ExpiryQueue.Set(ctx, collections.Join(expiryTime(record.Timestamp, params), record.Id)); err != nil {
+				return err
+			}
+		}
+	} else if len(record.Data) != 0 {
+		if err := k.PruneQueue.Set(ctx, collections.Join(record.BlockHeight, record.Id)); err != nil {
+			return err
+		}
+	}
+
+	// Amendments count towards the epoch of the record they amend
+	if record.SupersedesId != "" {
+		epochKey := collections.Join(record.ValidatorAddress, record.Epoch)
+		amendments, err := k.EpochAmendments.Get(ctx, epochKey)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.EpochAmendments.Set(ctx, epochKey, amendments+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	var err error
@@ -21,5 +200,168 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.RecordTypes.Walk(ctx, nil, func(_ string, recordType types.RecordType) (bool, error) {
+		genesis.RecordTypes = append(genesis.RecordTypes, recordType)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Epochs.Walk(ctx, nil, func(_ uint64, epoch types.EpochInfo) (bool, error) {
+		genesis.Epochs = append(genesis.Epochs, epoch)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Records.Walk(ctx, nil, func(_ string, record types.Record) (bool, error) {
+		genesis.Records = append(genesis.Records, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ValidatorStats.Walk(ctx, nil, func(_ string, stats types.ValidatorRecordStats) (bool, error) {
+		genesis.ValidatorRecordStats = append(genesis.ValidatorRecordStats, stats)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RecordVotes.Walk(ctx, nil, func(_ collections.Pair[string, string], vote types.RecordVote) (bool, error) {
+		genesis.RecordVotes = append(genesis.RecordVotes, vote)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.VerificationCommits.Walk(ctx, nil, func(_ collections.Pair[string, string], commit types.VerificationCommit) (bool, error) {
+		genesis.VerificationCommits = append(genesis.VerificationCommits, commit)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.AvailabilityChallenges.Walk(ctx, nil, func(_ uint64, challenge types.AvailabilityChallenge) (bool, error) {
+		genesis.AvailabilityChallenges = append(genesis.AvailabilityChallenges, challenge)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.NextAvailabilityChallengeId, err = k.AvailabilityChallengeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Disputes.Walk(ctx, nil, func(_ string, dispute types.RecordDispute) (bool, error) {
+		genesis.Disputes = append(genesis.Disputes, dispute)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RecordUploads.Walk(ctx, nil, func(_ uint64, upload types.RecordUpload) (bool, error) {
+		genesis.RecordUploads = append(genesis.RecordUploads, upload)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.UploadChunks.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], data []byte) (bool, error) {
+		genesis.UploadChunks = append(genesis.UploadChunks, types.UploadChunk{
+			UploadId: key.K1(),
+			Index:    key.K2(),
+			Data:     data,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.NextRecordUploadId, err = k.RecordUploadSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.EpochSummaries.Walk(ctx, nil, func(_ uint64, summary types.EpochSummary) (bool, error) {
+		genesis.EpochSummaries = append(genesis.EpochSummaries, summary)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ValidatorEpochSummaries.Walk(ctx, nil, func(_ collections.Pair[uint64, string], summary types.ValidatorEpochSummary) (bool, error) {
+		genesis.ValidatorEpochSummaries = append(genesis.ValidatorEpochSummaries, summary)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.EpochActivity.Walk(ctx, nil, func(_ collections.Pair[uint64, string], activity types.ValidatorEpochSummary) (bool, error) {
+		genesis.EpochActivity = append(genesis.EpochActivity, activity)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.RewardPool, err = k.GetRewardPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RewardPayouts.Walk(ctx, nil, func(_ collections.Pair[uint64, string], payout types.RewardPayout) (bool, error) {
+		genesis.RewardPayouts = append(genesis.RewardPayouts, payout)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RecordSigningInfos.Walk(ctx, nil, func(_ string, info types.RecordSigningInfo) (bool, error) {
+		genesis.RecordSigningInfos = append(genesis.RecordSigningInfos, info)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RecordMissedEpochs.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+		genesis.RecordMissedEpochs = append(genesis.RecordMissedEpochs, types.RecordMissedEpoch{
+			ValidatorAddress: key.K1(),
+			Epoch:            key.K2(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SlashEvents.Walk(ctx, nil, func(_ collections.Pair[string, uint64], event types.SlashEvent) (bool, error) {
+		genesis.SlashEvents = append(genesis.SlashEvents, event)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.NextSlashEventId, err = k.SlashEventSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -1,19 +1,104 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
-	"github.com/NeomSense/PoS/x/pos/types"
-
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/offchain"
+	"github.com/NeomSense/PoS/x/pos/types"
 )
 
 func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+	validator := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		RecordTypes: []types.RecordType{
+			{Id: "reading", Schema: `{"type": "object"}`, MaxDataSize: 4096},
+		},
+		Epochs: []types.EpochInfo{
+			{Number: 3, StartHeight: 300, StartTime: 1000, EndHeight: 400},
+			{Number: 4, StartHeight: 400, StartTime: 1600, Mode: types.EpochModeTime, EndTime: 2200},
+		},
+		Records: []types.Record{
+			{Id: "record-1", ValidatorAddress: validator, Data: []byte("reading"), Timestamp: 1650, Status: types.RecordStatusVerified, BlockHeight: 410, Epoch: 4, Version: 1},
+			{
+				Id:                    "record-2",
+				ValidatorAddress:      validator,
+				Data:                  []byte("reading"),
+				Timestamp:             1700,
+				Status:                types.RecordStatusPending,
+				BlockHeight:           420,
+				Epoch:                 4,
+				DisputeDeadlineHeight: 440,
+				Version:               1,
+			},
+			{Id: "record-3", ValidatorAddress: validator, Data: []byte("amended"), Timestamp: 1750, Status: types.RecordStatusVerified, BlockHeight: 425, Epoch: 4, SupersedesId: "record-1", Version: 2},
+		},
+		ValidatorRecordStats: []types.ValidatorRecordStats{
+			{ValidatorAddress: validator, TotalRecords: 2, VerifiedRecords: 1, LastRecordTime: 1700, IsEligible: true, LastRecordEpoch: 4},
+		},
+		RecordVotes: []types.RecordVote{
+			{RecordId: "record-1", Verifier: verifier, Approved: true, Power: math.NewInt(100), BlockHeight: 411},
+		},
+		VerificationCommits: []types.VerificationCommit{
+			{RecordId: "record-2", Verifier: verifier, Commitment: []byte("commitment"), Power: math.NewInt(100), BlockHeight: 421, RevealStartHeight: 425, RevealEndHeight: 430},
+		},
+		AvailabilityChallenges: []types.AvailabilityChallenge{
+			{Id: 0, RecordId: "record-1", Challenger: "challenger", BlockHeight: 412, DeadlineHeight: 450, Status: types.ChallengeStatusOpen, Bond: sdk.NewInt64Coin("stake", 1000)},
+		},
+		NextAvailabilityChallengeId: 1,
+		Disputes: []types.RecordDispute{
+			{RecordId: "record-2", Disputer: "disputer", Bond: sdk.NewInt64Coin("stake", 1000), Reason: "bad data", BlockHeight: 422, Status: types.DisputeStatusOpen},
+		},
+		RecordUploads: []types.RecordUpload{
+			{Id: 0, ValidatorAddress: validator, MerkleRoot: "root", TotalSize: 10, ChunkCount: 1, ReceivedSize: 5, BlockHeight: 430, DeadlineHeight: 530},
+		},
+		UploadChunks: []types.UploadChunk{
+			{UploadId: 0, Index: 0, Data: []byte("chunk")},
+		},
+		NextRecordUploadId: 1,
+		EpochSummaries: []types.EpochSummary{
+			{
+				Epoch:              types.EpochInfo{Number: 3, StartHeight: 300, StartTime: 1000, EndHeight: 400},
+				EndedHeight:        400,
+				EndedTime:          1600,
+				SubmittedRecords:   1,
+				EligibleValidators: 1,
+				SlashedAmount:      math.NewInt(10),
+			},
+		},
+		ValidatorEpochSummaries: []types.ValidatorEpochSummary{
+			{Epoch: 3, ValidatorAddress: validator, SubmittedRecords: 1, Eligibility: types.EligibilityEligible},
+		},
+		EpochActivity: []types.ValidatorEpochSummary{
+			{Epoch: 4, ValidatorAddress: validator, SubmittedRecords: 2, VerifiedRecords: 1},
+		},
+		RewardPayouts: []types.RewardPayout{
+			{Epoch: 3, ValidatorAddress: validator, VerifiedRecords: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), Recipient: "recipient"},
+		},
+		RecordSigningInfos: []types.RecordSigningInfo{
+			{ValidatorAddress: validator, StartEpoch: 3, LastEpoch: 4, MissedEpochsCounter: 1},
+		},
+		RecordMissedEpochs: []types.RecordMissedEpoch{
+			{ValidatorAddress: validator, Epoch: 3},
+		},
+		SlashEvents: []types.SlashEvent{
+			{Id: 0, ValidatorAddress: validator, Reason: "missed epochs", Fraction: math.LegacyNewDecWithPrec(1, 2), Amount: math.NewInt(10), Epoch: 3, BlockHeight: 400, BlockTime: 1600},
+		},
+		NextSlashEventId: 1,
 	}
+	require.NoError(t, genesisState.Validate())
 
-	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
 	require.NoError(t, err)
 	got, err := f.keeper.ExportGenesis(f.ctx)
@@ -21,4 +106,179 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.RecordTypes, got.RecordTypes)
+	require.Equal(t, genesisState.Epochs, got.Epochs)
+	require.Equal(t, genesisState.Records, got.Records)
+	require.Equal(t, genesisState.ValidatorRecordStats, got.ValidatorRecordStats)
+	require.Equal(t, genesisState.RecordVotes, got.RecordVotes)
+	require.Equal(t, genesisState.VerificationCommits, got.VerificationCommits)
+	require.Equal(t, genesisState.AvailabilityChallenges, got.AvailabilityChallenges)
+	require.Equal(t, genesisState.NextAvailabilityChallengeId, got.NextAvailabilityChallengeId)
+	require.Equal(t, genesisState.Disputes, got.Disputes)
+	require.Equal(t, genesisState.RecordUploads, got.RecordUploads)
+	require.Equal(t, genesisState.UploadChunks, got.UploadChunks)
+	require.Equal(t, genesisState.NextRecordUploadId, got.NextRecordUploadId)
+	require.Equal(t, genesisState.EpochSummaries, got.EpochSummaries)
+	require.Equal(t, genesisState.ValidatorEpochSummaries, got.ValidatorEpochSummaries)
+	require.Equal(t, genesisState.EpochActivity, got.EpochActivity)
+	require.Equal(t, genesisState.RewardPayouts, got.RewardPayouts)
+	require.Equal(t, genesisState.RecordSigningInfos, got.RecordSigningInfos)
+	require.Equal(t, genesisState.RecordMissedEpochs, got.RecordMissedEpochs)
+	require.Equal(t, genesisState.SlashEvents, got.SlashEvents)
+	require.Equal(t, genesisState.NextSlashEventId, got.NextSlashEventId)
+
+	epoch, err := f.keeper.GetCurrentEpoch(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), epoch.Number)
+
+	// the queue entries of the imported state are rebuilt
+	has, err := f.keeper.RevealQueue.Has(f.ctx, collections.Join(uint64(430), "record-2"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.ChallengeQueue.Has(f.ctx, collections.Join(uint64(450), uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+	challengeID, err := f.keeper.OpenChallenges.Get(f.ctx, "record-1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), challengeID)
+	has, err = f.keeper.DisputeWindowQueue.Has(f.ctx, collections.Join(uint64(440), "record-2"))
+	require.NoError(t, err)
+	require.True(t, has)
+	expiring := 0
+	err = f.keeper.ExpiryQueue.Walk(f.ctx, nil, func(key collections.Pair[int64, string]) (bool, error) {
+		require.Equal(t, "record-2", key.K2())
+		expiring++
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, expiring)
+	has, err = f.keeper.PruneQueue.Has(f.ctx, collections.Join(uint64(410), "record-1"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.UploadQueue.Has(f.ctx, collections.Join(uint64(530), uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+	amendments, err := f.keeper.EpochAmendments.Get(f.ctx, collections.Join(validator, uint64(4)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), amendments)
+
+	// new uploads continue the imported sequence
+	upload, err := f.keeper.BeginRecordUpload(f.withBlock(431), validator, types.RecordMerkleRoot(recordData(1)), 10, "", types.ContentEncodingIdentity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), upload.Id)
+}
+
+// genesisEscrow returns the fees and bonds a genesis state holds in escrow:
+// the fees and bonds of pending records, the bonds of open disputes and
+// challenges and the reward pool
+func genesisEscrow(gs *types.GenesisState) (fees, bonds sdk.Coins) {
+	bonds = gs.RewardPool.Balance
+	for _, record := range gs.Records {
+		if record.Status == types.RecordStatusPending {
+			fees = fees.Add(record.Fee...)
+			bonds = bonds.Add(record.Bond...)
+		}
+	}
+	for _, dispute := range gs.Disputes {
+		if dispute.Status == types.DisputeStatusOpen {
+			bonds = bonds.Add(dispute.Bond)
+		}
+	}
+	for _, challenge := range gs.AvailabilityChallenges {
+		if challenge.Status == types.ChallengeStatusOpen {
+			bonds = bonds.Add(challenge.Bond)
+		}
+	}
+	return fees, bonds
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *types.DefaultGenesis()))
+
+	params := types.DefaultParams()
+	params.RecordFeeFlat = sdk.NewInt64Coin("stake", 101)
+	params.RecordBond = sdk.NewInt64Coin("stake", 500)
+	params.InvalidRecordPenalty = types.InvalidRecordPenaltyBond
+	params.OptimisticVerificationEnabled = true
+	params.DisputeWindowBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	challenger := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+	f.addBondedValidator(t)
+	f.addBondedValidator(t)
+	f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000))
+	challengerAcc := sdk.AccAddress(mustValAddr(t, challenger)).String()
+	f.bankKeeper.balances[challengerAcc] = sdk.NewCoins(params.ChallengeBond)
+	disputer := sdk.AccAddress("disputer").String()
+	f.bankKeeper.balances[disputer] = sdk.NewCoins(params.DisputeBond)
+
+	// a disputed record with a vote cast on it
+	disputedID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	_, err = ms.DisputeRecord(f.withBlock(2), &types.MsgDisputeRecord{Disputer: disputer, RecordId: disputedID, Reason: "bad data"})
+	require.NoError(t, err)
+	_, err = ms.VerifyRecord(f.withBlock(3), &types.MsgVerifyRecord{Verifier: verifier, RecordId: disputedID, Approved: false})
+	require.NoError(t, err)
+
+	// a challenged off-chain record
+	data := bytes.Repeat([]byte("off-chain"), 500)
+	locator, err := offchain.NewMemStore().Put(data)
+	require.NoError(t, err)
+	res, err := ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		MerkleRoot:       types.RecordMerkleRoot(data),
+		DataLocator:      locator,
+		DataSize:         uint64(len(data)),
+	})
+	require.NoError(t, err)
+	challenge, err := ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: res.RecordId})
+	require.NoError(t, err)
+
+	feeEscrow := authtypes.NewModuleAddress(types.FeeEscrowName).String()
+	module := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Records, 2)
+	require.Len(t, exported.RecordVotes, 1)
+	require.Len(t, exported.Disputes, 1)
+	require.Len(t, exported.AvailabilityChallenges, 1)
+
+	fees, bonds := genesisEscrow(exported)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 202)), fees)
+	require.Equal(t, f.bankKeeper.balances[feeEscrow], fees)
+	require.Equal(t, f.bankKeeper.balances[module], bonds)
+
+	// import into a new chain holding the same module balances
+	imported := initFixture(t)
+	imported.stakingKeeper.validators = f.stakingKeeper.validators
+	imported.bankKeeper.balances[feeEscrow] = f.bankKeeper.balances[feeEscrow]
+	imported.bankKeeper.balances[module] = f.bankKeeper.balances[module]
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *exported))
+
+	reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	fees, bonds = genesisEscrow(reexported)
+	require.Equal(t, imported.bankKeeper.balances[feeEscrow], fees)
+	require.Equal(t, imported.bankKeeper.balances[module], bonds)
+
+	// the imported challenge fails at its deadline and its bond is returned
+	require.NoError(t, imported.keeper.ProcessChallengeQueue(imported.withBlock(int64(challenge.DeadlineHeight))))
+	require.Equal(t, sdk.NewCoins(params.ChallengeBond), imported.bankKeeper.balances[challengerAcc])
+
+	reexported, err = imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Len(t, reexported.SlashEvents, 1)
+	require.Equal(t, uint64(1), reexported.NextSlashEventId)
+	require.Equal(t, uint64(1), reexported.NextAvailabilityChallengeId)
+
+	_, bonds = genesisEscrow(reexported)
+	require.Equal(t, imported.bankKeeper.balances[module], bonds)
 }
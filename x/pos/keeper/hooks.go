@@ -70,7 +70,13 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddre
 // AfterValidatorBonded - called after a validator is bonded
 func (h Hooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	// When a validator becomes bonded, ensure they have stats initialized
-	return h.k.InitializeValidatorStats(ctx, valAddr.String())
+	if err := h.k.InitializeValidatorStats(ctx, valAddr.String()); err != nil {
+		return err
+	}
+
+	// A validator bonded again is no longer jailed for missing records,
+	// whichever way it was unjailed
+	return h.k.clearRecordJail(ctx, valAddr.String())
 }
 
 // AfterUnbondingInitiated - called after unbonding has been initiated
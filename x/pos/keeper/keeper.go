@@ -8,6 +8,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 
+	"github.com/NeomSense/PoS/x/pos/offchain"
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
@@ -20,13 +21,80 @@ type Keeper struct {
 	authority []byte
 
 	// External keepers
-	stakingKeeper  types.StakingKeeper
-	slashingKeeper types.SlashingKeeper
+	stakingKeeper      types.StakingKeeper
+	slashingKeeper     types.SlashingKeeper
+	distributionKeeper types.DistributionKeeper
+	bankKeeper         types.BankKeeper
+
+	// dataArchive optionally receives record data before it is pruned. It is
+	// local to the node and never affects state.
+	dataArchive offchain.Store
+
+	// epochHooks is shared by the copies of the keeper held by the app and
+	// the module, so hooks set after the app is built reach both
+	epochHooks *epochHooksHolder
 
 	Schema         collections.Schema
 	Params         collections.Item[types.Params]
-	Records        collections.Map[string, types.Record]
+	Records        *collections.IndexedMap[string, types.Record, RecordIndexes]
 	ValidatorStats collections.Map[string, types.ValidatorRecordStats]
+	// RecordVotes holds verifier votes keyed by (record id, verifier)
+	RecordVotes collections.Map[collections.Pair[string, string], types.RecordVote]
+	// VerificationCommits holds sealed votes keyed by (record id, verifier)
+	VerificationCommits collections.Map[collections.Pair[string, string], types.VerificationCommit]
+	// RevealQueue orders records by the height their reveal phase ends
+	RevealQueue collections.KeySet[collections.Pair[uint64, string]]
+	// AvailabilityChallenges holds data availability challenges by id
+	AvailabilityChallenges   collections.Map[uint64, types.AvailabilityChallenge]
+	AvailabilityChallengeSeq collections.Sequence
+	// OpenChallenges maps a record id to its open availability challenge
+	OpenChallenges collections.Map[string, uint64]
+	// ChallengeQueue orders open availability challenges by deadline height
+	ChallengeQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// Disputes holds the dispute filed against a record, by record id
+	Disputes collections.Map[string, types.RecordDispute]
+	// DisputeWindowQueue orders optimistic records by the height their dispute window ends
+	DisputeWindowQueue collections.KeySet[collections.Pair[uint64, string]]
+	// ExpiryQueue orders records by the unix time their verification deadline passes
+	ExpiryQueue collections.KeySet[collections.Pair[int64, string]]
+	// PruneQueue orders finalized records holding data by the height they were finalized
+	PruneQueue collections.KeySet[collections.Pair[uint64, string]]
+	// RecordTypes holds the record types registered by the authority, by id
+	RecordTypes collections.Map[string, types.RecordType]
+	// RecordUploads holds the open chunked record uploads, by id
+	RecordUploads *collections.IndexedMap[uint64, types.RecordUpload, RecordUploadIndexes]
+	// RecordUploadSeq is the sequence of record upload ids
+	RecordUploadSeq collections.Sequence
+	// UploadChunks holds the chunks of open uploads, by (upload id, chunk index)
+	UploadChunks collections.Map[collections.Pair[uint64, uint64], []byte]
+	// UploadQueue orders open uploads by the height they are discarded at
+	UploadQueue collections.KeySet[collections.Pair[uint64, uint64]]
+	// EpochAmendments counts the amendments of a validator by (validator, epoch);
+	// they are exempt from the per-epoch record limit
+	EpochAmendments collections.Map[collections.Pair[string, uint64], uint64]
+	// Epochs holds the epoch history by number; the highest epoch is the current one
+	Epochs collections.Map[uint64, types.EpochInfo]
+	// EpochActivity accumulates what validators do in the current epoch, by
+	// (epoch, validator), until it is summarized as the epoch ends
+	EpochActivity collections.Map[collections.Pair[uint64, string], types.ValidatorEpochSummary]
+	// EpochSummaries holds the summaries of ended epochs, by epoch
+	EpochSummaries collections.Map[uint64, types.EpochSummary]
+	// ValidatorEpochSummaries holds what validators did in ended epochs, by (epoch, validator)
+	ValidatorEpochSummaries *collections.IndexedMap[collections.Pair[uint64, string], types.ValidatorEpochSummary, ValidatorEpochSummaryIndexes]
+	// RewardPool tracks the part of the module account balance paid out as
+	// rewards, the rest is escrowed bonds
+	RewardPool collections.Item[types.RewardPool]
+	// RewardPayouts holds the rewards paid to validators as epochs ended, by (epoch, validator)
+	RewardPayouts *collections.IndexedMap[collections.Pair[uint64, string], types.RewardPayout, RewardPayoutIndexes]
+	// RecordSigningInfos tracks the record submissions of validators over the signing window, by validator
+	RecordSigningInfos collections.Map[string, types.RecordSigningInfo]
+	// RecordMissedEpochs holds the epochs in the signing window a validator
+	// missed, by (validator, epoch)
+	RecordMissedEpochs collections.KeySet[collections.Pair[string, uint64]]
+	// SlashEvents holds the slashes x/pos applied, by (validator, slash ID)
+	SlashEvents *collections.IndexedMap[collections.Pair[string, uint64], types.SlashEvent, SlashEventIndexes]
+	// SlashEventSeq assigns slash event IDs
+	SlashEventSeq collections.Sequence
 }
 
 func NewKeeper(
@@ -36,6 +104,8 @@ func NewKeeper(
 	authority []byte,
 	stakingKeeper types.StakingKeeper,
 	slashingKeeper types.SlashingKeeper,
+	distributionKeeper types.DistributionKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -44,20 +114,24 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	k := Keeper{
-		storeService:   storeService,
-		cdc:            cdc,
-		addressCodec:   addressCodec,
-		authority:      authority,
-		stakingKeeper:  stakingKeeper,
-		slashingKeeper: slashingKeeper,
+		storeService:       storeService,
+		cdc:                cdc,
+		addressCodec:       addressCodec,
+		authority:          authority,
+		stakingKeeper:      stakingKeeper,
+		slashingKeeper:     slashingKeeper,
+		distributionKeeper: distributionKeeper,
+		bankKeeper:         bankKeeper,
+		epochHooks:         &epochHooksHolder{},
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Records: collections.NewMap(
+		Records: collections.NewIndexedMap(
 			sb,
 			types.RecordsKey,
 			"records",
 			collections.StringKey,
 			codec.CollValue[types.Record](cdc),
+			newRecordIndexes(sb),
 		),
 		ValidatorStats: collections.NewMap(
 			sb,
@@ -66,6 +140,176 @@ func NewKeeper(
 			collections.StringKey,
 			codec.CollValue[types.ValidatorRecordStats](cdc),
 		),
+		RecordVotes: collections.NewMap(
+			sb,
+			types.RecordVotesKey,
+			"record_votes",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.RecordVote](cdc),
+		),
+		VerificationCommits: collections.NewMap(
+			sb,
+			types.VerificationCommitsKey,
+			"verification_commits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.VerificationCommit](cdc),
+		),
+		RevealQueue: collections.NewKeySet(
+			sb,
+			types.RevealQueueKey,
+			"reveal_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		AvailabilityChallenges: collections.NewMap(
+			sb,
+			types.AvailabilityChallengesKey,
+			"availability_challenges",
+			collections.Uint64Key,
+			codec.CollValue[types.AvailabilityChallenge](cdc),
+		),
+		AvailabilityChallengeSeq: collections.NewSequence(
+			sb,
+			types.AvailabilityChallengeSeqKey,
+			"availability_challenge_seq",
+		),
+		OpenChallenges: collections.NewMap(
+			sb,
+			types.OpenChallengesKey,
+			"open_challenges",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		ChallengeQueue: collections.NewKeySet(
+			sb,
+			types.ChallengeQueueKey,
+			"challenge_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		Disputes: collections.NewMap(
+			sb,
+			types.DisputesKey,
+			"disputes",
+			collections.StringKey,
+			codec.CollValue[types.RecordDispute](cdc),
+		),
+		DisputeWindowQueue: collections.NewKeySet(
+			sb,
+			types.DisputeWindowQueueKey,
+			"dispute_window_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		ExpiryQueue: collections.NewKeySet(
+			sb,
+			types.ExpiryQueueKey,
+			"expiry_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		PruneQueue: collections.NewKeySet(
+			sb,
+			types.PruneQueueKey,
+			"prune_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		RecordTypes: collections.NewMap(
+			sb,
+			types.RecordTypesKey,
+			"record_types",
+			collections.StringKey,
+			codec.CollValue[types.RecordType](cdc),
+		),
+		RecordUploads: collections.NewIndexedMap(
+			sb,
+			types.RecordUploadsKey,
+			"record_uploads",
+			collections.Uint64Key,
+			codec.CollValue[types.RecordUpload](cdc),
+			newRecordUploadIndexes(sb),
+		),
+		RecordUploadSeq: collections.NewSequence(
+			sb,
+			types.RecordUploadSeqKey,
+			"record_upload_seq",
+		),
+		UploadChunks: collections.NewMap(
+			sb,
+			types.UploadChunksKey,
+			"upload_chunks",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			collections.BytesValue,
+		),
+		UploadQueue: collections.NewKeySet(
+			sb,
+			types.UploadQueueKey,
+			"upload_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		EpochAmendments: collections.NewMap(
+			sb,
+			types.EpochAmendmentsKey,
+			"epoch_amendments",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		Epochs: collections.NewMap(
+			sb,
+			types.EpochsKey,
+			"epochs",
+			collections.Uint64Key,
+			codec.CollValue[types.EpochInfo](cdc),
+		),
+		EpochActivity: collections.NewMap(
+			sb,
+			types.EpochActivityKey,
+			"epoch_activity",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.ValidatorEpochSummary](cdc),
+		),
+		EpochSummaries: collections.NewMap(
+			sb,
+			types.EpochSummariesKey,
+			"epoch_summaries",
+			collections.Uint64Key,
+			codec.CollValue[types.EpochSummary](cdc),
+		),
+		ValidatorEpochSummaries: collections.NewIndexedMap(
+			sb,
+			types.ValidatorEpochSummariesKey,
+			"validator_epoch_summaries",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.ValidatorEpochSummary](cdc),
+			newValidatorEpochSummaryIndexes(sb),
+		),
+		RewardPool: collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
+		RewardPayouts: collections.NewIndexedMap(
+			sb,
+			types.RewardPayoutsKey,
+			"reward_payouts",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.RewardPayout](cdc),
+			newRewardPayoutIndexes(sb),
+		),
+		RecordSigningInfos: collections.NewMap(
+			sb,
+			types.RecordSigningInfosKey,
+			"record_signing_infos",
+			collections.StringKey,
+			codec.CollValue[types.RecordSigningInfo](cdc),
+		),
+		RecordMissedEpochs: collections.NewKeySet(
+			sb,
+			types.RecordMissedEpochsKey,
+			"record_missed_epochs",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		SlashEvents: collections.NewIndexedMap(
+			sb,
+			types.SlashEventsKey,
+			"slash_events",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.SlashEvent](cdc),
+			newSlashEventIndexes(sb),
+		),
+		SlashEventSeq: collections.NewSequence(sb, types.SlashEventSeqKey, "slash_event_seq"),
 	}
 
 	schema, err := sb.Build()
@@ -77,6 +321,21 @@ func NewKeeper(
 	return k
 }
 
+// SetDataArchive sets the store record data is exported to before it is pruned
+func (k *Keeper) SetDataArchive(archive offchain.Store) {
+	k.dataArchive = archive
+}
+
+// SetEpochHooks sets the hooks run on epoch transitions. It panics if hooks
+// were already set, use types.NewMultiEpochHooks to set several.
+func (k Keeper) SetEpochHooks(hooks types.EpochHooks) Keeper {
+	if k.epochHooks.hooks != nil {
+		panic("cannot set pos epoch hooks twice")
+	}
+	k.epochHooks.hooks = hooks
+	return k
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
@@ -2,16 +2,22 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/NeomSense/PoS/x/pos/keeper"
 	module "github.com/NeomSense/PoS/x/pos/module"
@@ -22,6 +28,11 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+
+	stakingKeeper      *mockStakingKeeper
+	slashingKeeper     *mockSlashingKeeper
+	distributionKeeper *mockDistributionKeeper
+	bankKeeper         *mockBankKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -36,11 +47,20 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 
+	stakingKeeper := newMockStakingKeeper()
+	slashingKeeper := newMockSlashingKeeper()
+	bankKeeper := newMockBankKeeper()
+	distributionKeeper := newMockDistributionKeeper(bankKeeper)
+
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		stakingKeeper,
+		slashingKeeper,
+		distributionKeeper,
+		bankKeeper,
 	)
 
 	// Initialize params
@@ -49,8 +69,237 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:                ctx,
+		keeper:             k,
+		addressCodec:       addressCodec,
+		stakingKeeper:      stakingKeeper,
+		slashingKeeper:     slashingKeeper,
+		distributionKeeper: distributionKeeper,
+		bankKeeper:         bankKeeper,
+	}
+}
+
+// addBondedValidator registers a new bonded validator with the mock staking
+// keeper and returns its operator address.
+func (f *fixture) addBondedValidator(t *testing.T) string {
+	t.Helper()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(pk.Address()).String()
+
+	validator, err := stakingtypes.NewValidator(operator, pk, stakingtypes.Description{})
+	if err != nil {
+		t.Fatalf("failed to create validator: %v", err)
+	}
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.DefaultPowerReduction.MulRaw(100)
+	validator.DelegatorShares = math.LegacyNewDecFromInt(validator.Tokens)
+
+	f.stakingKeeper.validators[operator] = validator
+	f.stakingKeeper.delegations[operator] = stakingtypes.NewDelegation(
+		sdk.AccAddress(pk.Address()).String(), operator, validator.DelegatorShares,
+	)
+	return operator
+}
+
+// withBlock returns the fixture context at the given block height.
+func (f *fixture) withBlock(height int64) context.Context {
+	return sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(height)
+}
+
+// beginBlock returns the fixture context at the given block height, after
+// advancing the epoch as the module does at the start of the block.
+func (f *fixture) beginBlock(t *testing.T, height int64) context.Context {
+	t.Helper()
+
+	ctx := f.withBlock(height)
+	if err := f.keeper.AdvanceEpoch(ctx); err != nil {
+		t.Fatalf("failed to advance epoch: %v", err)
+	}
+	return ctx
+}
+
+type mockSlash struct {
+	consAddr sdk.ConsAddress
+	height   int64
+	power    int64
+	fraction math.LegacyDec
+}
+
+type mockStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+	slashes    []mockSlash
+	jailed     map[string]bool
+	// delegations maps a validator address to its self-delegation
+	delegations map[string]stakingtypes.Delegation
+}
+
+var _ types.StakingKeeper = (*mockStakingKeeper)(nil)
+
+func newMockStakingKeeper() *mockStakingKeeper {
+	return &mockStakingKeeper{
+		validators:  make(map[string]stakingtypes.Validator),
+		jailed:      make(map[string]bool),
+		delegations: make(map[string]stakingtypes.Delegation),
+	}
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *mockStakingKeeper) GetAllValidators(_ context.Context) ([]stakingtypes.Validator, error) {
+	validators := make([]stakingtypes.Validator, 0, len(m.validators))
+	for _, validator := range m.validators {
+		validators = append(validators, validator)
+	}
+	return validators, nil
+}
+
+func (m *mockStakingKeeper) TotalBondedTokens(_ context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	for _, validator := range m.validators {
+		total = total.Add(validator.GetBondedTokens())
+	}
+	return total, nil
+}
+
+func (m *mockStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	for _, validator := range m.validators {
+		bz, err := validator.GetConsAddr()
+		if err == nil && sdk.ConsAddress(bz).Equals(consAddr) {
+			return validator, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoValidatorFound
+}
+
+func (m *mockStakingKeeper) Slash(_ context.Context, consAddr sdk.ConsAddress, height, power int64, fraction math.LegacyDec) (math.Int, error) {
+	m.slashes = append(m.slashes, mockSlash{consAddr: consAddr, height: height, power: power, fraction: fraction})
+	return fraction.MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt(), nil
+}
+
+func (m *mockStakingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	m.jailed[consAddr.String()] = true
+	return nil
+}
+
+func (m *mockStakingKeeper) Unjail(_ context.Context, consAddr sdk.ConsAddress) error {
+	delete(m.jailed, consAddr.String())
+	return nil
+}
+
+func (m *mockStakingKeeper) GetDelegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	delegation, ok := m.delegations[valAddr.String()]
+	if !ok || delegation.DelegatorAddress != delAddr.String() {
+		return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+	}
+	return delegation, nil
+}
+
+type mockSlashingKeeper struct {
+	tombstoned map[string]bool
+	jailUntil  map[string]time.Time
+}
+
+var _ types.SlashingKeeper = (*mockSlashingKeeper)(nil)
+
+func newMockSlashingKeeper() *mockSlashingKeeper {
+	return &mockSlashingKeeper{
+		tombstoned: make(map[string]bool),
+		jailUntil:  make(map[string]time.Time),
+	}
+}
+
+func (m *mockSlashingKeeper) IsTombstoned(_ context.Context, consAddr sdk.ConsAddress) bool {
+	return m.tombstoned[consAddr.String()]
+}
+
+func (m *mockSlashingKeeper) JailUntil(_ context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	m.jailUntil[consAddr.String()] = jailTime
+	return nil
+}
+
+func (m *mockSlashingKeeper) Tombstone(_ context.Context, consAddr sdk.ConsAddress) error {
+	m.tombstoned[consAddr.String()] = true
+	return nil
+}
+
+type mockDistributionKeeper struct {
+	withdrawAddrs map[string]sdk.AccAddress
+	communityPool sdk.Coins
+	bank          *mockBankKeeper
+}
+
+var _ types.DistributionKeeper = (*mockDistributionKeeper)(nil)
+
+func newMockDistributionKeeper(bank *mockBankKeeper) *mockDistributionKeeper {
+	return &mockDistributionKeeper{withdrawAddrs: make(map[string]sdk.AccAddress), bank: bank}
+}
+
+func (m *mockDistributionKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := m.bank.send(sender.String(), authtypes.NewModuleAddress(distrtypes.ModuleName).String(), amount); err != nil {
+		return err
+	}
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
+}
+
+func (m *mockDistributionKeeper) GetDelegatorWithdrawAddr(_ context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error) {
+	if addr, ok := m.withdrawAddrs[delAddr.String()]; ok {
+		return addr, nil
+	}
+	return delAddr, nil
+}
+
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+var _ types.BankKeeper = (*mockBankKeeper)(nil)
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[from].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", m.balances[from], amt)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule).String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	module := authtypes.NewModuleAddress(moduleName).String()
+	balance, hasNeg := m.balances[module].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient module funds: %s < %s", m.balances[module], amt)
 	}
+	m.balances[module] = balance
+	m.burned = m.burned.Add(amt...)
+	return nil
 }
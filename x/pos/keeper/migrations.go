@@ -0,0 +1,398 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/pos store from version 1 to 2.
+// It backfills the epoch of existing records and builds the record
+// secondary indexes (by validator, by validator and epoch, by status, by
+// epoch, by block height).
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Collect ids first so the store is not written while it is being iterated
+	var recordIDs []string
+	err = m.keeper.Records.Walk(ctx, nil, func(key string, _ types.Record) (bool, error) {
+		recordIDs = append(recordIDs, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range recordIDs {
+		record, err := m.keeper.Records.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if params.EpochLength > 0 {
+			record.Epoch = record.BlockHeight / params.EpochLength
+		}
+
+		// Re-setting the record writes its index entries
+		if err := m.keeper.Records.Set(ctx, id, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the x/pos store from version 2 to 3.
+// It sets the verification quorum and threshold params introduced with
+// stake-weighted record verification, and the related verifier exclusion that
+// upgraded chains decode as disabled, to their defaults.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.VerificationQuorum = types.DefaultVerificationQuorum
+	params.VerificationThreshold = types.DefaultVerificationThreshold
+	params.ExcludeRelatedVerifiers = types.DefaultParams().ExcludeRelatedVerifiers
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate3to4 migrates the x/pos store from version 3 to 4.
+// It sets the commit-reveal params to their defaults, leaving commit-reveal
+// voting disabled until governance turns it on.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.CommitRevealEnabled = defaults.CommitRevealEnabled
+	params.CommitPeriodBlocks = defaults.CommitPeriodBlocks
+	params.RevealPeriodBlocks = defaults.RevealPeriodBlocks
+	params.SlashFractionUnrevealedCommit = defaults.SlashFractionUnrevealedCommit
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate4to5 migrates the x/pos store from version 4 to 5.
+// It sets the data availability challenge params to their defaults.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.AvailabilityChallengePeriodBlocks = defaults.AvailabilityChallengePeriodBlocks
+	params.SlashFractionUnavailableData = defaults.SlashFractionUnavailableData
+	params.ChallengeBond = defaults.ChallengeBond
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 migrates the x/pos store from version 5 to 6.
+// It sets the optimistic verification params to their defaults, leaving
+// optimistic verification disabled until governance turns it on.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.OptimisticVerificationEnabled = defaults.OptimisticVerificationEnabled
+	params.DisputeWindowBlocks = defaults.DisputeWindowBlocks
+	params.DisputeBond = defaults.DisputeBond
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate6to7 migrates the x/pos store from version 6 to 7.
+// It sets the verification deadline param to its default and queues the
+// records that are still pending for expiry.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.VerificationDeadlineSeconds = types.DefaultParams().VerificationDeadlineSeconds
+
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	pending, err := m.keeper.GetRecordsByStatus(ctx, types.RecordStatusPending)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range pending {
+		key := collections.Join(expiryTime(record.Timestamp, params), record.Id)
+		if err := m.keeper.ExpiryQueue.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate7to8 migrates the x/pos store from version 7 to 8.
+// It sets the data retention param to its default and queues the finalized
+// records holding data for pruning, keyed by their submission height.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.DataRetentionBlocks = types.DefaultParams().DataRetentionBlocks
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// Collect keys first so the store is not written while it is being iterated
+	var keys []collections.Pair[uint64, string]
+	err = m.keeper.Records.Walk(ctx, nil, func(id string, record types.Record) (bool, error) {
+		if record.Status != types.RecordStatusPending && len(record.Data) != 0 {
+			keys = append(keys, collections.Join(record.BlockHeight, id))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.PruneQueue.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate8to9 migrates the x/pos store from version 8 to 9.
+// It sets the upload timeout and open upload limit params to their defaults.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.UploadTimeoutBlocks = defaults.UploadTimeoutBlocks
+	params.MaxOpenUploadsPerValidator = defaults.MaxOpenUploadsPerValidator
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate9to10 migrates the x/pos store from version 9 to 10.
+// It stores the current epoch, aligned with the height based epochs records
+// were assigned so far, and backfills the epoch fields of validator stats.
+// Validators are not required to submit a record in the epoch of the upgrade.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.EpochLength == 0 {
+		return fmt.Errorf("cannot align the current epoch: epoch length is zero")
+	}
+
+	number := uint64(ctx.BlockHeight()) / params.EpochLength
+	epoch := types.EpochInfo{
+		Number:      number,
+		StartHeight: number * params.EpochLength,
+		StartTime:   ctx.BlockTime().Unix(),
+	}
+	if err := m.keeper.Epochs.Set(ctx, epoch.Number, epoch); err != nil {
+		return err
+	}
+
+	lastRecordEpochs := make(map[string]uint64)
+	err = m.keeper.Records.Walk(ctx, nil, func(_ string, record types.Record) (bool, error) {
+		if record.SupersedesId == "" && record.Epoch >= lastRecordEpochs[record.ValidatorAddress] {
+			lastRecordEpochs[record.ValidatorAddress] = record.Epoch
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Collect stats first so the store is not written while it is being iterated
+	var allStats []types.ValidatorRecordStats
+	err = m.keeper.ValidatorStats.Walk(ctx, nil, func(_ string, stats types.ValidatorRecordStats) (bool, error) {
+		allStats = append(allStats, stats)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, stats := range allStats {
+		stats.LastRecordEpoch = lastRecordEpochs[stats.ValidatorAddress]
+		stats.NextRequiredRecordEpoch = epoch.Number + 1
+		if err := m.keeper.ValidatorStats.Set(ctx, stats.ValidatorAddress, stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate10to11 migrates the x/pos store from version 10 to 11.
+// It keeps epochs measured in blocks, sets the epoch duration param to its
+// default and records how the stored epochs are measured.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.EpochMode = types.EpochModeBlocks
+	params.EpochDurationSeconds = types.DefaultParams().EpochDurationSeconds
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// Collect epochs first so the store is not written while it is being iterated
+	var epochs []types.EpochInfo
+	err = m.keeper.Epochs.Walk(ctx, nil, func(_ uint64, epoch types.EpochInfo) (bool, error) {
+		epochs = append(epochs, epoch)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, epoch := range epochs {
+		epoch = types.NewEpochInfo(epoch.Number, epoch.StartHeight, epoch.StartTime, params)
+		if err := m.keeper.Epochs.Set(ctx, epoch.Number, epoch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate11to12 migrates the x/pos store from version 11 to 12.
+// It sets the reward pool fee share param to its default, leaving the reward
+// pool to be funded by deposits.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.RewardPoolFeeShare = types.DefaultParams().RewardPoolFeeShare
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate12to13 migrates the x/pos store from version 12 to 13.
+// It sets the record fee params to their defaults, keeping submissions free.
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.RecordFeeFlat = defaults.RecordFeeFlat
+	params.RecordFeePerByte = defaults.RecordFeePerByte
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate13to14 migrates the x/pos store from version 13 to 14.
+// It keeps slashing the stake of submitters of rejected records and sets the
+// record bond params to their defaults.
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.InvalidRecordPenalty = types.InvalidRecordPenaltySlash
+	params.RecordBond = defaults.RecordBond
+	params.RecordBondForfeiture = defaults.RecordBondForfeiture
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate14to15 migrates the x/pos store from version 14 to 15.
+// It sets an empty missed epoch penalty schedule, which keeps slashing every
+// missed epoch by SlashFractionMissingRecord.
+func (m Migrator) Migrate14to15(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.MissedEpochPenalties = types.DefaultParams().MissedEpochPenalties
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate15to16 migrates the x/pos store from version 15 to 16.
+// It sets the signing window params to their defaults, which keep requiring a
+// record in every epoch, and keeps counting verified records over the
+// lifetime of validators for eligibility.
+func (m Migrator) Migrate15to16(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.SignedEpochsWindow = defaults.SignedEpochsWindow
+	params.MinSignedPerWindow = defaults.MinSignedPerWindow
+	params.VerifiedRecordsWindow = 0
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate16to17 migrates the x/pos store from version 16 to 17.
+// It sets the onboarding grace period to its default of no grace; validators
+// bonded before the upgrade have no grace period.
+func (m Migrator) Migrate16to17(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.OnboardingEpochs = types.DefaultParams().OnboardingEpochs
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate17to18 migrates the x/pos store from version 17 to 18.
+// It sets the params validators jailed for missing records unjail themselves
+// by to their defaults.
+func (m Migrator) Migrate17to18(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.UnjailVerifiedRecords = defaults.UnjailVerifiedRecords
+	params.UnjailMinJailSeconds = defaults.UnjailMinJailSeconds
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// tombstoneJailEndTime is the jail end time of tombstoned validators, the same
+// as x/evidence uses for double signers
+var tombstoneJailEndTime = time.Unix(253402300799, 0)
+
+// penalizeMissedEpoch counts an epoch the validator ended without meeting its
+// record requirement and applies the step of the missed epoch penalty schedule
+// its run of missed epochs has reached
+func (k Keeper) penalizeMissedEpoch(ctx context.Context, validatorAddr string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	stats, err := k.GetValidatorStats(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	stats.ConsecutiveMissedEpochs++
+
+	penalty, ok := params.MissedEpochPenalty(stats.ConsecutiveMissedEpochs)
+	if !ok {
+		penalty.Action = types.PenaltyNone
+	}
+
+	slashed := math.ZeroInt()
+	if penalty.SlashFraction.IsPositive() {
+		slashed, err = k.slashValidator(ctx, validatorAddr, penalty.SlashFraction, "missing_records", "")
+		if err != nil {
+			return err
+		}
+	}
+
+	switch penalty.Action {
+	case types.PenaltyJail:
+		jailedUntil := sdk.UnwrapSDKContext(ctx).BlockTime().Add(time.Duration(penalty.JailDurationSeconds) * time.Second)
+		if err := k.jailValidator(ctx, validatorAddr, jailedUntil, false); err != nil {
+			return err
+		}
+		markJailed(ctx, &stats, jailedUntil)
+	case types.PenaltyTombstone:
+		if err := k.jailValidator(ctx, validatorAddr, tombstoneJailEndTime, true); err != nil {
+			return err
+		}
+		markJailed(ctx, &stats, tombstoneJailEndTime)
+	}
+
+	if err := k.SetValidatorStats(ctx, validatorAddr, stats); err != nil {
+		return err
+	}
+
+	err = k.updateEpochActivity(ctx, validatorAddr, func(summary *types.ValidatorEpochSummary) {
+		summary.MissedEpochs = stats.ConsecutiveMissedEpochs
+		summary.Penalty = penalty.Action
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if penalty.SlashFraction.IsPositive() {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorSlashed,
+				sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
+				sdk.NewAttribute(types.AttributeKeyReason, "missing_records"),
+				sdk.NewAttribute("slash_fraction", penalty.SlashFraction.String()),
+			),
+		)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMissedEpochPenalty,
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
+			sdk.NewAttribute(types.AttributeKeyMissedEpochs, fmt.Sprintf("%d", stats.ConsecutiveMissedEpochs)),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.Action.String()),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, fmt.Sprintf("%d", stats.JailedUntil)),
+		),
+	)
+
+	return nil
+}
+
+// markJailed records in the stats of a validator that the missed epoch
+// penalties jailed it until the given time
+func markJailed(ctx context.Context, stats *types.ValidatorRecordStats, until time.Time) {
+	stats.JailedAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	stats.JailedUntil = until.Unix()
+	stats.VerifiedRecordsAtJail = stats.VerifiedRecords
+}
+
+// jailValidator jails a validator until the given time, and tombstones it so
+// it can never be unjailed when tombstone is set
+func (k Keeper) jailValidator(ctx context.Context, validatorAddr string, until time.Time, tombstone bool) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.slashingKeeper.JailUntil(ctx, consAddr, until); err != nil {
+		return err
+	}
+
+	if tombstone && !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return k.slashingKeeper.Tombstone(ctx, consAddr)
+	}
+
+	return nil
+}
+
+// UnjailForRecords unjails a validator the missed epoch penalties jailed once
+// its jail ended, it has been jailed for UnjailMinJailSeconds, got
+// UnjailVerifiedRecords records verified since and has at least its minimum
+// self-delegation. It starts over its run of missed epochs and its
+// signing window, and requires records again from the next epoch on.
+func (k Keeper) UnjailForRecords(ctx context.Context, validatorAddr string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ErrNotValidator.Wrap(err.Error())
+	}
+
+	stats, err := k.ValidatorStats.Get(ctx, validatorAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNotJailedForRecords
+		}
+		return err
+	}
+
+	if !validator.IsJailed() || stats.JailedUntil == 0 {
+		return types.ErrNotJailedForRecords
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return types.ErrUnjailNotAllowed.Wrap("validator is tombstoned")
+	}
+
+	// The self-delegation requirement of x/slashing unjailing applies as well
+	selfDel, err := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return types.ErrUnjailNotAllowed.Wrap("validator has no self-delegation")
+	} else if err != nil {
+		return err
+	}
+	if tokens := validator.TokensFromShares(selfDel.GetShares()).TruncateInt(); tokens.LT(validator.MinSelfDelegation) {
+		return types.ErrUnjailNotAllowed.Wrapf("self-delegation %s is less than %s", tokens, validator.MinSelfDelegation)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// The validator stays jailed for at least UnjailMinJailSeconds and no
+	// shorter than the jail the penalty set in x/slashing
+	minUntil := max(stats.JailedAt+int64(params.UnjailMinJailSeconds), stats.JailedUntil)
+	if sdkCtx.BlockTime().Unix() < minUntil {
+		return types.ErrUnjailNotAllowed.Wrapf("validator is jailed until %d", minUntil)
+	}
+
+	if caughtUp := stats.VerifiedRecords - stats.VerifiedRecordsAtJail; caughtUp < params.UnjailVerifiedRecords {
+		return types.ErrUnjailNotAllowed.Wrapf(
+			"%d of %d records verified since the validator was jailed",
+			caughtUp, params.UnjailVerifiedRecords,
+		)
+	}
+
+	if err := k.stakingKeeper.Unjail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	stats.JailedAt = 0
+	stats.JailedUntil = 0
+	stats.ConsecutiveMissedEpochs = 0
+	stats.IsEligible = true
+	stats.NextRequiredRecordEpoch = epoch.Number + 1
+	if err := k.SetValidatorStats(ctx, validatorAddr, stats); err != nil {
+		return err
+	}
+
+	if err := k.resetRecordSigningInfo(ctx, validatorAddr); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorUnjailed,
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
+			sdk.NewAttribute(types.AttributeKeyVerifiedRecords, fmt.Sprintf("%d", stats.VerifiedRecords-stats.VerifiedRecordsAtJail)),
+		),
+	)
+
+	return nil
+}
+
+// clearRecordJail clears the record of a validator being jailed by the missed
+// epoch penalties
+func (k Keeper) clearRecordJail(ctx context.Context, validatorAddr string) error {
+	stats, err := k.GetValidatorStats(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	if stats.JailedUntil == 0 {
+		return nil
+	}
+
+	stats.JailedAt = 0
+	stats.JailedUntil = 0
+	return k.SetValidatorStats(ctx, validatorAddr, stats)
+}
+
+// jailedForRecords reports whether a validator is jailed by the missed epoch
+// penalties and can still unjail itself
+func (k Keeper) jailedForRecords(ctx context.Context, validator stakingtypes.Validator) (bool, error) {
+	if !validator.IsJailed() {
+		return false, nil
+	}
+
+	stats, err := k.ValidatorStats.Get(ctx, validator.GetOperator())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if stats.JailedUntil == 0 {
+		return false, nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return false, err
+	}
+	return !k.slashingKeeper.IsTombstoned(ctx, consAddr), nil
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestMissedEpochPenalties(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	params.MissedEpochPenalties = []types.MissedEpochPenalty{
+		{MissedEpochs: 1, Action: types.PenaltyWarn, SlashFraction: math.LegacyZeroDec()},
+		{MissedEpochs: 2, Action: types.PenaltySlash, SlashFraction: math.LegacyNewDecWithPrec(2, 2)},
+		{MissedEpochs: 3, Action: types.PenaltyJail, SlashFraction: math.LegacyZeroDec(), JailDurationSeconds: 3600},
+		{MissedEpochs: 4, Action: types.PenaltyTombstone, SlashFraction: math.LegacyNewDecWithPrec(5, 2)},
+	}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	idle := f.addBondedValidator(t)
+	consAddr, err := f.stakingKeeper.validators[idle].GetConsAddr()
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), idle))
+
+	// the validator is required to submit from epoch 1 on and never does
+	for height := int64(10); height <= 50; height += 10 {
+		f.beginBlock(t, height)
+	}
+
+	history, err := qs.ValidatorEpochSummaries(f.ctx, &types.QueryValidatorEpochSummariesRequest{ValidatorAddress: idle})
+	require.NoError(t, err)
+	require.Len(t, history.Summaries, 5)
+
+	expected := []types.PenaltyAction{types.PenaltyNone, types.PenaltyWarn, types.PenaltySlash, types.PenaltyJail, types.PenaltyTombstone}
+	for i, summary := range history.Summaries {
+		require.Equal(t, expected[i], summary.Penalty, "epoch %d", i)
+		require.Equal(t, uint64(i), summary.MissedEpochs, "epoch %d", i)
+	}
+	require.Empty(t, history.Summaries[1].Slashes)
+	require.Len(t, history.Summaries[2].Slashes, 1)
+	require.Empty(t, history.Summaries[3].Slashes)
+	require.Len(t, history.Summaries[4].Slashes, 1)
+
+	require.Len(t, f.stakingKeeper.slashes, 2)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 2), f.stakingKeeper.slashes[0].fraction)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), f.stakingKeeper.slashes[1].fraction)
+	require.True(t, f.stakingKeeper.jailed[sdk.ConsAddress(consAddr).String()])
+	require.True(t, f.slashingKeeper.tombstoned[sdk.ConsAddress(consAddr).String()])
+
+	res, err := qs.ValidatorStats(f.ctx, &types.QueryValidatorStatsRequest{ValidatorAddress: idle})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Stats.ConsecutiveMissedEpochs)
+	require.Equal(t, int64(253402300799), res.Stats.JailedUntil)
+	require.Equal(t, int64(253402300799), f.slashingKeeper.jailUntil[sdk.ConsAddress(consAddr).String()].Unix())
+
+	// a record in the next epoch ends the run of missed epochs
+	_, err = f.keeper.CreateRecord(f.beginBlock(t, 51), idle, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	f.beginBlock(t, 60)
+
+	res, err = qs.ValidatorStats(f.ctx, &types.QueryValidatorStatsRequest{ValidatorAddress: idle})
+	require.NoError(t, err)
+	require.Zero(t, res.Stats.ConsecutiveMissedEpochs)
+}
+
+func TestMissedEpochJail(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	params.MissedEpochPenalties = []types.MissedEpochPenalty{
+		{MissedEpochs: 1, Action: types.PenaltyJail, SlashFraction: math.LegacyZeroDec(), JailDurationSeconds: 3600},
+	}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	idle := f.addBondedValidator(t)
+	consAddr, err := f.stakingKeeper.validators[idle].GetConsAddr()
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), idle))
+	f.beginBlock(t, 10)
+
+	ctx := sdk.UnwrapSDKContext(f.withBlock(20)).WithBlockTime(time.Unix(1000, 0))
+	require.NoError(t, f.keeper.AdvanceEpoch(ctx))
+
+	jailedUntil := time.Unix(1000+3600, 0)
+	require.True(t, f.stakingKeeper.jailed[sdk.ConsAddress(consAddr).String()])
+	require.Equal(t, jailedUntil.Unix(), f.slashingKeeper.jailUntil[sdk.ConsAddress(consAddr).String()].Unix())
+	require.False(t, f.slashingKeeper.tombstoned[sdk.ConsAddress(consAddr).String()])
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, idle)
+	require.NoError(t, err)
+	require.Equal(t, jailedUntil.Unix(), stats.JailedUntil)
+}
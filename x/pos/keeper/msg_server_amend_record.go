@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// AmendRecord handles the MsgAmendRecord message
+func (ms msgServer) AmendRecord(ctx context.Context, msg *types.MsgAmendRecord) (*types.MsgAmendRecordResponse, error) {
+	amendment := types.Record{
+		ValidatorAddress: msg.ValidatorAddress,
+		Data:             msg.Data,
+		MerkleRoot:       msg.MerkleRoot,
+		RecordType:       msg.RecordType,
+		ContentEncoding:  msg.ContentEncoding,
+	}
+
+	// Keep only a locator of the data when it is stored off chain
+	if msg.DataLocator != "" {
+		if len(msg.Data) != 0 {
+			return nil, types.ErrInvalidDataLocator.Wrap("off-chain records cannot carry data")
+		}
+		amendment.DataLocator = msg.DataLocator
+		amendment.DataSize = msg.DataSize
+	}
+
+	recordID, err := ms.k.AmendRecord(ctx, msg.SupersedesId, amendment)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := ms.k.GetRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAmendRecordResponse{
+		RecordId:  recordID,
+		Timestamp: record.Timestamp,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func amendMsg(validator, supersedesID string, seed byte) *types.MsgAmendRecord {
+	return &types.MsgAmendRecord{
+		ValidatorAddress: validator,
+		SupersedesId:     supersedesID,
+		Data:             recordData(seed),
+		MerkleRoot:       types.RecordMerkleRoot(recordData(seed)),
+	}
+}
+
+func TestAmendPendingRecord(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	val := f.addBondedValidator(t)
+	other := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.RecordsPerEpoch = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := f.withBlock(1)
+	originalID, err := f.keeper.CreateRecord(ctx, val, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	_, err = ms.AmendRecord(ctx, amendMsg(other, originalID, 2))
+	require.ErrorIs(t, err, types.ErrNotValidator)
+
+	// amendments do not count against the epoch limit
+	res, err := ms.AmendRecord(ctx, amendMsg(val, originalID, 2))
+	require.NoError(t, err)
+
+	_, err = f.keeper.CreateRecord(ctx, val, "", recordData(3), types.RecordMerkleRoot(recordData(3)))
+	require.ErrorIs(t, err, types.ErrEpochRecordsExceeded)
+
+	original, err := f.keeper.GetRecord(f.ctx, originalID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusSuperseded, original.Status)
+	require.Equal(t, res.RecordId, original.SupersededBy)
+
+	amended, err := f.keeper.GetRecord(f.ctx, res.RecordId)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, amended.Status)
+	require.Equal(t, originalID, amended.SupersedesId)
+	require.Equal(t, uint64(1), amended.Version)
+	require.Equal(t, recordData(2), amended.Data)
+
+	// only the latest version can be amended
+	_, err = ms.AmendRecord(ctx, amendMsg(val, originalID, 4))
+	require.ErrorIs(t, err, types.ErrRecordNotAmendable)
+
+	latest, err := ms.AmendRecord(f.withBlock(2), amendMsg(val, res.RecordId, 4))
+	require.NoError(t, err)
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, val)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.TotalRecords)
+	require.Equal(t, uint64(2), stats.AmendedRecords)
+
+	// the whole chain is returned oldest first from any of its versions
+	for _, id := range []string{originalID, res.RecordId, latest.RecordId} {
+		versions, err := keeper.NewQueryServerImpl(f.keeper).RecordVersions(f.ctx, &types.QueryRecordVersionsRequest{RecordId: id})
+		require.NoError(t, err)
+		require.Len(t, versions.Versions, 3)
+		require.Equal(t, originalID, versions.Versions[0].Id)
+		require.Equal(t, res.RecordId, versions.Versions[1].Id)
+		require.Equal(t, latest.RecordId, versions.Versions[2].Id)
+	}
+}
+
+func TestAmendSettledRecord(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	val := f.addBondedValidator(t)
+
+	ctx := f.withBlock(1)
+	rejectedID, err := f.keeper.CreateRecord(ctx, val, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(ctx, rejectedID, false))
+
+	verifiedID, err := f.keeper.CreateRecord(ctx, val, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(ctx, verifiedID, true))
+
+	_, err = ms.AmendRecord(ctx, amendMsg(val, verifiedID, 3))
+	require.ErrorIs(t, err, types.ErrRecordNotAmendable)
+
+	// a rejected record stays rejected once amended
+	res, err := ms.AmendRecord(ctx, amendMsg(val, rejectedID, 3))
+	require.NoError(t, err)
+
+	rejected, err := f.keeper.GetRecord(f.ctx, rejectedID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusRejected, rejected.Status)
+	require.Equal(t, res.RecordId, rejected.SupersededBy)
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, val)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.RejectedRecords)
+}
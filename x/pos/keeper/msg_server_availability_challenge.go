@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// ChallengeAvailability handles the MsgChallengeAvailability message
+func (ms msgServer) ChallengeAvailability(ctx context.Context, msg *types.MsgChallengeAvailability) (*types.MsgChallengeAvailabilityResponse, error) {
+	// Only bonded validators can challenge, like verifiers
+	if _, err := ms.bondedVerifier(ctx, msg.Challenger); err != nil {
+		return nil, err
+	}
+
+	challenge, err := ms.k.ChallengeAvailability(ctx, msg.RecordId, msg.Challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChallengeAvailabilityResponse{
+		ChallengeId:    challenge.Id,
+		ChunkIndex:     challenge.ChunkIndex,
+		DeadlineHeight: challenge.DeadlineHeight,
+	}, nil
+}
+
+// RespondAvailabilityChallenge handles the MsgRespondAvailabilityChallenge message
+func (ms msgServer) RespondAvailabilityChallenge(ctx context.Context, msg *types.MsgRespondAvailabilityChallenge) (*types.MsgRespondAvailabilityChallengeResponse, error) {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return nil, fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	if err := ms.k.RespondAvailabilityChallenge(
+		ctx,
+		msg.ChallengeId,
+		msg.ValidatorAddress,
+		msg.Data,
+		msg.Chunk,
+		msg.Proof,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRespondAvailabilityChallengeResponse{}, nil
+}
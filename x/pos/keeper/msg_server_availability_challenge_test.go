@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/offchain"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestAvailabilityChallenge(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	submitter := f.addBondedValidator(t)
+	challenger := f.addBondedValidator(t)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	challengerAddr, err := sdk.ValAddressFromBech32(challenger)
+	require.NoError(t, err)
+	challengerAcc := sdk.AccAddress(challengerAddr).String()
+	f.bankKeeper.balances[challengerAcc] = sdk.NewCoins(params.ChallengeBond.Add(params.ChallengeBond))
+
+	store := offchain.NewMemStore()
+	data := bytes.Repeat([]byte("off-chain"), 500)
+	locator, err := store.Put(data)
+	require.NoError(t, err)
+
+	res, err := ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		MerkleRoot:       types.RecordMerkleRoot(data),
+		DataLocator:      locator,
+		DataSize:         uint64(len(data)),
+	})
+	require.NoError(t, err)
+	recordID := res.RecordId
+
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.True(t, record.IsOffChain())
+	require.Empty(t, record.Data)
+
+	// on-chain records and own records cannot be challenged
+	onChainID, err := f.keeper.CreateRecord(f.withBlock(1), challenger, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	_, err = ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: submitter, RecordId: onChainID})
+	require.ErrorIs(t, err, types.ErrRecordDataOnChain)
+	_, err = ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: submitter, RecordId: recordID})
+	require.ErrorIs(t, err, types.ErrSelfChallenge)
+
+	challenge, err := ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: recordID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(102), challenge.DeadlineHeight)
+	require.Equal(t, sdk.NewCoins(params.ChallengeBond), f.bankKeeper.balances[challengerAcc])
+
+	_, err = ms.ChallengeAvailability(f.withBlock(3), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: recordID})
+	require.ErrorIs(t, err, types.ErrChallengeOpen)
+
+	// the submitter answers with the challenged chunk fetched from the store
+	stored, err := offchain.Fetch(store, record.DataLocator, record.MerkleRoot)
+	require.NoError(t, err)
+
+	wrongIndex := (challenge.ChunkIndex + 1) % record.ChunkCount()
+	chunk, proof, err := types.RecordChunkProof(stored, wrongIndex)
+	require.NoError(t, err)
+	_, err = ms.RespondAvailabilityChallenge(f.withBlock(3), &types.MsgRespondAvailabilityChallenge{
+		ValidatorAddress: submitter,
+		ChallengeId:      challenge.ChallengeId,
+		Chunk:            chunk,
+		Proof:            &proof,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidChallengeProof)
+
+	chunk, proof, err = types.RecordChunkProof(stored, challenge.ChunkIndex)
+	require.NoError(t, err)
+	_, err = ms.RespondAvailabilityChallenge(f.withBlock(3), &types.MsgRespondAvailabilityChallenge{
+		ValidatorAddress: submitter,
+		ChallengeId:      challenge.ChallengeId,
+		Chunk:            chunk,
+		Proof:            &proof,
+	})
+	require.NoError(t, err)
+
+	resp, err := qs.AvailabilityChallenge(f.ctx, &types.QueryAvailabilityChallengeRequest{Id: challenge.ChallengeId})
+	require.NoError(t, err)
+	require.Equal(t, types.ChallengeStatusAnswered, resp.Challenge.Status)
+	require.Equal(t, params.ChallengeBond, resp.Challenge.Bond)
+	require.Equal(t, sdk.NewCoins(params.ChallengeBond), f.bankKeeper.burned)
+
+	// a second challenge is left unanswered and fails at its deadline
+	second, err := ms.ChallengeAvailability(f.withBlock(4), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: recordID})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ProcessChallengeQueue(f.withBlock(int64(second.DeadlineHeight)-1)))
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	deadline := f.withBlock(int64(second.DeadlineHeight))
+	_, err = ms.RespondAvailabilityChallenge(deadline, &types.MsgRespondAvailabilityChallenge{
+		ValidatorAddress: submitter,
+		ChallengeId:      second.ChallengeId,
+		Data:             stored,
+	})
+	require.ErrorIs(t, err, types.ErrChallengeClosed)
+
+	require.NoError(t, f.keeper.ProcessChallengeQueue(deadline))
+	require.Len(t, f.stakingKeeper.slashes, 1)
+	require.Equal(t, sdk.NewCoins(params.ChallengeBond), f.bankKeeper.balances[challengerAcc])
+
+	challenges, err := qs.AvailabilityChallenges(f.ctx, &types.QueryAvailabilityChallengesRequest{RecordId: recordID})
+	require.NoError(t, err)
+	require.Len(t, challenges.Challenges, 2)
+	require.Equal(t, types.ChallengeStatusFailed, challenges.Challenges[1].Status)
+}
+
+func TestAvailabilityChallengeRecordStatus(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	submitter := f.addBondedValidator(t)
+	challenger := f.addBondedValidator(t)
+
+	data := bytes.Repeat([]byte("off-chain"), 500)
+	locator, err := offchain.NewMemStore().Put(data)
+	require.NoError(t, err)
+	res, err := ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		MerkleRoot:       types.RecordMerkleRoot(data),
+		DataLocator:      locator,
+		DataSize:         uint64(len(data)),
+	})
+	require.NoError(t, err)
+
+	// a challenger without the bond cannot challenge
+	_, err = ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: res.RecordId})
+	require.ErrorContains(t, err, "insufficient funds")
+
+	record, err := f.keeper.GetRecord(f.ctx, res.RecordId)
+	require.NoError(t, err)
+	for _, status := range []types.RecordStatus{types.RecordStatusRejected, types.RecordStatusExpired, types.RecordStatusSuperseded} {
+		record.Status = status
+		require.NoError(t, f.keeper.Records.Set(f.ctx, record.Id, record))
+
+		_, err = ms.ChallengeAvailability(f.withBlock(2), &types.MsgChallengeAvailability{Challenger: challenger, RecordId: record.Id})
+		require.ErrorIs(t, err, types.ErrRecordNotChallengeable, status.String())
+	}
+}
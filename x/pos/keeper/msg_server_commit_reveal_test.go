@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestCommitRevealVerification(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommitRevealEnabled = true
+	params.CommitPeriodBlocks = 10
+	params.RevealPeriodBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	verifiers := []string{f.addBondedValidator(t), f.addBondedValidator(t), f.addBondedValidator(t)}
+
+	recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	// direct votes are refused while commit-reveal is enabled
+	_, err = ms.VerifyRecord(f.withBlock(2), &types.MsgVerifyRecord{
+		Verifier: verifiers[0],
+		RecordId: recordID,
+		Approved: true,
+	})
+	require.ErrorIs(t, err, types.ErrCommitRevealRequired)
+
+	salt := []byte("salt")
+	commit := func(height int64, verifier string, approved bool) (*types.MsgCommitVerificationResponse, error) {
+		return ms.CommitVerification(f.withBlock(height), &types.MsgCommitVerification{
+			Verifier:   verifier,
+			RecordId:   recordID,
+			Commitment: types.VerificationCommitment(recordID, verifier, approved, salt),
+		})
+	}
+	reveal := func(height int64, verifier string, approved bool) error {
+		_, err := ms.RevealVerification(f.withBlock(height), &types.MsgRevealVerification{
+			Verifier: verifier,
+			RecordId: recordID,
+			Approved: approved,
+			Salt:     salt,
+		})
+		return err
+	}
+
+	for _, verifier := range verifiers {
+		res, err := commit(2, verifier, true)
+		require.NoError(t, err)
+		require.Equal(t, uint64(11), res.RevealStartHeight)
+		require.Equal(t, uint64(21), res.RevealEndHeight)
+	}
+
+	_, err = commit(3, verifiers[0], true)
+	require.ErrorIs(t, err, types.ErrDuplicateVote)
+	_, err = commit(11, submitter, true)
+	require.ErrorIs(t, err, types.ErrCommitPhaseClosed)
+
+	commits, err := qs.RecordCommits(f.ctx, &types.QueryRecordCommitsRequest{RecordId: recordID})
+	require.NoError(t, err)
+	require.Len(t, commits.Commits, 3)
+
+	require.ErrorIs(t, reveal(10, verifiers[0], true), types.ErrRevealPhaseNotOpen)
+	require.ErrorIs(t, reveal(11, verifiers[0], false), types.ErrCommitmentMismatch)
+	require.NoError(t, reveal(11, verifiers[0], true))
+	require.ErrorIs(t, reveal(12, verifiers[0], true), types.ErrDuplicateVote)
+	require.NoError(t, reveal(12, verifiers[1], true))
+
+	// revealed votes are only counted once the reveal phase has ended
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, record.Status)
+
+	require.NoError(t, f.keeper.ProcessRevealQueue(f.withBlock(20)))
+	record, err = f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, record.Status)
+
+	require.NoError(t, f.keeper.ProcessRevealQueue(f.withBlock(21)))
+	record, err = f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusVerified, record.Status)
+
+	// the verifier that never revealed is slashed and all commits are pruned
+	require.Len(t, f.stakingKeeper.slashes, 1)
+	require.Equal(t, params.SlashFractionUnrevealedCommit, f.stakingKeeper.slashes[0].fraction)
+
+	commits, err = qs.RecordCommits(f.ctx, &types.QueryRecordCommitsRequest{RecordId: recordID})
+	require.NoError(t, err)
+	require.Empty(t, commits.Commits)
+}
+
+func TestCommitVerificationDisabled(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	submitter := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+
+	recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	_, err = ms.CommitVerification(f.withBlock(2), &types.MsgCommitVerification{
+		Verifier:   verifier,
+		RecordId:   recordID,
+		Commitment: types.VerificationCommitment(recordID, verifier, true, []byte("salt")),
+	})
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+}
+
+func TestCommitRevealRecordAmended(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommitRevealEnabled = true
+	params.CommitPeriodBlocks = 10
+	params.RevealPeriodBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+
+	recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	_, err = ms.CommitVerification(f.withBlock(2), &types.MsgCommitVerification{
+		Verifier:   verifier,
+		RecordId:   recordID,
+		Commitment: types.VerificationCommitment(recordID, verifier, true, salt),
+	})
+	require.NoError(t, err)
+
+	// the record is amended in the reveal phase, before the verifier reveals
+	_, err = ms.AmendRecord(f.withBlock(11), amendMsg(submitter, recordID, 2))
+	require.NoError(t, err)
+
+	_, err = ms.RevealVerification(f.withBlock(12), &types.MsgRevealVerification{
+		Verifier: verifier,
+		RecordId: recordID,
+		Approved: true,
+		Salt:     salt,
+	})
+	require.ErrorIs(t, err, types.ErrRecordAlreadyVerified)
+
+	require.NoError(t, f.keeper.ProcessRevealQueue(f.withBlock(21)))
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	commits, err := qs.RecordCommits(f.ctx, &types.QueryRecordCommitsRequest{RecordId: recordID})
+	require.NoError(t, err)
+	require.Empty(t, commits.Commits)
+}
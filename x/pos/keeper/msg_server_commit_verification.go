@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// CommitVerification handles the MsgCommitVerification message
+func (ms msgServer) CommitVerification(ctx context.Context, msg *types.MsgCommitVerification) (*types.MsgCommitVerificationResponse, error) {
+	validator, err := ms.bondedVerifier(ctx, msg.Verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := ms.k.CommitVerification(ctx, msg.RecordId, validator, msg.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitVerificationResponse{
+		RevealStartHeight: commit.RevealStartHeight,
+		RevealEndHeight:   commit.RevealEndHeight,
+	}, nil
+}
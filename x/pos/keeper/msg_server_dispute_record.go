@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// DisputeRecord handles the MsgDisputeRecord message
+func (ms msgServer) DisputeRecord(ctx context.Context, msg *types.MsgDisputeRecord) (*types.MsgDisputeRecordResponse, error) {
+	if _, err := ms.k.DisputeRecord(ctx, msg.RecordId, msg.Disputer, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisputeRecordResponse{}, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestOptimisticVerification(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// records submitted before optimistic verification is enabled are not affected
+	submitter := f.addBondedValidator(t)
+	regularID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.OptimisticVerificationEnabled = true
+	params.DisputeWindowBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), record.DisputeDeadlineHeight)
+
+	disputer := sdk.AccAddress("disputer").String()
+	_, err = ms.DisputeRecord(f.withBlock(2), &types.MsgDisputeRecord{Disputer: disputer, RecordId: regularID})
+	require.ErrorIs(t, err, types.ErrNotOptimistic)
+	_, err = ms.DisputeRecord(f.withBlock(2), &types.MsgDisputeRecord{
+		Disputer: disputer,
+		RecordId: recordID,
+		Reason:   strings.Repeat("a", types.MaxDisputeReasonLength+1),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDisputeReason)
+	_, err = ms.DisputeRecord(f.withBlock(11), &types.MsgDisputeRecord{Disputer: disputer, RecordId: recordID})
+	require.ErrorIs(t, err, types.ErrDisputeWindowClosed)
+
+	require.NoError(t, f.keeper.ProcessDisputeWindowQueue(f.withBlock(10)))
+	record, err = f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, record.Status)
+
+	require.NoError(t, f.keeper.ProcessDisputeWindowQueue(f.withBlock(11)))
+	record, err = f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusVerified, record.Status)
+
+	regular, err := f.keeper.GetRecord(f.ctx, regularID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, regular.Status)
+}
+
+func TestMsgDisputeRecord(t *testing.T) {
+	testCases := []struct {
+		name      string
+		approve   bool
+		expStatus types.DisputeStatus
+		expRefund bool
+	}{
+		{
+			name:      "upheld when the record is rejected",
+			approve:   false,
+			expStatus: types.DisputeStatusUpheld,
+			expRefund: true,
+		},
+		{
+			name:      "dismissed when the record is verified",
+			approve:   true,
+			expStatus: types.DisputeStatusDismissed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			qs := keeper.NewQueryServerImpl(f.keeper)
+
+			params := types.DefaultParams()
+			params.OptimisticVerificationEnabled = true
+			params.DisputeWindowBlocks = 10
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			// three verifiers with equal stake: a 33.4% quorum needs two votes
+			submitter := f.addBondedValidator(t)
+			verifiers := []string{f.addBondedValidator(t), f.addBondedValidator(t), f.addBondedValidator(t)}
+
+			recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+			require.NoError(t, err)
+
+			disputer := sdk.AccAddress("disputer")
+			f.bankKeeper.balances[disputer.String()] = sdk.NewCoins(params.DisputeBond)
+
+			msg := &types.MsgDisputeRecord{Disputer: disputer.String(), RecordId: recordID, Reason: "bad data"}
+			_, err = ms.DisputeRecord(f.withBlock(2), msg)
+			require.NoError(t, err)
+			require.True(t, f.bankKeeper.balances[disputer.String()].IsZero())
+
+			_, err = ms.DisputeRecord(f.withBlock(3), msg)
+			require.ErrorIs(t, err, types.ErrRecordDisputed)
+
+			// a disputed record is not verified when its window ends
+			require.NoError(t, f.keeper.ProcessDisputeWindowQueue(f.withBlock(11)))
+			record, err := f.keeper.GetRecord(f.ctx, recordID)
+			require.NoError(t, err)
+			require.Equal(t, types.RecordStatusPending, record.Status)
+
+			for _, verifier := range verifiers[:2] {
+				_, err := ms.VerifyRecord(f.withBlock(12), &types.MsgVerifyRecord{
+					Verifier: verifier,
+					RecordId: recordID,
+					Approved: tc.approve,
+				})
+				require.NoError(t, err)
+			}
+
+			resp, err := qs.RecordDispute(f.ctx, &types.QueryRecordDisputeRequest{RecordId: recordID})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, resp.Dispute.Status)
+
+			module := authtypes.NewModuleAddress(types.ModuleName).String()
+			require.True(t, f.bankKeeper.balances[module].IsZero())
+			if tc.expRefund {
+				require.Equal(t, sdk.NewCoins(params.DisputeBond), f.bankKeeper.balances[disputer.String()])
+				require.True(t, f.bankKeeper.burned.IsZero())
+			} else {
+				require.True(t, f.bankKeeper.balances[disputer.String()].IsZero())
+				require.Equal(t, sdk.NewCoins(params.DisputeBond), f.bankKeeper.burned)
+			}
+
+			disputes, err := qs.RecordDisputes(f.ctx, &types.QueryRecordDisputesRequest{Status: tc.expStatus})
+			require.NoError(t, err)
+			require.Len(t, disputes.Disputes, 1)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// FundRewardPool handles the MsgFundRewardPool message
+func (ms msgServer) FundRewardPool(ctx context.Context, msg *types.MsgFundRewardPool) (*types.MsgFundRewardPoolResponse, error) {
+	if err := ms.k.FundRewardPool(ctx, msg.Depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundRewardPoolResponse{}, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// SetRecordType handles the MsgSetRecordType message
+func (ms msgServer) SetRecordType(ctx context.Context, req *types.MsgSetRecordType) (*types.MsgSetRecordTypeResponse, error) {
+	if err := ms.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetRecordType(ctx, req.RecordType); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordTypeSet,
+			sdk.NewAttribute(types.AttributeKeyRecordType, req.RecordType.Id),
+		),
+	)
+
+	return &types.MsgSetRecordTypeResponse{}, nil
+}
+
+// RemoveRecordType handles the MsgRemoveRecordType message
+func (ms msgServer) RemoveRecordType(ctx context.Context, req *types.MsgRemoveRecordType) (*types.MsgRemoveRecordTypeResponse, error) {
+	if err := ms.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.RemoveRecordType(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordTypeRemove,
+			sdk.NewAttribute(types.AttributeKeyRecordType, req.Id),
+		),
+	)
+
+	return &types.MsgRemoveRecordTypeResponse{}, nil
+}
+
+// checkAuthority checks that the signer is the module authority
+func (ms msgServer) checkAuthority(signer string) error {
+	authority, err := ms.k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(ms.k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := ms.k.addressCodec.BytesToString(ms.k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, signer)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestMsgSetRecordType(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	recordType := types.RecordType{
+		Id:          "reading",
+		Description: "a sensor reading",
+		Schema:      `{"type": "object", "required": ["value"], "properties": {"value": {"type": "number"}}}`,
+		MaxDataSize: 1024,
+	}
+
+	// only the authority can register record types
+	other, err := f.addressCodec.BytesToString(bytes.Repeat([]byte{1}, 20))
+	require.NoError(t, err)
+	_, err = ms.SetRecordType(f.ctx, &types.MsgSetRecordType{Authority: other, RecordType: recordType})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	invalid := recordType
+	invalid.Schema = `{"type": "date"}`
+	_, err = ms.SetRecordType(f.ctx, &types.MsgSetRecordType{Authority: authority, RecordType: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidRecordType)
+
+	_, err = ms.SetRecordType(f.ctx, &types.MsgSetRecordType{Authority: authority, RecordType: recordType})
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).RecordType(f.ctx, &types.QueryRecordTypeRequest{Id: recordType.Id})
+	require.NoError(t, err)
+	require.Equal(t, recordType, res.RecordType)
+
+	_, err = ms.RemoveRecordType(f.ctx, &types.MsgRemoveRecordType{Authority: authority, Id: recordType.Id})
+	require.NoError(t, err)
+	_, err = ms.RemoveRecordType(f.ctx, &types.MsgRemoveRecordType{Authority: authority, Id: recordType.Id})
+	require.ErrorIs(t, err, types.ErrRecordTypeNotFound)
+}
+
+func TestSubmitTypedRecord(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	submitter := f.addBondedValidator(t)
+
+	require.NoError(t, f.keeper.SetRecordType(f.ctx, types.RecordType{
+		Id:          "reading",
+		Schema:      `{"type": "object", "required": ["value"], "properties": {"value": {"type": "number"}}}`,
+		MaxDataSize: 200,
+	}))
+
+	// reading returns a JSON reading padded by a note of noteLen bytes
+	reading := func(value string, noteLen int) []byte {
+		return []byte(fmt.Sprintf(`{"value": %s, "note": "%s"}`, value, strings.Repeat("x", noteLen)))
+	}
+
+	submit := func(recordType string, data []byte) (*types.MsgSubmitRecordResponse, error) {
+		return ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+			ValidatorAddress: submitter,
+			Data:             data,
+			MerkleRoot:       types.RecordMerkleRoot(data),
+			RecordType:       recordType,
+		})
+	}
+
+	res, err := submit("reading", reading("21.5", 100))
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(f.ctx, res.RecordId)
+	require.NoError(t, err)
+	require.Equal(t, "reading", record.RecordType)
+
+	_, err = submit("reading", reading(`"warm"`, 100))
+	require.ErrorIs(t, err, types.ErrSchemaValidation)
+
+	// the record type narrows the size bounds of the params
+	_, err = submit("reading", reading("1", 200))
+	require.ErrorIs(t, err, types.ErrInvalidRecordSize)
+
+	_, err = submit("unknown", reading("1", 100))
+	require.ErrorIs(t, err, types.ErrRecordTypeNotFound)
+
+	// untyped records stay opaque bytes
+	_, err = submit("", recordData(1))
+	require.NoError(t, err)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// BeginRecordUpload handles the MsgBeginRecordUpload message
+func (ms msgServer) BeginRecordUpload(ctx context.Context, msg *types.MsgBeginRecordUpload) (*types.MsgBeginRecordUploadResponse, error) {
+	upload, err := ms.k.BeginRecordUpload(
+		ctx,
+		msg.ValidatorAddress,
+		msg.MerkleRoot,
+		msg.TotalSize,
+		msg.RecordType,
+		msg.ContentEncoding,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBeginRecordUploadResponse{
+		UploadId:       upload.Id,
+		DeadlineHeight: upload.DeadlineHeight,
+	}, nil
+}
+
+// UploadRecordChunk handles the MsgUploadRecordChunk message
+func (ms msgServer) UploadRecordChunk(ctx context.Context, msg *types.MsgUploadRecordChunk) (*types.MsgUploadRecordChunkResponse, error) {
+	upload, err := ms.k.UploadRecordChunk(ctx, msg.ValidatorAddress, msg.UploadId, msg.Index, msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUploadRecordChunkResponse{ReceivedSize: upload.ReceivedSize}, nil
+}
+
+// FinalizeRecord handles the MsgFinalizeRecord message
+func (ms msgServer) FinalizeRecord(ctx context.Context, msg *types.MsgFinalizeRecord) (*types.MsgFinalizeRecordResponse, error) {
+	recordID, err := ms.k.FinalizeRecord(ctx, msg.ValidatorAddress, msg.UploadId)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := ms.k.GetRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFinalizeRecordResponse{
+		RecordId:  recordID,
+		Timestamp: record.Timestamp,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RevealVerification handles the MsgRevealVerification message
+func (ms msgServer) RevealVerification(ctx context.Context, msg *types.MsgRevealVerification) (*types.MsgRevealVerificationResponse, error) {
+	// The verifier does not need to be bonded anymore to open its commit
+	if _, err := sdk.ValAddressFromBech32(msg.Verifier); err != nil {
+		return nil, fmt.Errorf("invalid verifier address: %w", err)
+	}
+
+	if err := ms.k.RevealVerification(ctx, msg.RecordId, msg.Verifier, msg.Approved, msg.Salt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealVerificationResponse{}, nil
+}
@@ -8,8 +8,22 @@ import (
 
 // SubmitRecord handles the MsgSubmitRecord message
 func (ms msgServer) SubmitRecord(ctx context.Context, msg *types.MsgSubmitRecord) (*types.MsgSubmitRecordResponse, error) {
-	// Create the record
-	recordID, err := ms.k.CreateRecord(ctx, msg.ValidatorAddress, msg.Data, msg.MerkleRoot)
+	// Create the record, keeping only a locator of the data when it is stored off chain
+	var (
+		recordID string
+		err      error
+	)
+	if msg.DataLocator != "" {
+		if len(msg.Data) != 0 {
+			return nil, types.ErrInvalidDataLocator.Wrap("off-chain records cannot carry data")
+		}
+		if msg.ContentEncoding != types.ContentEncodingIdentity {
+			return nil, types.ErrInvalidContentEncoding.Wrap("off-chain records cannot be encoded")
+		}
+		recordID, err = ms.k.CreateOffChainRecord(ctx, msg.ValidatorAddress, msg.RecordType, msg.MerkleRoot, msg.DataSize, msg.DataLocator)
+	} else {
+		recordID, err = ms.k.CreateEncodedRecord(ctx, msg.ValidatorAddress, msg.RecordType, msg.ContentEncoding, msg.Data, msg.MerkleRoot)
+	}
 	if err != nil {
 		return nil, err
 	}
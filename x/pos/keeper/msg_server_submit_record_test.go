@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestSubmitEncodedRecord(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	submitter := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.MaxRecordSize = 4096
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// compresses well below the min record size
+	payload := bytes.Repeat([]byte(`{"value": 1}`), 100)
+	encoded, err := types.EncodeRecordData(types.ContentEncodingGzip, payload)
+	require.NoError(t, err)
+	require.Less(t, uint64(len(encoded)), params.MinRecordSize)
+
+	res, err := ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		Data:             encoded,
+		MerkleRoot:       types.RecordMerkleRoot(encoded),
+		ContentEncoding:  types.ContentEncodingGzip,
+	})
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(f.ctx, res.RecordId)
+	require.NoError(t, err)
+	require.Equal(t, encoded, record.Data)
+	require.Equal(t, uint64(len(encoded)), record.DataSize)
+	require.Equal(t, uint64(len(payload)), record.DecodedSize)
+
+	decoded, err := qs.Record(f.ctx, &types.QueryRecordRequest{Id: res.RecordId, Decode: true})
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded.DecodedData)
+
+	plain, err := qs.Record(f.ctx, &types.QueryRecordRequest{Id: res.RecordId})
+	require.NoError(t, err)
+	require.Empty(t, plain.DecodedData)
+
+	// the size limits apply to the decoded data
+	tooLarge, err := types.EncodeRecordData(types.ContentEncodingZstd, make([]byte, params.MaxRecordSize+1))
+	require.NoError(t, err)
+	_, err = ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		Data:             tooLarge,
+		MerkleRoot:       types.RecordMerkleRoot(tooLarge),
+		ContentEncoding:  types.ContentEncodingZstd,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRecordSize)
+
+	// data that doesn't match its declared encoding is rejected
+	_, err = ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		Data:             recordData(1),
+		MerkleRoot:       types.RecordMerkleRoot(recordData(1)),
+		ContentEncoding:  types.ContentEncodingGzip,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidContentEncoding)
+
+	_, err = ms.SubmitRecord(f.withBlock(1), &types.MsgSubmitRecord{
+		ValidatorAddress: submitter,
+		MerkleRoot:       types.RecordMerkleRoot(recordData(1)),
+		DataLocator:      "mem://" + types.RecordMerkleRoot(recordData(1)),
+		DataSize:         128,
+		ContentEncoding:  types.ContentEncodingGzip,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidContentEncoding)
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// UnjailForRecords handles the MsgUnjailForRecords message
+func (ms msgServer) UnjailForRecords(ctx context.Context, msg *types.MsgUnjailForRecords) (*types.MsgUnjailForRecordsResponse, error) {
+	if err := ms.k.UnjailForRecords(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailForRecordsResponse{}, nil
+}
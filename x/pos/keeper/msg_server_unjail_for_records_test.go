@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestMsgUnjailForRecords(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	params.MissedEpochPenalties = []types.MissedEpochPenalty{
+		{MissedEpochs: 1, Action: types.PenaltyJail, SlashFraction: math.LegacyZeroDec(), JailDurationSeconds: 24 * 60 * 60},
+	}
+	params.UnjailVerifiedRecords = 2
+	params.UnjailMinJailSeconds = 600
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	validator := f.addBondedValidator(t)
+	consAddr, err := f.stakingKeeper.validators[validator].GetConsAddr()
+	require.NoError(t, err)
+	msg := &types.MsgUnjailForRecords{ValidatorAddress: validator}
+
+	_, err = ms.UnjailForRecords(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotJailedForRecords)
+
+	// the validator misses epoch 1 and is jailed as it ends
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), validator))
+	f.beginBlock(t, 10)
+	blockAt := func(height, unix int64) sdk.Context {
+		return sdk.UnwrapSDKContext(f.withBlock(height)).WithBlockTime(time.Unix(unix, 0))
+	}
+	require.NoError(t, f.keeper.AdvanceEpoch(blockAt(20, 1000)))
+	require.True(t, f.stakingKeeper.jailed[sdk.ConsAddress(consAddr).String()])
+
+	jailed := f.stakingKeeper.validators[validator]
+	jailed.Jailed = true
+	jailed.Status = stakingtypes.Unbonding
+	f.stakingKeeper.validators[validator] = jailed
+
+	_, err = ms.UnjailForRecords(blockAt(21, 1100), msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+
+	// the jailed validator catches up on records
+	for seed := byte(1); seed <= 2; seed++ {
+		_, err = ms.UnjailForRecords(blockAt(22, 1600), msg)
+		require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+
+		recordID, err := f.keeper.CreateRecord(blockAt(22, 1600), validator, "", recordData(seed), types.RecordMerkleRoot(recordData(seed)))
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.VerifyRecord(blockAt(22, 1600), recordID, true))
+	}
+
+	// the jail set in x/slashing has not ended yet
+	_, err = ms.UnjailForRecords(blockAt(23, 1700), msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+	_, err = ms.UnjailForRecords(blockAt(23, 1000+24*60*60-1), msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+	jailEnd := blockAt(24, 1000+24*60*60)
+
+	f.slashingKeeper.tombstoned[sdk.ConsAddress(consAddr).String()] = true
+	_, err = ms.UnjailForRecords(jailEnd, msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+	delete(f.slashingKeeper.tombstoned, sdk.ConsAddress(consAddr).String())
+
+	// the self-delegation is below the minimum
+	selfDel := f.stakingKeeper.delegations[validator]
+	jailed.MinSelfDelegation = jailed.Tokens.AddRaw(1)
+	f.stakingKeeper.validators[validator] = jailed
+	_, err = ms.UnjailForRecords(jailEnd, msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+	jailed.MinSelfDelegation = math.OneInt()
+	f.stakingKeeper.validators[validator] = jailed
+
+	delete(f.stakingKeeper.delegations, validator)
+	_, err = ms.UnjailForRecords(jailEnd, msg)
+	require.ErrorIs(t, err, types.ErrUnjailNotAllowed)
+	f.stakingKeeper.delegations[validator] = selfDel
+
+	_, err = ms.UnjailForRecords(jailEnd, msg)
+	require.NoError(t, err)
+	require.False(t, f.stakingKeeper.jailed[sdk.ConsAddress(consAddr).String()])
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, validator)
+	require.NoError(t, err)
+	require.Zero(t, stats.JailedUntil)
+	require.Zero(t, stats.ConsecutiveMissedEpochs)
+	require.True(t, stats.IsEligible)
+	require.Equal(t, uint64(3), stats.NextRequiredRecordEpoch)
+
+	has, err := f.keeper.RecordSigningInfos.Has(f.ctx, validator)
+	require.NoError(t, err)
+	require.False(t, has)
+}
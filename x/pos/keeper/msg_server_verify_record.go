@@ -5,64 +5,45 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
 // VerifyRecord handles the MsgVerifyRecord message
 func (ms msgServer) VerifyRecord(ctx context.Context, msg *types.MsgVerifyRecord) (*types.MsgVerifyRecordResponse, error) {
-	// Authorization: Only bonded validators can verify records
-	verifierAddr, err := sdk.ValAddressFromBech32(msg.Verifier)
+	validator, err := ms.bondedVerifier(ctx, msg.Verifier)
 	if err != nil {
-		// Not a validator address, return error
-		return nil, fmt.Errorf("invalid verifier address: %w", err)
+		return nil, err
 	}
 
-	validator, err := ms.k.stakingKeeper.GetValidator(ctx, verifierAddr)
+	// Record the vote; the record is finalized (and the submitter slashed on
+	// rejection) once enough bonded stake has voted
+	status, err := ms.k.VoteOnRecord(ctx, msg.RecordId, validator, msg.Approved)
 	if err != nil {
-		return nil, fmt.Errorf("verifier is not a validator: %w", err)
+		return nil, err
 	}
 
-	if !validator.IsBonded() {
-		return nil, fmt.Errorf("only bonded validators can verify records")
-	}
+	return &types.MsgVerifyRecordResponse{Status: status}, nil
+}
 
-	// Verify the record
-	err = ms.k.VerifyRecord(ctx, msg.RecordId, msg.Approved)
+// bondedVerifier returns the validator behind a verifier address.
+// Authorization: Only bonded validators can verify records
+func (ms msgServer) bondedVerifier(ctx context.Context, verifier string) (stakingtypes.Validator, error) {
+	verifierAddr, err := sdk.ValAddressFromBech32(verifier)
 	if err != nil {
-		return nil, err
+		// Not a validator address, return error
+		return stakingtypes.Validator{}, fmt.Errorf("invalid verifier address: %w", err)
 	}
 
-	// If rejected, optionally slash the validator for invalid record
-	if !msg.Approved {
-		record, err := ms.k.GetRecord(ctx, msg.RecordId)
-		if err != nil {
-			return nil, err
-		}
-
-		// Slash for invalid record
-		if err := ms.k.SlashValidatorForInvalidRecord(ctx, record.ValidatorAddress, msg.RecordId); err != nil {
-			// Log error but don't fail the transaction
-			sdkCtx := sdk.UnwrapSDKContext(ctx)
-			sdkCtx.Logger().Error(
-				"failed to slash validator for invalid record",
-				"validator", record.ValidatorAddress,
-				"record_id", msg.RecordId,
-				"error", err,
-			)
-		}
+	validator, err := ms.k.stakingKeeper.GetValidator(ctx, verifierAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, fmt.Errorf("verifier is not a validator: %w", err)
 	}
 
-	// Emit event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeRecordVerified,
-			sdk.NewAttribute(types.AttributeKeyRecordID, msg.RecordId),
-			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier),
-			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", msg.Approved)),
-		),
-	)
+	if !validator.IsBonded() {
+		return stakingtypes.Validator{}, fmt.Errorf("only bonded validators can verify records")
+	}
 
-	return &types.MsgVerifyRecordResponse{}, nil
+	return validator, nil
 }
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestMsgVerifyRecordQuorum(t *testing.T) {
+	testCases := []struct {
+		name       string
+		votes      []bool
+		expStatus  []types.RecordStatus
+		expSlashed bool
+	}{
+		{
+			name:      "approved once quorum is reached",
+			votes:     []bool{true, true},
+			expStatus: []types.RecordStatus{types.RecordStatusPending, types.RecordStatusVerified},
+		},
+		{
+			name:       "rejected once quorum is reached",
+			votes:      []bool{false, false},
+			expStatus:  []types.RecordStatus{types.RecordStatusPending, types.RecordStatusRejected},
+			expSlashed: true,
+		},
+		{
+			name:      "split vote meets the threshold",
+			votes:     []bool{false, true},
+			expStatus: []types.RecordStatus{types.RecordStatusPending, types.RecordStatusVerified},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+
+			// the submitter's stake cannot vote, so a 33.4% quorum of the three
+			// verifiers' stake needs two votes
+			submitter := f.addBondedValidator(t)
+			verifiers := []string{f.addBondedValidator(t), f.addBondedValidator(t), f.addBondedValidator(t)}
+
+			ctx := f.withBlock(1)
+			recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+			require.NoError(t, err)
+
+			for i, approved := range tc.votes {
+				res, err := ms.VerifyRecord(ctx, &types.MsgVerifyRecord{
+					Verifier: verifiers[i],
+					RecordId: recordID,
+					Approved: approved,
+				})
+				require.NoError(t, err)
+				require.Equal(t, tc.expStatus[i], res.Status)
+			}
+
+			record, err := f.keeper.GetRecord(f.ctx, recordID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus[len(tc.expStatus)-1], record.Status)
+			require.Equal(t, tc.expSlashed, len(f.stakingKeeper.slashes) == 1)
+
+			// finalized records take no further votes
+			_, err = ms.VerifyRecord(ctx, &types.MsgVerifyRecord{
+				Verifier: verifiers[2],
+				RecordId: recordID,
+				Approved: true,
+			})
+			require.ErrorIs(t, err, types.ErrRecordAlreadyVerified)
+		})
+	}
+}
+
+func TestMsgVerifyRecordQuorumExcludesSubmitter(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.VerificationQuorum = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the submitter holds 60% of the bonded stake: counted in the quorum, the
+	// two verifiers could never reach it together
+	submitter := f.addBondedValidator(t)
+	validator := f.stakingKeeper.validators[submitter]
+	validator.Tokens = validator.Tokens.MulRaw(3)
+	f.stakingKeeper.validators[submitter] = validator
+	verifier := f.addBondedValidator(t)
+	f.addBondedValidator(t)
+
+	ctx := f.withBlock(1)
+	recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	// one of two equal verifiers holds half of the stake that can vote
+	res, err := ms.VerifyRecord(ctx, &types.MsgVerifyRecord{Verifier: verifier, RecordId: recordID, Approved: true})
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusVerified, res.Status)
+}
+
+func TestRecordVotesQuery(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	submitter := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+	f.addBondedValidator(t)
+	f.addBondedValidator(t)
+
+	ctx := f.withBlock(1)
+	recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	_, err = ms.VerifyRecord(ctx, &types.MsgVerifyRecord{Verifier: verifier, RecordId: recordID, Approved: true})
+	require.NoError(t, err)
+
+	expected := types.RecordVote{
+		RecordId:    recordID,
+		Verifier:    verifier,
+		Approved:    true,
+		Power:       f.stakingKeeper.validators[verifier].Tokens,
+		BlockHeight: 1,
+	}
+
+	votes, err := qs.RecordVotes(f.ctx, &types.QueryRecordVotesRequest{RecordId: recordID})
+	require.NoError(t, err)
+	require.Equal(t, []types.RecordVote{expected}, votes.Votes)
+
+	vote, err := qs.RecordVote(f.ctx, &types.QueryRecordVoteRequest{RecordId: recordID, Verifier: verifier})
+	require.NoError(t, err)
+	require.Equal(t, expected, vote.Vote)
+
+	_, err = qs.RecordVote(f.ctx, &types.QueryRecordVoteRequest{RecordId: recordID, Verifier: submitter})
+	require.Error(t, err)
+
+	approve, reject, err := f.keeper.TallyRecordVotes(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, expected.Power, approve)
+	require.Equal(t, math.ZeroInt(), reject)
+}
+
+func TestMsgVerifyRecordConflicts(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	submitter := f.addBondedValidator(t)
+	verifier := f.addBondedValidator(t)
+	related := f.addBondedValidator(t)
+	f.addBondedValidator(t)
+
+	// related withdraws its rewards to the submitter's operator account
+	submitterAddr, err := sdk.ValAddressFromBech32(submitter)
+	require.NoError(t, err)
+	relatedAddr, err := sdk.ValAddressFromBech32(related)
+	require.NoError(t, err)
+	f.distributionKeeper.withdrawAddrs[sdk.AccAddress(relatedAddr).String()] = sdk.AccAddress(submitterAddr)
+
+	ctx := f.withBlock(1)
+	recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	vote := func(verifier string) error {
+		_, err := ms.VerifyRecord(ctx, &types.MsgVerifyRecord{Verifier: verifier, RecordId: recordID, Approved: true})
+		return err
+	}
+
+	require.ErrorIs(t, vote(submitter), types.ErrSelfVerification)
+	require.ErrorIs(t, vote(related), types.ErrConflictOfInterest)
+
+	require.NoError(t, vote(verifier))
+	require.ErrorIs(t, vote(verifier), types.ErrDuplicateVote)
+
+	// the related-address exclusion can be turned off by governance
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.ExcludeRelatedVerifiers = false
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, vote(related))
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusVerified, record.Status)
+}
@@ -16,6 +16,9 @@ func TestMsgUpdateParams(t *testing.T) {
 	params := types.DefaultParams()
 	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
 
+	updated := types.DefaultParams()
+	updated.RecordsPerEpoch = 20
+
 	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
 	require.NoError(t, err)
 
@@ -36,10 +39,10 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "updated params",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
-				Params:    types.Params{},
+				Params:    updated,
 			},
 			expErr: false,
 		},
@@ -62,6 +65,10 @@ func TestMsgUpdateParams(t *testing.T) {
 				require.Contains(t, err.Error(), tc.expErrMsg)
 			} else {
 				require.NoError(t, err)
+
+				stored, err := f.keeper.Params.Get(f.ctx)
+				require.NoError(t, err)
+				require.Equal(t, tc.input.Params, stored)
 			}
 		})
 	}
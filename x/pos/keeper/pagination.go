@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// recordIndexRange ranges over the entries of a record index whose reference
+// is between two references, both inclusive
+type recordIndexRange[R any] struct {
+	start *collections.RangeKey[collections.Pair[R, string]]
+	end   *collections.RangeKey[collections.Pair[R, string]]
+	order collections.Order
+}
+
+// RangeValues implements collections.Ranger
+func (r recordIndexRange[R]) RangeValues() (start, end *collections.RangeKey[collections.Pair[R, string]], order collections.Order, err error) {
+	return r.start, r.end, r.order, nil
+}
+
+// paginateRecordIndex pages through the records referenced by the refs from
+// minRef to maxRef, both inclusive, in a record index. It honours offset or
+// key, limit, count_total and reverse the same way
+// query.CollectionFilteredPaginate does for the primary collection, so the cost
+// of a page is proportional to the index entries in the range rather than the
+// whole store. The index already applies the filter it is walked for, match
+// applies the others; records are only loaded when match is set or once they
+// land in the page. The returned next key is the index key of the first record
+// of the next page.
+func paginateRecordIndex[R any](
+	ctx context.Context,
+	records *collections.IndexedMap[string, types.Record, RecordIndexes],
+	index *indexes.Multi[R, string, types.Record],
+	minRef, maxRef R,
+	pageReq *query.PageRequest,
+	match func(record types.Record) bool,
+) ([]types.Record, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	keyCodec := index.KeyCodec()
+	ranger := recordIndexRange[R]{
+		start: collections.RangeKeyExact(collections.PairPrefix[R, string](minRef)),
+		end:   collections.RangeKeyPrefixEnd(collections.PairPrefix[R, string](maxRef)),
+	}
+	if len(pageReq.Key) != 0 {
+		_, key, err := keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid pagination key: %w", err)
+		}
+		if pageReq.Reverse {
+			ranger.end = collections.RangeKeyNext(key)
+		} else {
+			ranger.start = collections.RangeKeyExact(key)
+		}
+	}
+	if pageReq.Reverse {
+		ranger.order = collections.OrderDescending
+	}
+
+	iter, err := index.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	// totals are only reported for offset based pagination
+	countTotal = countTotal && len(pageReq.Key) == 0
+
+	var (
+		results []types.Record
+		next    []byte
+		count   uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var record *types.Record
+		if match != nil {
+			r, err := records.Get(ctx, key.K2())
+			if err != nil {
+				return nil, nil, err
+			}
+			if !match(r) {
+				continue
+			}
+			record = &r
+		}
+
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(len(results)) == limit {
+			if next == nil {
+				next = make([]byte, keyCodec.Size(key))
+				if _, err := keyCodec.Encode(next, key); err != nil {
+					return nil, nil, err
+				}
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		if record == nil {
+			r, err := records.Get(ctx, key.K2())
+			if err != nil {
+				return nil, nil, err
+			}
+			record = &r
+		}
+		results = append(results, *record)
+	}
+
+	pageRes := &query.PageResponse{NextKey: next}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return results, pageRes, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// AvailabilityChallenge queries a data availability challenge by id
+func (qs queryServer) AvailabilityChallenge(ctx context.Context, req *types.QueryAvailabilityChallengeRequest) (*types.QueryAvailabilityChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenge, err := qs.k.GetAvailabilityChallenge(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrChallengeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAvailabilityChallengeResponse{Challenge: challenge}, nil
+}
+
+// AvailabilityChallenges queries data availability challenges with pagination
+func (qs queryServer) AvailabilityChallenges(ctx context.Context, req *types.QueryAvailabilityChallengesRequest) (*types.QueryAvailabilityChallengesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenges, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		qs.k.AvailabilityChallenges,
+		req.Pagination,
+		func(_ uint64, challenge types.AvailabilityChallenge) (bool, error) {
+			return req.RecordId == "" || challenge.RecordId == req.RecordId, nil
+		},
+		func(_ uint64, challenge types.AvailabilityChallenge) (types.AvailabilityChallenge, error) {
+			return challenge, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAvailabilityChallengesResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
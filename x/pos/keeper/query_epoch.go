@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// CurrentEpoch queries the current epoch
+func (qs queryServer) CurrentEpoch(ctx context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	epoch, err := qs.k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCurrentEpochResponse{Epoch: epoch}, nil
+}
+
+// Epochs queries the current and past epochs with pagination
+func (qs queryServer) Epochs(ctx context.Context, req *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	epochs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.Epochs,
+		req.Pagination,
+		func(_ uint64, epoch types.EpochInfo) (types.EpochInfo, error) {
+			return epoch, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochsResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
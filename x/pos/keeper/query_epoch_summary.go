@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// EpochSummary queries the summary of an ended epoch with the summaries of its validators
+func (qs queryServer) EpochSummary(ctx context.Context, req *types.QueryEpochSummaryRequest) (*types.QueryEpochSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	summary, err := qs.k.GetEpochSummary(ctx, req.Epoch)
+	if err != nil {
+		if errors.Is(err, types.ErrEpochSummaryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.ValidatorEpochSummaries,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], validator types.ValidatorEpochSummary) (types.ValidatorEpochSummary, error) {
+			return validator, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.Epoch),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochSummaryResponse{
+		Summary:    summary,
+		Validators: validators,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EpochSummaries queries the summaries of a range of ended epochs
+func (qs queryServer) EpochSummaries(ctx context.Context, req *types.QueryEpochSummariesRequest) (*types.QueryEpochSummariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "end epoch %d is lower than start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+	if req.EndEpoch-req.StartEpoch >= types.MaxEpochSummaryRange {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch range cannot span more than %d epochs", types.MaxEpochSummaryRange)
+	}
+
+	var summaries []types.EpochSummary
+	rng := new(collections.Range[uint64]).StartInclusive(req.StartEpoch).EndInclusive(req.EndEpoch)
+	err := qs.k.EpochSummaries.Walk(ctx, rng, func(_ uint64, summary types.EpochSummary) (bool, error) {
+		summaries = append(summaries, summary)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochSummariesResponse{Summaries: summaries}, nil
+}
+
+// ValidatorEpochSummaries queries the epoch history of a validator
+func (qs queryServer) ValidatorEpochSummaries(ctx context.Context, req *types.QueryValidatorEpochSummariesRequest) (*types.QueryValidatorEpochSummariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	summaries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.ValidatorEpochSummaries.Indexes.ByValidator,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.ValidatorEpochSummary, error) {
+			return qs.k.ValidatorEpochSummaries.Get(ctx, collections.Join(key.K2(), key.K1()))
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.ValidatorAddress),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorEpochSummariesResponse{Summaries: summaries, Pagination: pageRes}, nil
+}
@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -21,39 +25,105 @@ func (qs queryServer) Record(ctx context.Context, req *types.QueryRecordRequest)
 
 	record, err := qs.k.GetRecord(ctx, req.Id)
 	if err != nil {
-		if types.ErrRecordNotFound.Is(err) {
+		if errors.Is(err, types.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryRecordResponse{Record: record}, nil
+	res := &types.QueryRecordResponse{Record: record}
+	if req.Decode {
+		if res.DecodedData, err = qs.k.DecodeRecordData(record); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	return res, nil
 }
 
-// Records queries all records with pagination
+// Records queries all records with pagination and optional filters
 func (qs queryServer) Records(ctx context.Context, req *types.QueryRecordsRequest) (*types.QueryRecordsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var records []types.Record
-	// Simple iteration without pagination for now
-	err := qs.k.Records.Walk(ctx, nil, func(key string, value types.Record) (bool, error) {
-		records = append(records, value)
-		return false, nil
-	})
+	filter := recordFilter{
+		status:    req.Status,
+		hasEpoch:  req.HasEpoch,
+		minEpoch:  req.MinEpoch,
+		maxEpoch:  req.MaxEpoch,
+		hasHeight: req.HasBlockHeight,
+		minHeight: req.MinBlockHeight,
+		maxHeight: req.MaxBlockHeight,
+	}
+	if err := filter.validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
+	var (
+		records []types.Record
+		pageRes *query.PageResponse
+		err     error
+	)
+	switch {
+	case filter.hasEpoch:
+		// Walk only the records in the requested epochs
+		rest := filter
+		rest.hasEpoch = false
+		records, pageRes, err = paginateRecordIndex(
+			ctx,
+			qs.k.Records,
+			qs.k.Records.Indexes.ByEpoch,
+			filter.minEpoch,
+			filter.maxEpoch,
+			req.Pagination,
+			rest.matcher(),
+		)
+	case filter.hasHeight:
+		// Walk only the records submitted at the requested heights
+		rest := filter
+		rest.hasHeight = false
+		records, pageRes, err = paginateRecordIndex(
+			ctx,
+			qs.k.Records,
+			qs.k.Records.Indexes.ByHeight,
+			filter.minHeight,
+			filter.maxHeight,
+			req.Pagination,
+			rest.matcher(),
+		)
+	case filter.status != types.RecordStatusUnspecified:
+		// Walk only the records with the requested status
+		records, pageRes, err = paginateRecordIndex(
+			ctx,
+			qs.k.Records,
+			qs.k.Records.Indexes.ByStatus,
+			int32(filter.status),
+			int32(filter.status),
+			req.Pagination,
+			nil,
+		)
+	default:
+		records, pageRes, err = query.CollectionPaginate(
+			ctx,
+			qs.k.Records,
+			req.Pagination,
+			func(_ string, record types.Record) (types.Record, error) {
+				return record, nil
+			},
+		)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryRecordsResponse{
 		Records:    records,
-		Pagination: nil,
+		Pagination: pageRes,
 	}, nil
 }
 
-// ValidatorRecords queries all records for a specific validator
+// ValidatorRecords queries records for a specific validator with pagination and optional filters
 func (qs queryServer) ValidatorRecords(ctx context.Context, req *types.QueryValidatorRecordsRequest) (*types.QueryValidatorRecordsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -63,25 +133,55 @@ func (qs queryServer) ValidatorRecords(ctx context.Context, req *types.QueryVali
 		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
 	}
 
-	// Get all records for this validator
-	var validatorRecords []types.Record
-	err := qs.k.Records.Walk(ctx, nil, func(key string, record types.Record) (bool, error) {
-		if record.ValidatorAddress == req.ValidatorAddress {
-			validatorRecords = append(validatorRecords, record)
-		}
-		return false, nil
-	})
+	filter := recordFilter{
+		status:    req.Status,
+		hasEpoch:  req.HasEpoch,
+		minEpoch:  req.MinEpoch,
+		maxEpoch:  req.MaxEpoch,
+		hasHeight: req.HasBlockHeight,
+		minHeight: req.MinBlockHeight,
+		maxHeight: req.MaxBlockHeight,
+	}
+	if err := filter.validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
+	var (
+		records []types.Record
+		pageRes *query.PageResponse
+		err     error
+	)
+	if filter.hasEpoch {
+		// Walk only the records of the validator in the requested epochs
+		rest := filter
+		rest.hasEpoch = false
+		records, pageRes, err = paginateRecordIndex(
+			ctx,
+			qs.k.Records,
+			qs.k.Records.Indexes.ByValidatorEpoch,
+			collections.Join(req.ValidatorAddress, filter.minEpoch),
+			collections.Join(req.ValidatorAddress, filter.maxEpoch),
+			req.Pagination,
+			rest.matcher(),
+		)
+	} else {
+		records, pageRes, err = paginateRecordIndex(
+			ctx,
+			qs.k.Records,
+			qs.k.Records.Indexes.ByValidator,
+			req.ValidatorAddress,
+			req.ValidatorAddress,
+			req.Pagination,
+			filter.matcher(),
+		)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// TODO: Implement proper pagination for filtered results
-	// For now, returning all records without pagination
-
 	return &types.QueryValidatorRecordsResponse{
-		Records:    validatorRecords,
-		Pagination: nil,
+		Records:    records,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -97,7 +197,7 @@ func (qs queryServer) ValidatorStats(ctx context.Context, req *types.QueryValida
 
 	stats, err := qs.k.GetValidatorStats(ctx, req.ValidatorAddress)
 	if err != nil {
-		if err != nil && err.Error() == "collections: not found" || types.ErrValidatorStatsNotFound.Is(err) {
+		if errors.Is(err, types.ErrValidatorStatsNotFound) {
 			// Return empty stats if not found
 			stats = types.ValidatorRecordStats{
 				ValidatorAddress: req.ValidatorAddress,
@@ -110,7 +210,69 @@ func (qs queryServer) ValidatorStats(ctx context.Context, req *types.QueryValida
 		} else {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		return &types.QueryValidatorStatsResponse{Stats: stats}, nil
+	}
+
+	epoch, err := qs.k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryValidatorStatsResponse{Stats: stats}, nil
+	return &types.QueryValidatorStatsResponse{
+		Stats:         stats,
+		InGracePeriod: stats.InGracePeriod(epoch.Number),
+	}, nil
+}
+
+// recordFilter holds the optional filters shared by the record list queries.
+// The epoch and height bounds are inclusive and only apply when their range is
+// set, so epoch and height 0 can be filtered for.
+type recordFilter struct {
+	status    types.RecordStatus
+	hasEpoch  bool
+	minEpoch  uint64
+	maxEpoch  uint64
+	hasHeight bool
+	minHeight uint64
+	maxHeight uint64
+}
+
+// validate checks that the requested ranges are well formed
+func (f recordFilter) validate() error {
+	if !f.hasEpoch && (f.minEpoch != 0 || f.maxEpoch != 0) {
+		return fmt.Errorf("epoch bounds are set without has_epoch")
+	}
+	if f.hasEpoch && f.maxEpoch < f.minEpoch {
+		return fmt.Errorf("max epoch %d is lower than min epoch %d", f.maxEpoch, f.minEpoch)
+	}
+	if !f.hasHeight && (f.minHeight != 0 || f.maxHeight != 0) {
+		return fmt.Errorf("block height bounds are set without has_block_height")
+	}
+	if f.hasHeight && f.maxHeight < f.minHeight {
+		return fmt.Errorf("max block height %d is lower than min block height %d", f.maxHeight, f.minHeight)
+	}
+	return nil
+}
+
+// matcher returns a predicate applying every filter that is set, or nil when
+// none is
+func (f recordFilter) matcher() func(record types.Record) bool {
+	if f.status == types.RecordStatusUnspecified && !f.hasEpoch && !f.hasHeight {
+		return nil
+	}
+	return f.match
+}
+
+// match reports whether a record passes every filter that is set
+func (f recordFilter) match(record types.Record) bool {
+	if f.status != types.RecordStatusUnspecified && record.Status != f.status {
+		return false
+	}
+	if f.hasEpoch && (record.Epoch < f.minEpoch || record.Epoch > f.maxEpoch) {
+		return false
+	}
+	if f.hasHeight && (record.BlockHeight < f.minHeight || record.BlockHeight > f.maxHeight) {
+		return false
+	}
+	return true
 }
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordCommits queries the open verification commits on a record
+func (qs queryServer) RecordCommits(ctx context.Context, req *types.QueryRecordCommitsRequest) (*types.QueryRecordCommitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id cannot be empty")
+	}
+
+	commits, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.VerificationCommits,
+		req.Pagination,
+		func(_ collections.Pair[string, string], commit types.VerificationCommit) (types.VerificationCommit, error) {
+			return commit, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.RecordId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordCommitsResponse{Commits: commits, Pagination: pageRes}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordDispute queries the dispute filed against a record
+func (qs queryServer) RecordDispute(ctx context.Context, req *types.QueryRecordDisputeRequest) (*types.QueryRecordDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id cannot be empty")
+	}
+
+	dispute, err := qs.k.GetRecordDispute(ctx, req.RecordId)
+	if err != nil {
+		if errors.Is(err, types.ErrDisputeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordDisputeResponse{Dispute: dispute}, nil
+}
+
+// RecordDisputes queries record disputes with pagination and an optional status filter
+func (qs queryServer) RecordDisputes(ctx context.Context, req *types.QueryRecordDisputesRequest) (*types.QueryRecordDisputesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	disputes, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		qs.k.Disputes,
+		req.Pagination,
+		func(_ string, dispute types.RecordDispute) (bool, error) {
+			return req.Status == types.DisputeStatusUnspecified || dispute.Status == req.Status, nil
+		},
+		func(_ string, dispute types.RecordDispute) (types.RecordDispute, error) {
+			return dispute, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordDisputesResponse{Disputes: disputes, Pagination: pageRes}, nil
+}
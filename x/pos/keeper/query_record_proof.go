@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordChunkProof queries a chunk of a record's data with its inclusion proof
+func (qs queryServer) RecordChunkProof(ctx context.Context, req *types.QueryRecordChunkProofRequest) (*types.QueryRecordChunkProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id cannot be empty")
+	}
+
+	record, err := qs.k.GetRecord(ctx, req.RecordId)
+	if err != nil {
+		if errors.Is(err, types.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if record.IsOffChain() {
+		return nil, status.Errorf(codes.FailedPrecondition, "record %s data is stored off chain at %s", record.Id, record.DataLocator)
+	}
+
+	if record.DataPruned {
+		return nil, status.Errorf(codes.FailedPrecondition, "record %s data was pruned at height %d", record.Id, record.PrunedHeight)
+	}
+
+	chunk, proof, err := types.RecordChunkProof(record.Data, req.Index)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryRecordChunkProofResponse{
+		Chunk:      chunk,
+		Proof:      proof,
+		MerkleRoot: record.MerkleRoot,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordSigningInfo queries the record submissions of a validator over the signing window
+func (qs queryServer) RecordSigningInfo(ctx context.Context, req *types.QueryRecordSigningInfoRequest) (*types.QueryRecordSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	info, err := qs.k.RecordSigningInfos.Get(ctx, req.ValidatorAddress)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "record signing info not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	missed, err := qs.k.missedEpochsInWindow(ctx, req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params, err := qs.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordSigningInfoResponse{
+		Info:            info,
+		MissedEpochs:    missed,
+		MaxMissedEpochs: params.MaxMissedEpochs(),
+	}, nil
+}
@@ -0,0 +1,310 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// createNRecords stores n pending records for the validator, one per block
+// starting at height 1.
+func createNRecords(t *testing.T, k keeper.Keeper, ctx context.Context, validator string, n int) []types.Record {
+	t.Helper()
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+
+	items := make([]types.Record, n)
+	for i := range items {
+		height := uint64(i + 1)
+		items[i] = types.Record{
+			Id:               fmt.Sprintf("%s-%03d", validator, i),
+			ValidatorAddress: validator,
+			Data:             recordData(byte(i)),
+			Status:           types.RecordStatusPending,
+			MerkleRoot:       "root",
+			BlockHeight:      height,
+			Epoch:            height / params.EpochLength,
+		}
+		require.NoError(t, k.Records.Set(ctx, items[i].Id, items[i]))
+	}
+	return items
+}
+
+func TestRecordsQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNRecords(t, f.keeper, f.ctx, "val", 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryRecordsRequest {
+		return &types.QueryRecordsRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.Records(f.ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Records), step)
+			require.Subset(t, msgs, resp.Records)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var got []types.Record
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.Records(f.ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Records), step)
+			got = append(got, resp.Records...)
+			next = resp.Pagination.NextKey
+		}
+		require.ElementsMatch(t, msgs, got)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.Records(f.ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.EqualExportedValues(t, msgs, resp.Records)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.Records(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestRecordsQueryFilters(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// heights 1..6 fall in epochs 0,1,1,2,2,3
+	msgs := createNRecords(t, f.keeper, f.ctx, "val", 6)
+	msgs[1].Status = types.RecordStatusVerified
+	msgs[4].Status = types.RecordStatusVerified
+	require.NoError(t, f.keeper.Records.Set(f.ctx, msgs[1].Id, msgs[1]))
+	require.NoError(t, f.keeper.Records.Set(f.ctx, msgs[4].Id, msgs[4]))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryRecordsRequest
+		expected []types.Record
+		err      error
+	}{
+		{
+			desc:     "Status",
+			request:  &types.QueryRecordsRequest{Status: types.RecordStatusVerified},
+			expected: []types.Record{msgs[1], msgs[4]},
+		},
+		{
+			desc:     "EpochRange",
+			request:  &types.QueryRecordsRequest{HasEpoch: true, MinEpoch: 1, MaxEpoch: 2},
+			expected: msgs[1:5],
+		},
+		{
+			desc:     "EpochZero",
+			request:  &types.QueryRecordsRequest{HasEpoch: true},
+			expected: msgs[:1],
+		},
+		{
+			desc:     "HeightRange",
+			request:  &types.QueryRecordsRequest{HasBlockHeight: true, MinBlockHeight: 5, MaxBlockHeight: math.MaxUint64},
+			expected: msgs[4:],
+		},
+		{
+			desc:     "Combined",
+			request:  &types.QueryRecordsRequest{Status: types.RecordStatusVerified, HasEpoch: true, MinEpoch: 2, MaxEpoch: math.MaxUint64},
+			expected: []types.Record{msgs[4]},
+		},
+		{
+			desc:    "InvalidEpochRange",
+			request: &types.QueryRecordsRequest{HasEpoch: true, MinEpoch: 3, MaxEpoch: 1},
+			err:     status.Error(codes.InvalidArgument, "max epoch 1 is lower than min epoch 3"),
+		},
+		{
+			desc:    "EpochBoundsWithoutRange",
+			request: &types.QueryRecordsRequest{MinEpoch: 1},
+			err:     status.Error(codes.InvalidArgument, "epoch bounds are set without has_epoch"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.Records(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualExportedValues(t, tc.expected, resp.Records)
+			require.Equal(t, uint64(len(tc.expected)), resp.Pagination.Total)
+		})
+	}
+
+	// epochs 1 to 3 are paged through the epoch index, in both directions
+	for _, reverse := range []bool{false, true} {
+		var (
+			next []byte
+			got  []types.Record
+		)
+		for {
+			resp, err := qs.Records(f.ctx, &types.QueryRecordsRequest{
+				HasEpoch:   true,
+				MinEpoch:   1,
+				MaxEpoch:   3,
+				Pagination: &query.PageRequest{Key: next, Limit: 2, Reverse: reverse},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Records), 2)
+			got = append(got, resp.Records...)
+			if next = resp.Pagination.NextKey; next == nil {
+				break
+			}
+		}
+		require.Len(t, got, 5)
+		require.ElementsMatch(t, msgs[1:], got)
+		if reverse {
+			require.Equal(t, msgs[5].Id, got[0].Id)
+		}
+	}
+}
+
+func TestValidatorRecordsQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNRecords(t, f.keeper, f.ctx, "valA", 5)
+	createNRecords(t, f.keeper, f.ctx, "valB", 3)
+
+	request := func(next []byte, offset, limit uint64, reverse bool) *types.QueryValidatorRecordsRequest {
+		return &types.QueryValidatorRecordsRequest{
+			ValidatorAddress: "valA",
+			Pagination: &query.PageRequest{
+				Key:     next,
+				Offset:  offset,
+				Limit:   limit,
+				Reverse: reverse,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		resp, err := qs.ValidatorRecords(f.ctx, request(nil, 3, 2, false))
+		require.NoError(t, err)
+		require.EqualExportedValues(t, msgs[3:], resp.Records)
+		require.Nil(t, resp.Pagination.NextKey)
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var got []types.Record
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.ValidatorRecords(f.ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			got = append(got, resp.Records...)
+			next = resp.Pagination.NextKey
+		}
+		require.Nil(t, next)
+		require.EqualExportedValues(t, msgs, got)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		resp, err := qs.ValidatorRecords(f.ctx, request(nil, 0, 2, true))
+		require.NoError(t, err)
+		require.EqualExportedValues(t, []types.Record{msgs[4], msgs[3]}, resp.Records)
+
+		resp, err = qs.ValidatorRecords(f.ctx, request(resp.Pagination.NextKey, 0, 2, true))
+		require.NoError(t, err)
+		require.EqualExportedValues(t, []types.Record{msgs[2], msgs[1]}, resp.Records)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.ValidatorRecords(f.ctx, &types.QueryValidatorRecordsRequest{ValidatorAddress: "valA"})
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		resp, err := qs.ValidatorRecords(f.ctx, &types.QueryValidatorRecordsRequest{
+			ValidatorAddress: "valA",
+			HasBlockHeight:   true,
+			MinBlockHeight:   2,
+			MaxBlockHeight:   3,
+		})
+		require.NoError(t, err)
+		require.EqualExportedValues(t, msgs[1:3], resp.Records)
+	})
+	t.Run("EmptyAddress", func(t *testing.T) {
+		_, err := qs.ValidatorRecords(f.ctx, &types.QueryValidatorRecordsRequest{})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "validator address cannot be empty"))
+	})
+}
+
+func TestValidatorRecordsQueryEpochs(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// heights 1..6 fall in epochs 0,1,1,2,2,3
+	msgs := createNRecords(t, f.keeper, f.ctx, "valA", 6)
+	createNRecords(t, f.keeper, f.ctx, "valB", 6)
+	msgs[2].Status = types.RecordStatusVerified
+	require.NoError(t, f.keeper.Records.Set(f.ctx, msgs[2].Id, msgs[2]))
+
+	request := func(minEpoch, maxEpoch uint64, recordStatus types.RecordStatus, pageReq *query.PageRequest) *types.QueryValidatorRecordsRequest {
+		return &types.QueryValidatorRecordsRequest{
+			ValidatorAddress: "valA",
+			Status:           recordStatus,
+			HasEpoch:         true,
+			MinEpoch:         minEpoch,
+			MaxEpoch:         maxEpoch,
+			Pagination:       pageReq,
+		}
+	}
+
+	resp, err := qs.ValidatorRecords(f.ctx, request(0, 0, types.RecordStatusUnspecified, nil))
+	require.NoError(t, err)
+	require.EqualExportedValues(t, msgs[:1], resp.Records)
+
+	resp, err = qs.ValidatorRecords(f.ctx, request(1, math.MaxUint64, types.RecordStatusVerified, nil))
+	require.NoError(t, err)
+	require.EqualExportedValues(t, msgs[2:3], resp.Records)
+	require.Equal(t, uint64(1), resp.Pagination.Total)
+
+	// epochs 1 and 2 are paged through the validator epoch index, in both directions
+	for _, reverse := range []bool{false, true} {
+		var (
+			next []byte
+			got  []types.Record
+		)
+		for {
+			resp, err := qs.ValidatorRecords(f.ctx, request(1, 2, types.RecordStatusUnspecified, &query.PageRequest{Key: next, Limit: 3, Reverse: reverse}))
+			require.NoError(t, err)
+			got = append(got, resp.Records...)
+			if next = resp.Pagination.NextKey; next == nil {
+				break
+			}
+		}
+		require.Len(t, got, 4)
+		require.ElementsMatch(t, msgs[1:5], got)
+		if reverse {
+			require.Equal(t, msgs[4].Id, got[0].Id)
+		}
+	}
+}
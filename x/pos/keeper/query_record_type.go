@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordType queries a registered record type by id
+func (qs queryServer) RecordType(ctx context.Context, req *types.QueryRecordTypeRequest) (*types.QueryRecordTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "record type id cannot be empty")
+	}
+
+	recordType, err := qs.k.GetRecordType(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrRecordTypeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordTypeResponse{RecordType: recordType}, nil
+}
+
+// RecordTypes queries all registered record types with pagination
+func (qs queryServer) RecordTypes(ctx context.Context, req *types.QueryRecordTypesRequest) (*types.QueryRecordTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	recordTypes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.RecordTypes,
+		req.Pagination,
+		func(_ string, recordType types.RecordType) (types.RecordType, error) {
+			return recordType, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordTypesResponse{RecordTypes: recordTypes, Pagination: pageRes}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordUpload queries an open chunked record upload by id
+func (qs queryServer) RecordUpload(ctx context.Context, req *types.QueryRecordUploadRequest) (*types.QueryRecordUploadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	upload, err := qs.k.GetRecordUpload(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrUploadNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordUploadResponse{Upload: upload}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordVersions queries the version chain a record belongs to
+func (qs queryServer) RecordVersions(ctx context.Context, req *types.QueryRecordVersionsRequest) (*types.QueryRecordVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id cannot be empty")
+	}
+
+	versions, err := qs.k.GetRecordVersions(ctx, req.RecordId)
+	if err != nil {
+		if errors.Is(err, types.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordVersionsResponse{Versions: versions}, nil
+}
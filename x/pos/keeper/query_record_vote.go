@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordVotes queries the verifier votes cast on a record
+func (qs queryServer) RecordVotes(ctx context.Context, req *types.QueryRecordVotesRequest) (*types.QueryRecordVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id cannot be empty")
+	}
+
+	votes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.RecordVotes,
+		req.Pagination,
+		func(_ collections.Pair[string, string], vote types.RecordVote) (types.RecordVote, error) {
+			return vote, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.RecordId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// RecordVote queries a single verifier's vote on a record
+func (qs queryServer) RecordVote(ctx context.Context, req *types.QueryRecordVoteRequest) (*types.QueryRecordVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RecordId == "" || req.Verifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "record id and verifier cannot be empty")
+	}
+
+	vote, err := qs.k.GetRecordVote(ctx, req.RecordId, req.Verifier)
+	if err != nil {
+		if errors.Is(err, types.ErrRecordVoteNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordVoteResponse{Vote: vote}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RewardPool queries the reward pool and the payouts pending at the end of the current epoch
+func (qs queryServer) RewardPool(ctx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := qs.k.GetRewardPool(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	epoch, pending, err := qs.k.PendingRewardPayouts(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardPoolResponse{Pool: pool, Epoch: epoch.Number, Pending: pending}, nil
+}
+
+// RewardPayouts queries the past reward payouts of a validator
+func (qs queryServer) RewardPayouts(ctx context.Context, req *types.QueryRewardPayoutsRequest) (*types.QueryRewardPayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	payouts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.RewardPayouts.Indexes.ByValidator,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.RewardPayout, error) {
+			return qs.k.RewardPayouts.Get(ctx, collections.Join(key.K2(), key.K1()))
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.ValidatorAddress),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardPayoutsResponse{Payouts: payouts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// ValidatorSlashes queries the slash history of a validator
+func (qs queryServer) ValidatorSlashes(ctx context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	slashes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.k.SlashEvents,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], event types.SlashEvent) (types.SlashEvent, error) {
+			return event, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.ValidatorAddress),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// Slashes queries the slash history of all validators in a range of block heights
+func (qs queryServer) Slashes(ctx context.Context, req *types.QuerySlashesRequest) (*types.QuerySlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d is lower than min height %d", req.MaxHeight, req.MinHeight)
+	}
+	if req.MaxHeight-req.MinHeight >= types.MaxSlashHeightRange {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot span more than %d blocks", types.MaxSlashHeightRange)
+	}
+
+	slashes, err := qs.k.GetSlashEventsInHeightRange(ctx, req.MinHeight, req.MaxHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashesResponse{Slashes: slashes}, nil
+}
@@ -4,28 +4,143 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
-// CreateRecord creates a new record submitted by a validator
+// CreateRecord creates a new record submitted by a validator. A non-empty
+// recordType names the registered record type the data must conform to.
 func (k Keeper) CreateRecord(
 	ctx context.Context,
 	validatorAddr string,
+	recordType string,
 	data []byte,
 	merkleRoot string,
 ) (string, error) {
+	return k.CreateEncodedRecord(ctx, validatorAddr, recordType, types.ContentEncodingIdentity, data, merkleRoot)
+}
+
+// CreateEncodedRecord creates a new record whose data is compressed with the
+// given content encoding. Size limits and the record type apply to the decoded
+// data, while the merkle root covers the data as stored.
+func (k Keeper) CreateEncodedRecord(
+	ctx context.Context,
+	validatorAddr string,
+	recordType string,
+	encoding types.ContentEncoding,
+	data []byte,
+	merkleRoot string,
+) (string, error) {
+	return k.createOnChainRecord(ctx, types.Record{
+		ValidatorAddress: validatorAddr,
+		Data:             data,
+		MerkleRoot:       merkleRoot,
+		RecordType:       recordType,
+		ContentEncoding:  encoding,
+	})
+}
+
+// CreateOffChainRecord creates a new record whose data is kept off chain. Only
+// the merkle root, size and locator of the data are stored; the submitter must
+// answer availability challenges to prove the data can still be fetched. Only
+// the size limits of the record type can be checked without the data.
+func (k Keeper) CreateOffChainRecord(
+	ctx context.Context,
+	validatorAddr string,
+	recordType string,
+	merkleRoot string,
+	dataSize uint64,
+	dataLocator string,
+) (string, error) {
+	return k.createOffChainRecord(ctx, types.Record{
+		ValidatorAddress: validatorAddr,
+		MerkleRoot:       merkleRoot,
+		DataSize:         dataSize,
+		DataLocator:      dataLocator,
+		RecordType:       recordType,
+	})
+}
+
+// createOnChainRecord validates and stores a record whose data is kept on
+// chain. The submitter, data, merkle root, record type and content encoding
+// are taken from record.
+func (k Keeper) createOnChainRecord(ctx context.Context, record types.Record) (string, error) {
 	// Get params for validation
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return "", err
 	}
 
-	// Validate record size
+	// Decode the data, refusing to inflate it past the max record size
+	data := record.Data
 	dataSize := uint64(len(data))
+	payload := data
+	if record.IsEncoded() {
+		if dataSize > params.MaxRecordSize {
+			return "", types.ErrInvalidRecordSize.Wrapf(
+				"encoded record size %d exceeds %d",
+				dataSize,
+				params.MaxRecordSize,
+			)
+		}
+
+		payload, err = types.DecodeRecordData(record.ContentEncoding, data, params.MaxRecordSize)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Validate record size
+	payloadSize := uint64(len(payload))
+	if payloadSize < params.MinRecordSize || payloadSize > params.MaxRecordSize {
+		return "", types.ErrInvalidRecordSize.Wrapf(
+			"record size %d is not within bounds [%d, %d]",
+			payloadSize,
+			params.MinRecordSize,
+			params.MaxRecordSize,
+		)
+	}
+
+	// Validate the data against its record type
+	if err := k.checkRecordType(ctx, record.RecordType, payload, payloadSize); err != nil {
+		return "", err
+	}
+
+	// Validate merkle root against the data
+	if err := types.ValidateRecordMerkleRoot(data, record.MerkleRoot); err != nil {
+		return "", err
+	}
+
+	record.MerkleRoot = strings.ToLower(record.MerkleRoot)
+	record.DataSize = dataSize
+	if record.IsEncoded() {
+		record.DecodedSize = payloadSize
+	}
+
+	return k.storeRecord(ctx, params, record, data)
+}
+
+// createOffChainRecord validates and stores a record whose data is kept off
+// chain. The submitter, merkle root, data size, locator and record type are
+// taken from record.
+func (k Keeper) createOffChainRecord(ctx context.Context, record types.Record) (string, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if record.IsEncoded() {
+		return "", types.ErrInvalidContentEncoding.Wrap("off-chain records cannot be encoded")
+	}
+
+	dataSize := record.DataSize
 	if dataSize < params.MinRecordSize || dataSize > params.MaxRecordSize {
 		return "", types.ErrInvalidRecordSize.Wrapf(
 			"record size %d is not within bounds [%d, %d]",
@@ -35,33 +150,42 @@ func (k Keeper) CreateRecord(
 		)
 	}
 
-	// Validate merkle root
-	if len(merkleRoot) == 0 {
-		return "", types.ErrInvalidMerkleRoot.Wrap("merkle root cannot be empty")
+	if err := k.checkRecordType(ctx, record.RecordType, nil, dataSize); err != nil {
+		return "", err
 	}
 
-	// Get validator to ensure they exist
-	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	// The data is not available to recompute the root, only check its shape
+	root, err := types.ParseMerkleRoot(record.MerkleRoot)
 	if err != nil {
 		return "", err
 	}
 
-	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
-	if err != nil {
-		return "", types.ErrNotValidator.Wrap(err.Error())
+	if len(record.DataLocator) == 0 || len(record.DataLocator) > types.MaxDataLocatorLength {
+		return "", types.ErrInvalidDataLocator.Wrapf(
+			"data locator length must be between 1 and %d",
+			types.MaxDataLocatorLength,
+		)
 	}
 
-	// Check if validator is bonded
-	if !validator.IsBonded() {
-		return "", types.ErrNotValidator.Wrap("validator must be bonded to submit records")
+	record.MerkleRoot = strings.ToLower(record.MerkleRoot)
+	return k.storeRecord(ctx, params, record, root)
+}
+
+// storeRecord stores a new pending record submitted by a bonded validator.
+// The record id is derived from the validator, idSeed and the block time.
+func (k Keeper) storeRecord(ctx context.Context, params types.Params, record types.Record, idSeed []byte) (string, error) {
+	validatorAddr := record.ValidatorAddress
+
+	if err := k.checkBondedSubmitter(ctx, validatorAddr); err != nil {
+		return "", err
 	}
 
-	// Generate record ID from hash of validator + data + timestamp
+	// Generate record ID from hash of validator + data (or root) + timestamp
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	timestamp := sdkCtx.BlockTime().Unix()
 	blockHeight := uint64(sdkCtx.BlockHeight())
 
-	recordID := generateRecordID(validatorAddr, data, timestamp)
+	recordID := generateRecordID(validatorAddr, idSeed, timestamp)
 
 	// Check for duplicate
 	if has, err := k.Records.Has(ctx, recordID); err != nil {
@@ -70,60 +194,73 @@ func (k Keeper) CreateRecord(
 		return "", types.ErrDuplicateRecord.Wrapf("record %s already exists", recordID)
 	}
 
-	// Check epoch record limits
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Load validator stats
 	stats, err := k.GetValidatorStats(ctx, validatorAddr)
 	if err != nil {
 		// If stats don't exist, create them
 		stats = types.ValidatorRecordStats{
 			ValidatorAddress:        validatorAddr,
-			TotalRecords:           0,
-			VerifiedRecords:        0,
-			RejectedRecords:        0,
-			LastRecordTime:         0,
-			IsEligible:             true,
-			NextRequiredRecordTime: timestamp + int64(params.EpochLength),
+			TotalRecords:            0,
+			VerifiedRecords:         0,
+			RejectedRecords:         0,
+			LastRecordTime:          0,
+			IsEligible:              true,
+			NextRequiredRecordEpoch: epoch.Number + 1,
 		}
 	}
 
-	// Calculate current epoch
-	currentEpoch := blockHeight / params.EpochLength
-	lastRecordEpoch := uint64(stats.LastRecordTime) / params.EpochLength
-
-	// If same epoch, check record limit
-	if currentEpoch == lastRecordEpoch {
-		// Count records in current epoch
-		epochRecordCount := uint64(0)
-		err := k.Records.Walk(ctx, nil, func(key string, record types.Record) (bool, error) {
-			if record.ValidatorAddress == validatorAddr {
-				recordEpoch := record.BlockHeight / params.EpochLength
-				if recordEpoch == currentEpoch {
-					epochRecordCount++
-				}
-			}
-			return false, nil
-		})
+	// Enforce the per-epoch record limit of the current epoch.
+	// Amendments don't count against the limit.
+	currentEpoch := epoch.Number
+	epochKey := collections.Join(validatorAddr, currentEpoch)
+	epochAmendments, err := k.EpochAmendments.Get(ctx, epochKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", err
+	}
+
+	if record.SupersedesId == "" {
+		epochRecordCount, err := k.CountValidatorEpochRecords(ctx, validatorAddr, currentEpoch)
 		if err != nil {
 			return "", err
 		}
 
-		if epochRecordCount >= params.RecordsPerEpoch {
+		if epochRecordCount-epochAmendments >= params.RecordsPerEpoch {
 			return "", types.ErrEpochRecordsExceeded.Wrapf(
 				"validator has already submitted %d records in epoch %d",
-				epochRecordCount,
+				epochRecordCount-epochAmendments,
 				currentEpoch,
 			)
 		}
 	}
 
+	// Escrow the submission fee until the record is settled
+	record.Fee, err = k.chargeRecordFee(ctx, params, record)
+	if err != nil {
+		return "", err
+	}
+
+	// Escrow the submission bond forfeited if the record is rejected
+	record.Bond, err = k.postRecordBond(ctx, params, record)
+	if err != nil {
+		return "", err
+	}
+
 	// Create record
-	record := types.Record{
-		Id:               recordID,
-		ValidatorAddress: validatorAddr,
-		Data:             data,
-		Timestamp:        timestamp,
-		Status:           types.RecordStatusPending,
-		MerkleRoot:       merkleRoot,
-		BlockHeight:      blockHeight,
+	record.Id = recordID
+	record.Timestamp = timestamp
+	record.Status = types.RecordStatusPending
+	record.BlockHeight = blockHeight
+	record.Epoch = currentEpoch
+
+	// Under optimistic verification the record is verified automatically once
+	// its dispute window ends without a dispute
+	if params.OptimisticVerificationEnabled {
+		record.DisputeDeadlineHeight = blockHeight + params.DisputeWindowBlocks
 	}
 
 	// Store record
@@ -131,9 +268,37 @@ func (k Keeper) CreateRecord(
 		return "", err
 	}
 
-	// Update validator stats
-	stats.TotalRecords++
-	stats.LastRecordTime = timestamp
+	if record.DisputeDeadlineHeight > 0 {
+		if err := k.DisputeWindowQueue.Set(ctx, collections.Join(record.DisputeDeadlineHeight, recordID)); err != nil {
+			return "", err
+		}
+	}
+
+	if params.VerificationDeadlineSeconds > 0 {
+		if err := k.ExpiryQueue.Set(ctx, collections.Join(expiryTime(timestamp, params), recordID)); err != nil {
+			return "", err
+		}
+	}
+
+	// Update validator stats. An amendment is a new version of a record that
+	// was already counted.
+	if record.SupersedesId != "" {
+		stats.AmendedRecords++
+		if err := k.EpochAmendments.Set(ctx, epochKey, epochAmendments+1); err != nil {
+			return "", err
+		}
+	} else {
+		stats.TotalRecords++
+		stats.LastRecordTime = timestamp
+		stats.LastRecordEpoch = currentEpoch
+
+		err = k.updateEpochActivity(ctx, validatorAddr, func(summary *types.ValidatorEpochSummary) {
+			summary.SubmittedRecords++
+		})
+		if err != nil {
+			return "", err
+		}
+	}
 	if err := k.SetValidatorStats(ctx, validatorAddr, stats); err != nil {
 		return "", err
 	}
@@ -145,18 +310,46 @@ func (k Keeper) CreateRecord(
 			sdk.NewAttribute("record_id", recordID),
 			sdk.NewAttribute("validator", validatorAddr),
 			sdk.NewAttribute("block_height", fmt.Sprintf("%d", blockHeight)),
-			sdk.NewAttribute("merkle_root", merkleRoot),
+			sdk.NewAttribute("merkle_root", record.MerkleRoot),
 		),
 	)
 
 	return recordID, nil
 }
 
+// checkBondedSubmitter checks that a record submitter is a bonded validator
+func (k Keeper) checkBondedSubmitter(ctx context.Context, validatorAddr string) error {
+	// Get validator to ensure they exist
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ErrNotValidator.Wrap(err.Error())
+	}
+
+	// Check if validator is bonded. Validators jailed for missing records
+	// can submit the records they need to unjail themselves.
+	if !validator.IsBonded() {
+		jailed, err := k.jailedForRecords(ctx, validator)
+		if err != nil {
+			return err
+		}
+		if !jailed {
+			return types.ErrNotValidator.Wrap("validator must be bonded to submit records")
+		}
+	}
+
+	return nil
+}
+
 // GetRecord retrieves a record by ID
 func (k Keeper) GetRecord(ctx context.Context, recordID string) (types.Record, error) {
 	record, err := k.Records.Get(ctx, recordID)
 	if err != nil {
-		if err != nil && err.Error() == "collections: not found" {
+		if errors.Is(err, collections.ErrNotFound) {
 			return types.Record{}, types.ErrRecordNotFound.Wrapf("record %s not found", recordID)
 		}
 		return types.Record{}, err
@@ -193,6 +386,10 @@ func (k Keeper) VerifyRecord(ctx context.Context, recordID string, approved bool
 		return err
 	}
 
+	if err := k.queueForPruning(ctx, record); err != nil {
+		return err
+	}
+
 	// Update validator stats
 	stats, err := k.GetValidatorStats(ctx, record.ValidatorAddress)
 	if err != nil {
@@ -205,14 +402,36 @@ func (k Keeper) VerifyRecord(ctx context.Context, recordID string, approved bool
 		stats.RejectedRecords++
 	}
 
-	// Check eligibility based on verified records
-	params, _ := k.Params.Get(ctx)
-	stats.IsEligible = stats.VerifiedRecords >= params.MinVerifiedRecordsForEligibility
+	err = k.updateEpochActivity(ctx, record.ValidatorAddress, func(summary *types.ValidatorEpochSummary) {
+		if approved {
+			summary.VerifiedRecords++
+		} else {
+			summary.RejectedRecords++
+		}
+	})
+	if err != nil {
+		return err
+	}
 
 	if err := k.SetValidatorStats(ctx, record.ValidatorAddress, stats); err != nil {
 		return err
 	}
 
+	// Return or forfeit the bond of a dispute filed against the record
+	if err := k.settleDispute(ctx, recordID, approved); err != nil {
+		return err
+	}
+
+	// Pay the submission fee to the verifiers that voted with the outcome
+	if err := k.settleRecordFee(ctx, record, approved); err != nil {
+		return err
+	}
+
+	// Return the submission bond, or forfeit it if the record was rejected
+	if err := k.settleRecordBond(ctx, record, approved); err != nil {
+		return err
+	}
+
 	// Emit event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -240,14 +459,59 @@ func (k Keeper) GetAllRecords(ctx context.Context) ([]types.Record, error) {
 
 // GetValidatorRecords returns all records for a specific validator
 func (k Keeper) GetValidatorRecords(ctx context.Context, validatorAddr string) ([]types.Record, error) {
-	var records []types.Record
-	err := k.Records.Walk(ctx, nil, func(key string, record types.Record) (bool, error) {
-		if record.ValidatorAddress == validatorAddr {
-			records = append(records, record)
-		}
-		return false, nil
-	})
-	return records, err
+	iter, err := k.Records.Indexes.ByValidator.MatchExact(ctx, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return indexes.CollectValues(ctx, k.Records, iter)
+}
+
+// GetValidatorEpochRecords returns the records a validator submitted in an epoch
+func (k Keeper) GetValidatorEpochRecords(ctx context.Context, validatorAddr string, epoch uint64) ([]types.Record, error) {
+	iter, err := k.Records.Indexes.ByValidatorEpoch.MatchExact(ctx, collections.Join(validatorAddr, epoch))
+	if err != nil {
+		return nil, err
+	}
+	return indexes.CollectValues(ctx, k.Records, iter)
+}
+
+// CountValidatorEpochRecords returns how many records a validator submitted in an epoch
+// without loading the records themselves
+func (k Keeper) CountValidatorEpochRecords(ctx context.Context, validatorAddr string, epoch uint64) (uint64, error) {
+	iter, err := k.Records.Indexes.ByValidatorEpoch.MatchExact(ctx, collections.Join(validatorAddr, epoch))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// DecodeRecordData returns the decoded data of a record stored on chain
+func (k Keeper) DecodeRecordData(record types.Record) ([]byte, error) {
+	if record.IsOffChain() {
+		return nil, types.ErrInvalidDataLocator.Wrapf("record %s data is stored off chain at %s", record.Id, record.DataLocator)
+	}
+
+	if record.DataPruned {
+		return nil, types.ErrInvalidRecordStatus.Wrapf("record %s data was pruned at height %d", record.Id, record.PrunedHeight)
+	}
+
+	// The decoded size was checked when the record was submitted
+	return types.DecodeRecordData(record.ContentEncoding, record.Data, record.PayloadSize())
+}
+
+// GetRecordsByStatus returns all records with the given status
+func (k Keeper) GetRecordsByStatus(ctx context.Context, status types.RecordStatus) ([]types.Record, error) {
+	iter, err := k.Records.Indexes.ByStatus.MatchExact(ctx, int32(status))
+	if err != nil {
+		return nil, err
+	}
+	return indexes.CollectValues(ctx, k.Records, iter)
 }
 
 // generateRecordID generates a unique ID for a record
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// AmendRecord submits amendment as a new version of the record supersedesID.
+// The amendment is stored like a new submission, with its data on chain or,
+// when it has a data locator, off chain; it does not count against the
+// per-epoch record limit.
+//
+// Only the latest version of a pending or rejected record can be amended, by
+// its submitter. Verification does not carry over: the amendment is verified
+// on its own. A superseded pending record is never settled, it becomes
+// SUPERSEDED, a dispute filed against it is voided, its submission fee goes to
+// the reward pool and its bond is returned. A superseded rejected record stays
+// rejected and its rejection still counts in the validator stats.
+func (k Keeper) AmendRecord(ctx context.Context, supersedesID string, amendment types.Record) (string, error) {
+	original, err := k.GetRecord(ctx, supersedesID)
+	if err != nil {
+		return "", err
+	}
+
+	if amendment.ValidatorAddress != original.ValidatorAddress {
+		return "", types.ErrNotValidator.Wrapf("only %s can amend record %s", original.ValidatorAddress, supersedesID)
+	}
+
+	if original.SupersededBy != "" {
+		return "", types.ErrRecordNotAmendable.Wrapf("record %s is already superseded by %s", supersedesID, original.SupersededBy)
+	}
+
+	if original.Status != types.RecordStatusPending && original.Status != types.RecordStatusRejected {
+		return "", types.ErrRecordNotAmendable.Wrapf("record %s is %s, only pending or rejected records can be amended", supersedesID, original.Status)
+	}
+
+	if original.Version+1 >= types.MaxRecordVersions {
+		return "", types.ErrRecordNotAmendable.Wrapf("record %s has reached the limit of %d versions", supersedesID, types.MaxRecordVersions)
+	}
+
+	// Amending must not let the submitter walk away from an availability challenge
+	if has, err := k.OpenChallenges.Has(ctx, supersedesID); err != nil {
+		return "", err
+	} else if has {
+		return "", types.ErrChallengeOpen.Wrapf("record %s", supersedesID)
+	}
+
+	amendment.SupersedesId = supersedesID
+	amendment.Version = original.Version + 1
+
+	var recordID string
+	if amendment.IsOffChain() {
+		recordID, err = k.createOffChainRecord(ctx, amendment)
+	} else {
+		recordID, err = k.createOnChainRecord(ctx, amendment)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	original.SupersededBy = recordID
+	if original.Status == types.RecordStatusPending {
+		original.Status = types.RecordStatusSuperseded
+
+		if err := k.voidDispute(ctx, supersedesID); err != nil {
+			return "", err
+		}
+
+		if err := k.forfeitFee(ctx, original.Fee); err != nil {
+			return "", err
+		}
+
+		if err := k.returnRecordBond(ctx, original); err != nil {
+			return "", err
+		}
+
+		// The superseded data is kept only as long as the data of settled records
+		if err := k.queueForPruning(ctx, original); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.Records.Set(ctx, supersedesID, original); err != nil {
+		return "", err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordAmended,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeySupersedes, supersedesID),
+			sdk.NewAttribute(types.AttributeKeyValidator, original.ValidatorAddress),
+			sdk.NewAttribute("version", fmt.Sprintf("%d", amendment.Version)),
+		),
+	)
+
+	return recordID, nil
+}
+
+// GetRecordVersions returns the version chain recordID belongs to, from the
+// original submission to the latest amendment
+func (k Keeper) GetRecordVersions(ctx context.Context, recordID string) ([]types.Record, error) {
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk back to the original submission; chains are bounded by MaxRecordVersions
+	for record.SupersedesId != "" {
+		if record, err = k.GetRecord(ctx, record.SupersedesId); err != nil {
+			return nil, err
+		}
+	}
+
+	versions := []types.Record{record}
+	for record.SupersededBy != "" {
+		if record, err = k.GetRecord(ctx, record.SupersededBy); err != nil {
+			return nil, err
+		}
+		versions = append(versions, record)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// postRecordBond escrows the submission bond of a record in the module
+// account, paid by the operator account of its submitter, when the invalid
+// record penalty forfeits bonds. It returns the bond posted.
+func (k Keeper) postRecordBond(ctx context.Context, params types.Params, record types.Record) (sdk.Coins, error) {
+	bond := sdk.NewCoins()
+	if !params.UsesRecordBond() {
+		return bond, nil
+	}
+	bond = bond.Add(params.RecordBond)
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, bond); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordBondPosted,
+			sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+		),
+	)
+
+	return bond, nil
+}
+
+// settleRecordBond returns the bond of a settled record to its submitter, or
+// forfeits it as RecordBondForfeiture sets when the record was rejected
+func (k Keeper) settleRecordBond(ctx context.Context, record types.Record, approved bool) error {
+	if approved {
+		return k.returnRecordBond(ctx, record)
+	}
+
+	if record.Bond.IsZero() {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch params.RecordBondForfeiture {
+	case types.BondForfeitureCommunityPool:
+		err = k.distributionKeeper.FundCommunityPool(ctx, record.Bond, authtypes.NewModuleAddress(types.ModuleName))
+	default:
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, record.Bond)
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordBondSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBond, record.Bond.String()),
+			sdk.NewAttribute("forfeiture", params.RecordBondForfeiture.String()),
+		),
+	)
+
+	return nil
+}
+
+// returnRecordBond returns the bond of a record to the operator account of its
+// submitter
+func (k Keeper) returnRecordBond(ctx context.Context, record types.Record) error {
+	if record.Bond.IsZero() {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(valAddr), record.Bond); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordBondSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBond, record.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, record.Status.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestRecordBond(t *testing.T) {
+	bond := sdk.NewInt64Coin("stake", 500)
+
+	testCases := []struct {
+		name         string
+		penalty      types.InvalidRecordPenalty
+		forfeiture   types.BondForfeiture
+		approved     bool
+		expBalance   int64
+		expBurned    int64
+		expCommunity int64
+		expSlashed   bool
+	}{
+		{
+			name:       "bond returned on verification",
+			penalty:    types.InvalidRecordPenaltyBond,
+			approved:   true,
+			expBalance: 1000,
+		},
+		{
+			name:       "bond burned on rejection without slashing",
+			penalty:    types.InvalidRecordPenaltyBond,
+			expBalance: 500,
+			expBurned:  500,
+		},
+		{
+			name:         "bond sent to the community pool on rejection",
+			penalty:      types.InvalidRecordPenaltyBond,
+			forfeiture:   types.BondForfeitureCommunityPool,
+			expBalance:   500,
+			expCommunity: 500,
+		},
+		{
+			name:       "bond forfeited and stake slashed on rejection",
+			penalty:    types.InvalidRecordPenaltyBoth,
+			expBalance: 500,
+			expBurned:  500,
+			expSlashed: true,
+		},
+		{
+			name:       "stake slashed without a bond",
+			penalty:    types.InvalidRecordPenaltySlash,
+			expBalance: 1000,
+			expSlashed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+
+			params := types.DefaultParams()
+			params.InvalidRecordPenalty = tc.penalty
+			params.RecordBond = bond
+			params.RecordBondForfeiture = tc.forfeiture
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			// three verifiers with equal stake: a 33.4% quorum needs two votes
+			submitter := f.addBondedValidator(t)
+			verifiers := []string{f.addBondedValidator(t), f.addBondedValidator(t)}
+			f.addBondedValidator(t)
+			f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+			ctx := f.withBlock(1)
+			recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+			require.NoError(t, err)
+
+			for _, verifier := range verifiers {
+				_, err := ms.VerifyRecord(ctx, &types.MsgVerifyRecord{
+					Verifier: verifier,
+					RecordId: recordID,
+					Approved: tc.approved,
+				})
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expBalance, f.operatorBalance(t, submitter).AmountOf("stake").Int64())
+			require.Equal(t, tc.expBurned, f.bankKeeper.burned.AmountOf("stake").Int64())
+			require.Equal(t, tc.expCommunity, f.distributionKeeper.communityPool.AmountOf("stake").Int64())
+			require.Equal(t, tc.expSlashed, len(f.stakingKeeper.slashes) == 1)
+		})
+	}
+}
+
+func TestRecordBondRequired(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.InvalidRecordPenalty = types.InvalidRecordPenaltyBond
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	_, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.Error(t, err)
+
+	// the bond is returned when a pending record is superseded
+	f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(params.RecordBond.Add(params.RecordBond))
+	recordID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(params.RecordBond), record.Bond)
+
+	_, err = keeper.NewMsgServerImpl(f.keeper).AmendRecord(f.withBlock(2), amendMsg(submitter, recordID, 2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(params.RecordBond), f.operatorBalance(t, submitter))
+}
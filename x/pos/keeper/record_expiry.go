@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// ProcessExpiryQueue expires the records whose verification deadline passed at
+// or before the current block time while they were still pending. Records that
+// were finalized in the meantime are dropped from the queue.
+func (k Keeper) ProcessExpiryQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[int64, string]
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(now+1, ""))
+	err := k.ExpiryQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.ExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		record, err := k.GetRecord(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		if record.Status != types.RecordStatusPending {
+			continue
+		}
+
+		if err := k.expireRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireRecord moves a pending record to the expired status and returns the
+// bond of an unsettled dispute and the submission fee and bond. Records have no
+// assigned verifiers, so the validators that did not vote are not penalized.
+func (k Keeper) expireRecord(ctx context.Context, record types.Record) error {
+	record.Status = types.RecordStatusExpired
+	if err := k.Records.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	if err := k.queueForPruning(ctx, record); err != nil {
+		return err
+	}
+
+	stats, err := k.GetValidatorStats(ctx, record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	stats.ExpiredRecords++
+	if err := k.SetValidatorStats(ctx, record.ValidatorAddress, stats); err != nil {
+		return err
+	}
+
+	err = k.updateEpochActivity(ctx, record.ValidatorAddress, func(summary *types.ValidatorEpochSummary) {
+		summary.ExpiredRecords++
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.voidDispute(ctx, record.Id); err != nil {
+		return err
+	}
+
+	if err := k.refundRecordFee(ctx, record); err != nil {
+		return err
+	}
+
+	if err := k.returnRecordBond(ctx, record); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordExpired,
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+		),
+	)
+
+	return nil
+}
+
+// expiryTime returns the unix time a record submitted at timestamp expires
+func expiryTime(timestamp int64, params types.Params) int64 {
+	return timestamp + int64(params.VerificationDeadlineSeconds)
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestProcessExpiryQueue(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.VerificationDeadlineSeconds = 60
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// three verifiers with equal stake: one vote stays below the 33.4% quorum
+	submitter := f.addBondedValidator(t)
+	voter := f.addBondedValidator(t)
+	f.addBondedValidator(t)
+	f.addBondedValidator(t)
+
+	start := time.Unix(1_000_000, 0)
+	at := func(height int64, blockTime time.Time) sdk.Context {
+		return sdk.UnwrapSDKContext(f.withBlock(height)).WithBlockTime(blockTime)
+	}
+
+	staleID, err := f.keeper.CreateRecord(at(1, start), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	_, err = ms.VerifyRecord(at(2, start), &types.MsgVerifyRecord{Verifier: voter, RecordId: staleID, Approved: true})
+	require.NoError(t, err)
+
+	// a record finalized before its deadline is left alone
+	settledID, err := f.keeper.CreateRecord(at(1, start), submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(at(2, start), settledID, true))
+
+	freshID, err := f.keeper.CreateRecord(at(3, start.Add(30*time.Second)), submitter, "", recordData(3), types.RecordMerkleRoot(recordData(3)))
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ProcessExpiryQueue(at(4, start.Add(59*time.Second))))
+	record, err := f.keeper.GetRecord(f.ctx, staleID)
+	require.NoError(t, err)
+	require.Equal(t, types.RecordStatusPending, record.Status)
+
+	require.NoError(t, f.keeper.ProcessExpiryQueue(at(5, start.Add(60*time.Second))))
+
+	expected := map[string]types.RecordStatus{
+		staleID:   types.RecordStatusExpired,
+		settledID: types.RecordStatusVerified,
+		freshID:   types.RecordStatusPending,
+	}
+	for id, status := range expected {
+		record, err := f.keeper.GetRecord(f.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, status, record.Status)
+	}
+
+	stats, err := f.keeper.GetValidatorStats(f.ctx, submitter)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.ExpiredRecords)
+
+	// the validator that never voted is not penalized
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	expired, err := f.keeper.GetRecordsByStatus(f.ctx, types.RecordStatusExpired)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+}
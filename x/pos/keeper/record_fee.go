@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// chargeRecordFee escrows the submission fee of a record, paid by the operator
+// account of its submitter, and returns the fee charged
+func (k Keeper) chargeRecordFee(ctx context.Context, params types.Params, record types.Record) (sdk.Coins, error) {
+	fee := params.RecordFee(record.DataSize)
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.FeeEscrowName, fee); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordFeePaid,
+			sdk.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, fee.String()),
+		),
+	)
+
+	return fee, nil
+}
+
+// settleRecordFee pays the escrowed fee of a settled record to the verifiers
+// that voted with the outcome, in proportion to their voting power. What is
+// left over by rounding, or all of it when no verifier voted with the outcome,
+// goes to the reward pool.
+func (k Keeper) settleRecordFee(ctx context.Context, record types.Record, approved bool) error {
+	if record.Fee.IsZero() {
+		return nil
+	}
+
+	var (
+		votes []types.RecordVote
+		total = math.ZeroInt()
+	)
+	rng := collections.NewPrefixedPairRange[string, string](record.Id)
+	err := k.RecordVotes.Walk(ctx, rng, func(_ collections.Pair[string, string], vote types.RecordVote) (bool, error) {
+		if vote.Approved == approved && vote.Power.IsPositive() {
+			votes = append(votes, vote)
+			total = total.Add(vote.Power)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	paid := sdk.NewCoins()
+	for _, vote := range votes {
+		share := sdk.NewCoins()
+		for _, coin := range record.Fee {
+			share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(vote.Power).Quo(total)))
+		}
+		if share.IsZero() {
+			continue
+		}
+
+		recipient, err := k.validatorPayoutAddress(ctx, vote.Verifier)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeEscrowName, recipient, share); err != nil {
+			return err
+		}
+		paid = paid.Add(share...)
+	}
+
+	unclaimed := record.Fee.Sub(paid...)
+	if err := k.forfeitFee(ctx, unclaimed); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordFeeSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", approved)),
+			sdk.NewAttribute("verifiers_paid", paid.String()),
+			sdk.NewAttribute("reward_pool", unclaimed.String()),
+		),
+	)
+
+	return nil
+}
+
+// refundRecordFee returns the escrowed fee of a record that expired before
+// verifiers settled it to the operator account of its submitter
+func (k Keeper) refundRecordFee(ctx context.Context, record types.Record) error {
+	if record.Fee.IsZero() {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeEscrowName, sdk.AccAddress(valAddr), record.Fee); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordFeeSettled,
+			sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+			sdk.NewAttribute(types.AttributeKeyStatus, record.Status.String()),
+			sdk.NewAttribute("refunded", record.Fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// forfeitFee moves escrowed fees no verifier earned to the reward pool
+func (k Keeper) forfeitFee(ctx context.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeEscrowName, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return k.addToRewardPool(ctx, types.FeeEscrowName, amount)
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// setRecordFee sets a flat fee of 101stake and a per-byte fee of 1stake, so a
+// record of 128 bytes costs 229stake
+func (f *fixture) setRecordFee(t *testing.T) types.Params {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.RecordFeeFlat = sdk.NewInt64Coin("stake", 101)
+	params.RecordFeePerByte = sdk.NewInt64Coin("stake", 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	return params
+}
+
+// operatorBalance returns the balance of the operator account of a validator
+func (f *fixture) operatorBalance(t *testing.T, validator string) sdk.Coins {
+	t.Helper()
+
+	return f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, validator)).String()]
+}
+
+func TestRecordFeeEscrow(t *testing.T) {
+	f := initFixture(t)
+	f.setRecordFee(t)
+	submitter := f.addBondedValidator(t)
+	ctx := f.withBlock(1)
+
+	_, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.Error(t, err)
+
+	f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 229))
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, fee, record.Fee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 771)), f.operatorBalance(t, submitter))
+	require.Equal(t, fee, f.bankKeeper.balances[authtypes.NewModuleAddress(types.FeeEscrowName).String()])
+}
+
+func TestRecordFeeSettlement(t *testing.T) {
+	testCases := []struct {
+		name      string
+		votes     []bool
+		expPaid   []int64
+		expToPool int64
+	}{
+		{
+			name:      "split between the approving verifiers",
+			votes:     []bool{true, true},
+			expPaid:   []int64{114, 114},
+			expToPool: 1,
+		},
+		{
+			name:      "split between the rejecting verifiers",
+			votes:     []bool{false, false},
+			expPaid:   []int64{114, 114},
+			expToPool: 1,
+		},
+		{
+			name:    "only verifiers voting with the outcome are paid",
+			votes:   []bool{false, true},
+			expPaid: []int64{0, 229},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			f.setRecordFee(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+
+			// three verifiers with equal stake: a 33.4% quorum needs two votes
+			submitter := f.addBondedValidator(t)
+			verifiers := []string{f.addBondedValidator(t), f.addBondedValidator(t), f.addBondedValidator(t)}
+			f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 229))
+
+			ctx := f.withBlock(1)
+			recordID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+			require.NoError(t, err)
+
+			for i, approved := range tc.votes {
+				_, err := ms.VerifyRecord(ctx, &types.MsgVerifyRecord{
+					Verifier: verifiers[i],
+					RecordId: recordID,
+					Approved: approved,
+				})
+				require.NoError(t, err)
+			}
+
+			for i, paid := range tc.expPaid {
+				require.Equal(t, paid, f.operatorBalance(t, verifiers[i]).AmountOf("stake").Int64())
+			}
+
+			pool, err := f.keeper.GetRewardPool(f.ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expToPool, pool.Balance.AmountOf("stake").Int64())
+			require.True(t, f.bankKeeper.balances[authtypes.NewModuleAddress(types.FeeEscrowName).String()].IsZero())
+		})
+	}
+}
+
+func TestUnclaimedRecordFees(t *testing.T) {
+	f := initFixture(t)
+	params := f.setRecordFee(t)
+	params.VerificationDeadlineSeconds = 60
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	submitter := f.addBondedValidator(t)
+	f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, submitter)).String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	ctx := sdk.UnwrapSDKContext(f.withBlock(1)).WithBlockTime(time.Unix(1000, 0))
+
+	// settled without votes: the fee goes to the reward pool
+	verifiedID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(ctx, verifiedID, true))
+
+	// superseded while pending: the fee goes to the reward pool
+	supersededID, err := f.keeper.CreateRecord(ctx, submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(f.keeper).AmendRecord(ctx, amendMsg(submitter, supersededID, 3))
+	require.NoError(t, err)
+
+	pool, err := f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 458)), pool.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 313)), f.operatorBalance(t, submitter))
+
+	// expired: the fee of the amendment is refunded
+	require.NoError(t, f.keeper.ProcessExpiryQueue(ctx.WithBlockTime(time.Unix(1060, 0))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 542)), f.operatorBalance(t, submitter))
+	require.True(t, f.bankKeeper.balances[authtypes.NewModuleAddress(types.FeeEscrowName).String()].IsZero())
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordIndexes defines the secondary indexes kept alongside Keeper.Records
+type RecordIndexes struct {
+	// ByValidator maps a validator address to the ids of its records
+	ByValidator *indexes.Multi[string, string, types.Record]
+
+	// ByValidatorEpoch maps (validator address, epoch) to record ids
+	ByValidatorEpoch *indexes.Multi[collections.Pair[string, uint64], string, types.Record]
+
+	// ByStatus maps a record status to record ids
+	ByStatus *indexes.Multi[int32, string, types.Record]
+
+	// ByEpoch maps an epoch to the ids of the records submitted in it
+	ByEpoch *indexes.Multi[uint64, string, types.Record]
+
+	// ByHeight maps a block height to the ids of the records submitted at it
+	ByHeight *indexes.Multi[uint64, string, types.Record]
+}
+
+// IndexesList implements collections.Indexes
+func (i RecordIndexes) IndexesList() []collections.Index[string, types.Record] {
+	return []collections.Index[string, types.Record]{
+		i.ByValidator,
+		i.ByValidatorEpoch,
+		i.ByStatus,
+		i.ByEpoch,
+		i.ByHeight,
+	}
+}
+
+func newRecordIndexes(sb *collections.SchemaBuilder) RecordIndexes {
+	return RecordIndexes{
+		ByValidator: indexes.NewMulti(
+			sb,
+			types.RecordsByValidatorKey,
+			"records_by_validator",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, record types.Record) (string, error) {
+				return record.ValidatorAddress, nil
+			},
+		),
+		ByValidatorEpoch: indexes.NewMulti(
+			sb,
+			types.RecordsByValidatorEpochKey,
+			"records_by_validator_epoch",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.StringKey,
+			func(_ string, record types.Record) (collections.Pair[string, uint64], error) {
+				return collections.Join(record.ValidatorAddress, record.Epoch), nil
+			},
+		),
+		ByStatus: indexes.NewMulti(
+			sb,
+			types.RecordsByStatusKey,
+			"records_by_status",
+			collections.Int32Key,
+			collections.StringKey,
+			func(_ string, record types.Record) (int32, error) {
+				return int32(record.Status), nil
+			},
+		),
+		ByEpoch: indexes.NewMulti(
+			sb,
+			types.RecordsByEpochKey,
+			"records_by_epoch",
+			collections.Uint64Key,
+			collections.StringKey,
+			func(_ string, record types.Record) (uint64, error) {
+				return record.Epoch, nil
+			},
+		),
+		ByHeight: indexes.NewMulti(
+			sb,
+			types.RecordsByHeightKey,
+			"records_by_height",
+			collections.Uint64Key,
+			collections.StringKey,
+			func(_ string, record types.Record) (uint64, error) {
+				return record.BlockHeight, nil
+			},
+		),
+	}
+}
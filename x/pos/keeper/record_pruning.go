@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// maxRecordsPrunedPerBlock bounds the work of the prune sweep in a single
+// block; records left over are pruned in the following blocks
+const maxRecordsPrunedPerBlock = 100
+
+// queueForPruning queues a finalized record for pruning once the data
+// retention period has passed. Records without on-chain data are skipped.
+func (k Keeper) queueForPruning(ctx context.Context, record types.Record) error {
+	if len(record.Data) == 0 {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return k.PruneQueue.Set(ctx, collections.Join(height, record.Id))
+}
+
+// ProcessPruneQueue deletes the data of records finalized at least
+// DataRetentionBlocks blocks ago, oldest first. When a data archive is set the
+// data is exported to it before deletion.
+func (k Keeper) ProcessPruneQueue(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if params.DataRetentionBlocks == 0 || height < params.DataRetentionBlocks {
+		return nil
+	}
+	cutoff := height - params.DataRetentionBlocks
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[uint64, string]
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(cutoff+1, ""))
+	err = k.PruneQueue.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		due = append(due, key)
+		return len(due) == maxRecordsPrunedPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		if err := k.PruneQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		record, err := k.GetRecord(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		if record.DataPruned || len(record.Data) == 0 {
+			continue
+		}
+
+		k.archiveRecordData(ctx, record)
+
+		record.Data = nil
+		record.DataPruned = true
+		record.PrunedHeight = height
+		if err := k.Records.Set(ctx, record.Id, record); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDataPruned,
+				sdk.NewAttribute(types.AttributeKeyRecordID, record.Id),
+				sdk.NewAttribute("merkle_root", record.MerkleRoot),
+				sdk.NewAttribute("data_size", fmt.Sprintf("%d", record.DataSize)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// archiveRecordData exports record data to the node's data archive, if any.
+// Failures are only logged: the archive is local to the node and pruning must
+// happen the same way on every node.
+func (k Keeper) archiveRecordData(ctx context.Context, record types.Record) {
+	if k.dataArchive == nil {
+		return
+	}
+
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+
+	locator, err := k.dataArchive.Put(record.Data)
+	if err != nil {
+		logger.Error(
+			"failed to archive record data before pruning",
+			"record_id", record.Id,
+			"error", err,
+		)
+		return
+	}
+
+	logger.Info("archived record data", "record_id", record.Id, "locator", locator)
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/offchain"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestProcessPruneQueue(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.DataRetentionBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	archive := offchain.NewMemStore()
+	f.keeper.SetDataArchive(archive)
+
+	submitter := f.addBondedValidator(t)
+
+	verifiedID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(f.withBlock(2), verifiedID, true))
+
+	pendingID, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+
+	// the retention period counts from the finalize height
+	require.NoError(t, f.keeper.ProcessPruneQueue(f.withBlock(11)))
+	record, err := f.keeper.GetRecord(f.ctx, verifiedID)
+	require.NoError(t, err)
+	require.False(t, record.DataPruned)
+	require.Equal(t, recordData(1), record.Data)
+
+	require.NoError(t, f.keeper.ProcessPruneQueue(f.withBlock(12)))
+	record, err = f.keeper.GetRecord(f.ctx, verifiedID)
+	require.NoError(t, err)
+	require.True(t, record.DataPruned)
+	require.Equal(t, uint64(12), record.PrunedHeight)
+	require.Empty(t, record.Data)
+	require.Equal(t, types.RecordStatusVerified, record.Status)
+	require.Equal(t, types.RecordMerkleRoot(recordData(1)), record.MerkleRoot)
+	require.Equal(t, verifiedID, record.Id)
+	require.Equal(t, uint64(1), record.BlockHeight)
+
+	// the data was exported to the archive before deletion
+	archived, err := offchain.Fetch(archive, "mem://"+record.MerkleRoot, record.MerkleRoot)
+	require.NoError(t, err)
+	require.Equal(t, recordData(1), archived)
+
+	// pending records keep their data
+	pending, err := f.keeper.GetRecord(f.ctx, pendingID)
+	require.NoError(t, err)
+	require.False(t, pending.DataPruned)
+	require.Equal(t, recordData(2), pending.Data)
+
+	// pruned records can no longer serve chunk proofs
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, err = qs.RecordChunkProof(f.ctx, &types.QueryRecordChunkProofRequest{RecordId: verifiedID})
+	require.ErrorContains(t, err, "pruned")
+}
+
+func TestProcessPruneQueueDisabled(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+
+	id, err := f.keeper.CreateRecord(f.withBlock(1), submitter, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyRecord(f.withBlock(2), id, true))
+
+	require.NoError(t, f.keeper.ProcessPruneQueue(f.withBlock(1_000_000)))
+	record, err := f.keeper.GetRecord(f.ctx, id)
+	require.NoError(t, err)
+	require.False(t, record.DataPruned)
+	require.Equal(t, recordData(1), record.Data)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// countEpochRecords records in the signing window of a validator whether it
+// submitted a record in the epoch that is ending. Epochs before the first one
+// the validator is required to submit in, or in its onboarding grace period,
+// are not counted.
+func (k Keeper) countEpochRecords(ctx context.Context, validatorAddr string, epoch uint64) error {
+	stats, err := k.ValidatorStats.Get(ctx, validatorAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if epoch < stats.NextRequiredRecordEpoch || stats.InGracePeriod(epoch) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	info, err := k.RecordSigningInfos.Get(ctx, validatorAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		info = types.RecordSigningInfo{ValidatorAddress: validatorAddr, StartEpoch: epoch}
+	} else if err != nil {
+		return err
+	}
+
+	submitted := stats.TotalRecords > 0 && stats.LastRecordEpoch == epoch
+	if !submitted {
+		if err := k.RecordMissedEpochs.Set(ctx, collections.Join(validatorAddr, epoch)); err != nil {
+			return err
+		}
+	}
+
+	// Drop the epochs that slid out of the window, then count what is left
+	start := windowStart(epoch, params.SignedEpochsWindow)
+	var stale []collections.Pair[string, uint64]
+	rng := collections.NewPrefixedPairRange[string, uint64](validatorAddr).EndExclusive(start)
+	err = k.RecordMissedEpochs.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		stale = append(stale, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range stale {
+		if err := k.RecordMissedEpochs.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	missed, err := k.missedEpochsInWindow(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	info.LastEpoch = epoch
+	info.MissedEpochsCounter = uint64(len(missed))
+	return k.RecordSigningInfos.Set(ctx, validatorAddr, info)
+}
+
+// missedEpochsInWindow returns the epochs in the signing window a validator
+// did not submit a record in
+func (k Keeper) missedEpochsInWindow(ctx context.Context, validatorAddr string) ([]uint64, error) {
+	var missed []uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](validatorAddr)
+	err := k.RecordMissedEpochs.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		missed = append(missed, key.K2())
+		return false, nil
+	})
+	return missed, err
+}
+
+// verifiedRecordsInWindow returns how many records of a validator were
+// verified in the window epochs ending at the current epoch, counted from the
+// summaries of the ended epochs and the activity of the current one
+func (k Keeper) verifiedRecordsInWindow(ctx context.Context, validatorAddr string, epoch, window uint64) (uint64, error) {
+	activity, err := k.EpochActivity.Get(ctx, collections.Join(epoch, validatorAddr))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	count := activity.VerifiedRecords
+	for ended := windowStart(epoch, window); ended < epoch; ended++ {
+		summary, err := k.ValidatorEpochSummaries.Get(ctx, collections.Join(ended, validatorAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		count += summary.VerifiedRecords
+	}
+	return count, nil
+}
+
+// resetRecordSigningInfo starts the signing window of a validator over
+func (k Keeper) resetRecordSigningInfo(ctx context.Context, validatorAddr string) error {
+	missed, err := k.missedEpochsInWindow(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	for _, epoch := range missed {
+		if err := k.RecordMissedEpochs.Remove(ctx, collections.Join(validatorAddr, epoch)); err != nil {
+			return err
+		}
+	}
+	return k.RecordSigningInfos.Remove(ctx, validatorAddr)
+}
+
+// windowStart returns the first epoch of the signing window ending at epoch
+func windowStart(epoch, window uint64) uint64 {
+	if epoch+1 < window {
+		return 0
+	}
+	return epoch + 1 - window
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestRecordSigningWindow(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// a window of four epochs, two of which must have a record
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	params.SignedEpochsWindow = 4
+	params.MinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	validator := f.addBondedValidator(t)
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), validator))
+
+	_, err := qs.RecordSigningInfo(f.ctx, &types.QueryRecordSigningInfoRequest{ValidatorAddress: validator})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	f.beginBlock(t, 10)
+
+	steps := []struct {
+		submits     bool
+		expEligible bool
+		expMissed   []uint64
+	}{
+		{submits: true, expEligible: true},
+		{submits: false, expEligible: true, expMissed: []uint64{2}},
+		{submits: false, expEligible: true, expMissed: []uint64{2, 3}},
+		{submits: false, expEligible: false, expMissed: []uint64{2, 3, 4}},
+		{submits: true, expEligible: false, expMissed: []uint64{2, 3, 4}},
+		// epoch 2 slides out of the window
+		{submits: true, expEligible: true, expMissed: []uint64{3, 4}},
+	}
+
+	for i, step := range steps {
+		epoch := int64(i + 1)
+		if step.submits {
+			data := recordData(byte(epoch))
+			_, err := f.keeper.CreateRecord(f.withBlock(epoch*10+1), validator, "", data, types.RecordMerkleRoot(data))
+			require.NoError(t, err)
+		}
+		f.beginBlock(t, epoch*10+10)
+
+		stats, err := f.keeper.GetValidatorStats(f.ctx, validator)
+		require.NoError(t, err)
+		require.Equal(t, step.expEligible, stats.IsEligible, "epoch %d", epoch)
+
+		res, err := qs.RecordSigningInfo(f.ctx, &types.QueryRecordSigningInfoRequest{ValidatorAddress: validator})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.Info.StartEpoch)
+		require.Equal(t, uint64(epoch), res.Info.LastEpoch)
+		require.Equal(t, uint64(len(step.expMissed)), res.Info.MissedEpochsCounter, "epoch %d", epoch)
+		require.Equal(t, step.expMissed, res.MissedEpochs, "epoch %d", epoch)
+		require.Equal(t, uint64(2), res.MaxMissedEpochs)
+	}
+
+	// only the two epochs the validator ended ineligible are penalized
+	require.Len(t, f.stakingKeeper.slashes, 2)
+}
+
+func TestEligibilityVerifiedRecordsWindow(t *testing.T) {
+	f := initFixture(t)
+
+	// two records must have been verified in a window of two epochs
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 2
+	params.VerifiedRecordsWindow = 2
+	params.SignedEpochsWindow = 2
+	params.MinSignedPerWindow = math.LegacyZeroDec()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	validator := f.addBondedValidator(t)
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), validator))
+	f.beginBlock(t, 10)
+
+	f.verifiedRecords(t, f.withBlock(11), validator, 1, 3)
+
+	// the records verified in epoch 1 are counted while it is in the window,
+	// once it slid out the lifetime total no longer counts
+	for _, step := range []struct {
+		epoch       int64
+		expEligible bool
+	}{{1, true}, {2, true}, {3, false}} {
+		f.beginBlock(t, step.epoch*10+10)
+		stats, err := f.keeper.GetValidatorStats(f.ctx, validator)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), stats.VerifiedRecords)
+		require.Equal(t, step.expEligible, stats.IsEligible, "epoch %d", step.epoch)
+	}
+
+	eligible, err := f.keeper.CheckValidatorEligibility(f.ctx, validator)
+	require.NoError(t, err)
+	require.False(t, eligible)
+
+	// without a window the lifetime total counts
+	params.VerifiedRecordsWindow = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	eligible, err = f.keeper.CheckValidatorEligibility(f.ctx, validator)
+	require.NoError(t, err)
+	require.True(t, eligible)
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func recordData(seed byte) []byte {
+	return bytes.Repeat([]byte{seed}, 128)
+}
+
+func TestCreateRecordIndexes(t *testing.T) {
+	f := initFixture(t)
+	valA := f.addBondedValidator(t)
+	valB := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+
+	// two records for A in epoch 0, one for A in epoch 1, one for B in epoch 0
+	ctx := f.withBlock(1)
+	id1, err := f.keeper.CreateRecord(ctx, valA, "", recordData(1), types.RecordMerkleRoot(recordData(1)))
+	require.NoError(t, err)
+	_, err = f.keeper.CreateRecord(ctx, valA, "", recordData(2), types.RecordMerkleRoot(recordData(2)))
+	require.NoError(t, err)
+	_, err = f.keeper.CreateRecord(ctx, valB, "", recordData(3), types.RecordMerkleRoot(recordData(3)))
+	require.NoError(t, err)
+	_, err = f.keeper.CreateRecord(f.beginBlock(t, int64(params.EpochLength)+1), valA, "", recordData(4), types.RecordMerkleRoot(recordData(4)))
+	require.NoError(t, err)
+
+	records, err := f.keeper.GetValidatorRecords(f.ctx, valA)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	records, err = f.keeper.GetValidatorEpochRecords(f.ctx, valA, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	count, err := f.keeper.CountValidatorEpochRecords(f.ctx, valA, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	count, err = f.keeper.CountValidatorEpochRecords(f.ctx, valB, 1)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	pending, err := f.keeper.GetRecordsByStatus(f.ctx, types.RecordStatusPending)
+	require.NoError(t, err)
+	require.Len(t, pending, 4)
+
+	// status index follows status changes
+	require.NoError(t, f.keeper.VerifyRecord(ctx, id1, true))
+
+	pending, err = f.keeper.GetRecordsByStatus(f.ctx, types.RecordStatusPending)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	verified, err := f.keeper.GetRecordsByStatus(f.ctx, types.RecordStatusVerified)
+	require.NoError(t, err)
+	require.Len(t, verified, 1)
+	require.Equal(t, id1, verified[0].Id)
+}
+
+func TestCreateRecordEpochLimit(t *testing.T) {
+	f := initFixture(t)
+	val := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.RecordsPerEpoch = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	ctx := f.withBlock(1)
+	for i := byte(0); i < 2; i++ {
+		_, err := f.keeper.CreateRecord(ctx, val, "", recordData(i), types.RecordMerkleRoot(recordData(i)))
+		require.NoError(t, err)
+	}
+
+	_, err := f.keeper.CreateRecord(ctx, val, "", recordData(9), types.RecordMerkleRoot(recordData(9)))
+	require.ErrorIs(t, err, types.ErrEpochRecordsExceeded)
+
+	// the limit resets in the next epoch
+	_, err = f.keeper.CreateRecord(f.beginBlock(t, int64(params.EpochLength)), val, "", recordData(9), types.RecordMerkleRoot(recordData(9)))
+	require.NoError(t, err)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	val := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+
+	// simulate a record written before the epoch field and indexes existed
+	record := types.Record{
+		Id:               "legacy",
+		ValidatorAddress: val,
+		Data:             recordData(1),
+		Status:           types.RecordStatusPending,
+		MerkleRoot:       "root",
+		BlockHeight:      params.EpochLength*3 + 5,
+	}
+	require.NoError(t, f.keeper.Records.Set(f.ctx, record.Id, record))
+	require.NoError(t, f.keeper.Records.Indexes.ByValidatorEpoch.Unreference(f.ctx, record.Id, func() (types.Record, error) {
+		return record, nil
+	}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.GetRecord(f.ctx, record.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), got.Epoch)
+
+	records, err := f.keeper.GetValidatorEpochRecords(f.ctx, val, 3)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+func TestCreateRecordMerkleRoot(t *testing.T) {
+	f := initFixture(t)
+	val := f.addBondedValidator(t)
+	ctx := f.withBlock(1)
+
+	_, err := f.keeper.CreateRecord(ctx, val, "", recordData(1), types.RecordMerkleRoot(recordData(2)))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleRoot)
+
+	_, err = f.keeper.CreateRecord(ctx, val, "", recordData(1), "")
+	require.ErrorIs(t, err, types.ErrInvalidMerkleRoot)
+
+	// roots are matched case-insensitively and stored lower case
+	root := types.RecordMerkleRoot(recordData(1))
+	id, err := f.keeper.CreateRecord(ctx, val, "", recordData(1), strings.ToUpper(root))
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, root, record.MerkleRoot)
+}
+
+func TestRecordChunkProofQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	val := f.addBondedValidator(t)
+
+	// three full chunks and a partial one
+	data := make([]byte, 3*types.RecordChunkSize+100)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	id, err := f.keeper.CreateRecord(f.withBlock(1), val, "", data, types.RecordMerkleRoot(data))
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 4; i++ {
+		resp, err := qs.RecordChunkProof(f.ctx, &types.QueryRecordChunkProofRequest{RecordId: id, Index: i})
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), resp.Proof.Total)
+		require.NoError(t, resp.Proof.Verify(resp.MerkleRoot, resp.Chunk))
+
+		// a tampered chunk does not verify
+		tampered := bytes.Clone(resp.Chunk)
+		tampered[0]++
+		require.ErrorIs(t, resp.Proof.Verify(resp.MerkleRoot, tampered), types.ErrInvalidMerkleRoot)
+	}
+
+	_, err = qs.RecordChunkProof(f.ctx, &types.QueryRecordChunkProofRequest{RecordId: id, Index: 4})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.RecordChunkProof(f.ctx, &types.QueryRecordChunkProofRequest{RecordId: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
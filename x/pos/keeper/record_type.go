@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// SetRecordType registers a record type, replacing any type with the same id
+func (k Keeper) SetRecordType(ctx context.Context, recordType types.RecordType) error {
+	if err := recordType.Validate(); err != nil {
+		return err
+	}
+
+	return k.RecordTypes.Set(ctx, recordType.Id, recordType)
+}
+
+// RemoveRecordType removes a registered record type. Records of the type keep
+// their type id.
+func (k Keeper) RemoveRecordType(ctx context.Context, id string) error {
+	if has, err := k.RecordTypes.Has(ctx, id); err != nil {
+		return err
+	} else if !has {
+		return types.ErrRecordTypeNotFound.Wrapf("record type %s not found", id)
+	}
+
+	return k.RecordTypes.Remove(ctx, id)
+}
+
+// GetRecordType returns a registered record type by id
+func (k Keeper) GetRecordType(ctx context.Context, id string) (types.RecordType, error) {
+	recordType, err := k.RecordTypes.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecordType{}, types.ErrRecordTypeNotFound.Wrapf("record type %s not found", id)
+		}
+		return types.RecordType{}, err
+	}
+
+	return recordType, nil
+}
+
+// checkRecordType checks record data against the size limits and schema of its
+// record type. Untyped records are not checked. The data of off-chain records
+// (nil data) is not available, so only their size is checked.
+func (k Keeper) checkRecordType(ctx context.Context, id string, data []byte, dataSize uint64) error {
+	if id == "" {
+		return nil
+	}
+
+	recordType, err := k.GetRecordType(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := recordType.ValidateSize(dataSize); err != nil {
+		return err
+	}
+
+	if data == nil {
+		return nil
+	}
+
+	schema, err := recordType.CompileSchema()
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(data)
+}
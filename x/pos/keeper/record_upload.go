@@ -0,0 +1,371 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RecordUploadIndexes defines the secondary indexes kept alongside
+// Keeper.RecordUploads
+type RecordUploadIndexes struct {
+	// ByValidator maps a validator address to the ids of its open uploads
+	ByValidator *indexes.Multi[string, uint64, types.RecordUpload]
+}
+
+// IndexesList implements collections.Indexes
+func (i RecordUploadIndexes) IndexesList() []collections.Index[uint64, types.RecordUpload] {
+	return []collections.Index[uint64, types.RecordUpload]{
+		i.ByValidator,
+	}
+}
+
+func newRecordUploadIndexes(sb *collections.SchemaBuilder) RecordUploadIndexes {
+	return RecordUploadIndexes{
+		ByValidator: indexes.NewMulti(
+			sb,
+			types.RecordUploadsByValidatorKey,
+			"record_uploads_by_validator",
+			collections.StringKey,
+			collections.Uint64Key,
+			func(_ uint64, upload types.RecordUpload) (string, error) {
+				return upload.ValidatorAddress, nil
+			},
+		),
+	}
+}
+
+// BeginRecordUpload opens a chunked upload of a record too large for a single
+// transaction. The upload must be finalized within UploadTimeoutBlocks blocks
+// and a validator can have at most MaxOpenUploadsPerValidator uploads open.
+func (k Keeper) BeginRecordUpload(
+	ctx context.Context,
+	validatorAddr string,
+	merkleRoot string,
+	totalSize uint64,
+	recordType string,
+	encoding types.ContentEncoding,
+) (types.RecordUpload, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if err := k.checkBondedSubmitter(ctx, validatorAddr); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if totalSize == 0 || totalSize > params.MaxRecordSize {
+		return types.RecordUpload{}, types.ErrInvalidRecordSize.Wrapf(
+			"upload size %d is not within bounds [1, %d]",
+			totalSize,
+			params.MaxRecordSize,
+		)
+	}
+
+	if _, err := types.ParseMerkleRoot(merkleRoot); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if _, ok := types.ContentEncoding_name[int32(encoding)]; !ok {
+		return types.RecordUpload{}, types.ErrInvalidContentEncoding.Wrapf("unknown content encoding %d", encoding)
+	}
+
+	// Fail early on unknown types, the data is checked against the type at finalization
+	if recordType != "" {
+		if _, err := k.GetRecordType(ctx, recordType); err != nil {
+			return types.RecordUpload{}, err
+		}
+	}
+
+	open, err := k.countOpenUploads(ctx, validatorAddr, params.MaxOpenUploadsPerValidator)
+	if err != nil {
+		return types.RecordUpload{}, err
+	}
+	if open >= params.MaxOpenUploadsPerValidator {
+		return types.RecordUpload{}, types.ErrTooManyUploads.Wrapf(
+			"validator %s has %d uploads open",
+			validatorAddr,
+			open,
+		)
+	}
+
+	id, err := k.RecordUploadSeq.Next(ctx)
+	if err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	upload := types.RecordUpload{
+		Id:               id,
+		ValidatorAddress: validatorAddr,
+		MerkleRoot:       strings.ToLower(merkleRoot),
+		TotalSize:        totalSize,
+		RecordType:       recordType,
+		ContentEncoding:  encoding,
+		BlockHeight:      height,
+		DeadlineHeight:   height + params.UploadTimeoutBlocks,
+	}
+
+	if err := k.RecordUploads.Set(ctx, id, upload); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if err := k.UploadQueue.Set(ctx, collections.Join(upload.DeadlineHeight, id)); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUploadBegun,
+			sdk.NewAttribute(types.AttributeKeyUploadID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
+			sdk.NewAttribute("total_size", fmt.Sprintf("%d", totalSize)),
+			sdk.NewAttribute("deadline_height", fmt.Sprintf("%d", upload.DeadlineHeight)),
+		),
+	)
+
+	return upload, nil
+}
+
+// UploadRecordChunk appends the next chunk of data to an open upload. Chunks
+// must be uploaded in order and cannot exceed the declared upload size. The
+// store gas finalizing the upload spends on the chunk is charged with it.
+func (k Keeper) UploadRecordChunk(
+	ctx context.Context,
+	validatorAddr string,
+	uploadID uint64,
+	index uint64,
+	data []byte,
+) (types.RecordUpload, error) {
+	upload, err := k.getOpenUpload(ctx, validatorAddr, uploadID)
+	if err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if index != upload.ChunkCount {
+		return types.RecordUpload{}, types.ErrInvalidUploadChunk.Wrapf(
+			"upload %d expects chunk %d, got %d",
+			uploadID,
+			upload.ChunkCount,
+			index,
+		)
+	}
+
+	size := uint64(len(data))
+	if size == 0 {
+		return types.RecordUpload{}, types.ErrInvalidUploadChunk.Wrap("chunk cannot be empty")
+	}
+	if upload.ReceivedSize+size > upload.TotalSize {
+		return types.RecordUpload{}, types.ErrInvalidUploadChunk.Wrapf(
+			"chunk of %d bytes exceeds the declared upload size %d by %d bytes",
+			size,
+			upload.TotalSize,
+			upload.ReceivedSize+size-upload.TotalSize,
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(finalizeGas(sdkCtx.KVGasConfig(), size), "record upload chunk")
+
+	if err := k.UploadChunks.Set(ctx, collections.Join(uploadID, index), data); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	upload.ChunkCount++
+	upload.ReceivedSize += size
+	if err := k.RecordUploads.Set(ctx, uploadID, upload); err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUploadChunk,
+			sdk.NewAttribute(types.AttributeKeyUploadID, fmt.Sprintf("%d", uploadID)),
+			sdk.NewAttribute(types.AttributeKeyChunkIndex, fmt.Sprintf("%d", index)),
+			sdk.NewAttribute("received_size", fmt.Sprintf("%d", upload.ReceivedSize)),
+		),
+	)
+
+	return upload, nil
+}
+
+// FinalizeRecord assembles the chunks of a complete upload and submits them as
+// a record. The assembled data is checked against the declared merkle root and
+// goes through the same checks as a single transaction submission. The store
+// gas spent per byte of data was charged as the chunks were uploaded, so only
+// the rest is charged here and the size of the record does not bound the gas
+// of the transaction.
+func (k Keeper) FinalizeRecord(ctx context.Context, validatorAddr string, uploadID uint64) (string, error) {
+	upload, err := k.getOpenUpload(ctx, validatorAddr, uploadID)
+	if err != nil {
+		return "", err
+	}
+
+	if upload.ReceivedSize != upload.TotalSize {
+		return "", types.ErrUploadIncomplete.Wrapf(
+			"upload %d received %d of %d bytes",
+			uploadID,
+			upload.ReceivedSize,
+			upload.TotalSize,
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	finalizeCtx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var data bytes.Buffer
+	data.Grow(int(upload.TotalSize))
+	rng := collections.NewPrefixedPairRange[uint64, uint64](uploadID)
+	err = k.UploadChunks.Walk(finalizeCtx, rng, func(_ collections.Pair[uint64, uint64], chunk []byte) (bool, error) {
+		data.Write(chunk)
+		return false, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.deleteUpload(finalizeCtx, upload); err != nil {
+		return "", err
+	}
+
+	recordID, err := k.CreateEncodedRecord(
+		finalizeCtx,
+		upload.ValidatorAddress,
+		upload.RecordType,
+		upload.ContentEncoding,
+		data.Bytes(),
+		upload.MerkleRoot,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	used := finalizeCtx.GasMeter().GasConsumed()
+	if prepaid := finalizeGas(sdkCtx.KVGasConfig(), upload.TotalSize); used > prepaid {
+		sdkCtx.GasMeter().ConsumeGas(used-prepaid, "finalize record upload")
+	}
+
+	return recordID, nil
+}
+
+// GetRecordUpload returns an open record upload by id
+func (k Keeper) GetRecordUpload(ctx context.Context, uploadID uint64) (types.RecordUpload, error) {
+	upload, err := k.RecordUploads.Get(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecordUpload{}, types.ErrUploadNotFound.Wrapf("upload %d not found", uploadID)
+		}
+		return types.RecordUpload{}, err
+	}
+
+	return upload, nil
+}
+
+// ProcessUploadQueue discards the uploads whose deadline has been reached
+// without being finalized, together with their chunks.
+func (k Keeper) ProcessUploadQueue(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	// Collect due entries first so the queue is not written while it is being iterated
+	var due []collections.Pair[uint64, uint64]
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(height+1, uint64(0)))
+	err := k.UploadQueue.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		upload, err := k.GetRecordUpload(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		if err := k.deleteUpload(ctx, upload); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUploadExpired,
+				sdk.NewAttribute(types.AttributeKeyUploadID, fmt.Sprintf("%d", upload.Id)),
+				sdk.NewAttribute(types.AttributeKeyValidator, upload.ValidatorAddress),
+				sdk.NewAttribute("received_size", fmt.Sprintf("%d", upload.ReceivedSize)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// countOpenUploads returns the number of open uploads of a validator, counting
+// no further than limit
+func (k Keeper) countOpenUploads(ctx context.Context, validatorAddr string, limit uint64) (uint64, error) {
+	iter, err := k.RecordUploads.Indexes.ByValidator.MatchExact(ctx, validatorAddr)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid() && count < limit; iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// finalizeGas returns the store gas finalizing an upload spends per size bytes
+// of data: the chunks are read back once to assemble the record and once to
+// delete them, and the record is written
+func finalizeGas(config storetypes.GasConfig, size uint64) uint64 {
+	return size * (config.WriteCostPerByte + 2*config.ReadCostPerByte)
+}
+
+// getOpenUpload returns an upload that validatorAddr can still add to
+func (k Keeper) getOpenUpload(ctx context.Context, validatorAddr string, uploadID uint64) (types.RecordUpload, error) {
+	upload, err := k.GetRecordUpload(ctx, uploadID)
+	if err != nil {
+		return types.RecordUpload{}, err
+	}
+
+	if validatorAddr != upload.ValidatorAddress {
+		return types.RecordUpload{}, types.ErrNotValidator.Wrapf("only %s can add to upload %d", upload.ValidatorAddress, uploadID)
+	}
+
+	// Uploads are discarded at the end of their deadline block
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if height >= upload.DeadlineHeight {
+		return types.RecordUpload{}, types.ErrUploadNotFound.Wrapf("upload %d expired at height %d", uploadID, upload.DeadlineHeight)
+	}
+
+	return upload, nil
+}
+
+// deleteUpload removes an upload, its chunks and its queue entry
+func (k Keeper) deleteUpload(ctx context.Context, upload types.RecordUpload) error {
+	if err := k.UploadChunks.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](upload.Id)); err != nil {
+		return err
+	}
+
+	if err := k.UploadQueue.Remove(ctx, collections.Join(upload.DeadlineHeight, upload.Id)); err != nil {
+		return err
+	}
+
+	return k.RecordUploads.Remove(ctx, upload.Id)
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestChunkedRecordUpload(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	submitter := f.addBondedValidator(t)
+	other := f.addBondedValidator(t)
+
+	data := bytes.Repeat([]byte("0123456789"), 300)
+	root := types.RecordMerkleRoot(data)
+
+	begin, err := ms.BeginRecordUpload(f.withBlock(1), &types.MsgBeginRecordUpload{
+		ValidatorAddress: submitter,
+		MerkleRoot:       root,
+		TotalSize:        uint64(len(data)),
+	})
+	require.NoError(t, err)
+	uploadID := begin.UploadId
+
+	upload := func(height int64, signer string, index uint64, chunk []byte) error {
+		_, err := ms.UploadRecordChunk(f.withBlock(height), &types.MsgUploadRecordChunk{
+			ValidatorAddress: signer,
+			UploadId:         uploadID,
+			Index:            index,
+			Data:             chunk,
+		})
+		return err
+	}
+
+	require.ErrorIs(t, upload(2, other, 0, data[:1000]), types.ErrNotValidator)
+	require.ErrorIs(t, upload(2, submitter, 1, data[:1000]), types.ErrInvalidUploadChunk)
+	require.NoError(t, upload(2, submitter, 0, data[:1000]))
+	require.NoError(t, upload(3, submitter, 1, data[1000:2000]))
+
+	_, err = ms.FinalizeRecord(f.withBlock(4), &types.MsgFinalizeRecord{ValidatorAddress: submitter, UploadId: uploadID})
+	require.ErrorIs(t, err, types.ErrUploadIncomplete)
+
+	// chunks cannot exceed the declared size
+	require.ErrorIs(t, upload(4, submitter, 2, append(data[2000:], 'x')), types.ErrInvalidUploadChunk)
+	require.NoError(t, upload(4, submitter, 2, data[2000:]))
+
+	res, err := ms.FinalizeRecord(f.withBlock(5), &types.MsgFinalizeRecord{ValidatorAddress: submitter, UploadId: uploadID})
+	require.NoError(t, err)
+
+	record, err := f.keeper.GetRecord(f.ctx, res.RecordId)
+	require.NoError(t, err)
+	require.Equal(t, data, record.Data)
+	require.Equal(t, root, record.MerkleRoot)
+	require.Equal(t, uint64(5), record.BlockHeight)
+
+	// the upload and its chunks are gone
+	_, err = f.keeper.GetRecordUpload(f.ctx, uploadID)
+	require.ErrorIs(t, err, types.ErrUploadNotFound)
+	has, err := f.keeper.UploadChunks.Has(f.ctx, collections.Join(uploadID, uint64(0)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestFinalizeRecordMerkleMismatch(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+
+	data := recordData(1)
+	upload, err := f.keeper.BeginRecordUpload(f.withBlock(1), submitter, types.RecordMerkleRoot(recordData(2)), uint64(len(data)), "", types.ContentEncodingIdentity)
+	require.NoError(t, err)
+
+	_, err = f.keeper.UploadRecordChunk(f.withBlock(2), submitter, upload.Id, 0, data)
+	require.NoError(t, err)
+
+	_, err = f.keeper.FinalizeRecord(f.withBlock(3), submitter, upload.Id)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleRoot)
+}
+
+func TestProcessUploadQueue(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.UploadTimeoutBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	data := recordData(1)
+	abandoned, err := f.keeper.BeginRecordUpload(f.withBlock(1), submitter, types.RecordMerkleRoot(data), uint64(len(data)), "", types.ContentEncodingIdentity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), abandoned.DeadlineHeight)
+	_, err = f.keeper.UploadRecordChunk(f.withBlock(2), submitter, abandoned.Id, 0, data[:64])
+	require.NoError(t, err)
+
+	open, err := f.keeper.BeginRecordUpload(f.withBlock(5), submitter, types.RecordMerkleRoot(data), uint64(len(data)), "", types.ContentEncodingIdentity)
+	require.NoError(t, err)
+
+	// the upload can't be added to at its deadline
+	_, err = f.keeper.UploadRecordChunk(f.withBlock(11), submitter, abandoned.Id, 1, data[64:])
+	require.ErrorIs(t, err, types.ErrUploadNotFound)
+
+	require.NoError(t, f.keeper.ProcessUploadQueue(f.withBlock(10)))
+	_, err = f.keeper.GetRecordUpload(f.ctx, abandoned.Id)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ProcessUploadQueue(f.withBlock(11)))
+	_, err = f.keeper.GetRecordUpload(f.ctx, abandoned.Id)
+	require.ErrorIs(t, err, types.ErrUploadNotFound)
+	has, err := f.keeper.UploadChunks.Has(f.ctx, collections.Join(abandoned.Id, uint64(0)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = f.keeper.GetRecordUpload(f.ctx, open.Id)
+	require.NoError(t, err)
+}
+
+func TestFinalizeRecordGas(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+
+	// writing the record in one transaction costs 30 gas per byte, 6M gas
+	data := bytes.Repeat([]byte("0123456789"), 20_000)
+	upload, err := f.keeper.BeginRecordUpload(f.withBlock(1), submitter, types.RecordMerkleRoot(data), uint64(len(data)), "", types.ContentEncodingIdentity)
+	require.NoError(t, err)
+
+	withGas := func(height int64, limit uint64) sdk.Context {
+		return sdk.UnwrapSDKContext(f.withBlock(height)).WithGasMeter(storetypes.NewGasMeter(limit))
+	}
+
+	// the per-byte gas is charged with the chunks
+	const chunkSize = 50_000
+	writeCost := storetypes.KVGasConfig().WriteCostPerByte
+	for i := 0; i < len(data)/chunkSize; i++ {
+		ctx := withGas(2, 10_000_000)
+		_, err := f.keeper.UploadRecordChunk(ctx, submitter, upload.Id, uint64(i), data[i*chunkSize:(i+1)*chunkSize])
+		require.NoError(t, err)
+		require.Greater(t, ctx.GasMeter().GasConsumed(), 2*chunkSize*writeCost)
+	}
+
+	ctx := withGas(3, 1_000_000)
+	recordID, err := f.keeper.FinalizeRecord(ctx, submitter, upload.Id)
+	require.NoError(t, err)
+	require.Less(t, ctx.GasMeter().GasConsumed(), uint64(len(data))*writeCost)
+
+	record, err := f.keeper.GetRecord(f.ctx, recordID)
+	require.NoError(t, err)
+	require.Equal(t, data, record.Data)
+}
+
+func TestMaxOpenUploadsPerValidator(t *testing.T) {
+	f := initFixture(t)
+	submitter := f.addBondedValidator(t)
+	other := f.addBondedValidator(t)
+
+	params := types.DefaultParams()
+	params.MaxOpenUploadsPerValidator = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	data := recordData(1)
+	begin := func(validator string) (types.RecordUpload, error) {
+		return f.keeper.BeginRecordUpload(f.withBlock(1), validator, types.RecordMerkleRoot(data), uint64(len(data)), "", types.ContentEncodingIdentity)
+	}
+
+	first, err := begin(submitter)
+	require.NoError(t, err)
+	_, err = begin(submitter)
+	require.NoError(t, err)
+	_, err = begin(submitter)
+	require.ErrorIs(t, err, types.ErrTooManyUploads)
+
+	// the limit is per validator
+	_, err = begin(other)
+	require.NoError(t, err)
+
+	// a finalized upload no longer counts
+	_, err = f.keeper.UploadRecordChunk(f.withBlock(2), submitter, first.Id, 0, data)
+	require.NoError(t, err)
+	_, err = f.keeper.FinalizeRecord(f.withBlock(3), submitter, first.Id)
+	require.NoError(t, err)
+	_, err = begin(submitter)
+	require.NoError(t, err)
+}
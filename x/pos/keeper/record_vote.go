@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// VoteOnRecord stores a verifier's stake-weighted vote on a pending record and
+// finalizes the record once the verification quorum has been reached.
+// It returns the record status after the vote was tallied.
+func (k Keeper) VoteOnRecord(
+	ctx context.Context,
+	recordID string,
+	verifier stakingtypes.Validator,
+	approved bool,
+) (types.RecordStatus, error) {
+	record, err := k.GetRecord(ctx, recordID)
+	if err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	if record.Status != types.RecordStatusPending {
+		return record.Status, types.ErrRecordAlreadyVerified.Wrapf(
+			"record %s is already %s",
+			recordID,
+			record.Status.String(),
+		)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	if params.CommitRevealEnabled {
+		return types.RecordStatusUnspecified, types.ErrCommitRevealRequired.Wrap(
+			"use MsgCommitVerification and MsgRevealVerification",
+		)
+	}
+
+	if err := k.checkVerifierConflicts(ctx, record, verifier.GetOperator()); err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	if err := k.castVote(ctx, recordID, verifier.GetOperator(), verifier.GetBondedTokens(), approved); err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	return k.tallyRecord(ctx, record)
+}
+
+// castVote stores a verifier's vote on a record without tallying it
+func (k Keeper) castVote(ctx context.Context, recordID, verifier string, power math.Int, approved bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vote := types.RecordVote{
+		RecordId:    recordID,
+		Verifier:    verifier,
+		Approved:    approved,
+		Power:       power,
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+
+	if err := k.RecordVotes.Set(ctx, collections.Join(recordID, verifier), vote); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecordVote,
+			sdk.NewAttribute(types.AttributeKeyRecordID, recordID),
+			sdk.NewAttribute(types.AttributeKeyVerifier, verifier),
+			sdk.NewAttribute(types.AttributeKeyApproved, fmt.Sprintf("%t", approved)),
+			sdk.NewAttribute(types.AttributeKeyPower, power.String()),
+		),
+	)
+
+	return nil
+}
+
+// checkVerifierConflicts enforces the conflict-of-interest rules for a vote.
+// A validator cannot verify its own record or vote twice on the same record and,
+// when ExcludeRelatedVerifiers is set, cannot verify the records of a validator
+// it shares an operator or withdraw address with.
+func (k Keeper) checkVerifierConflicts(ctx context.Context, record types.Record, verifier string) error {
+	if verifier == record.ValidatorAddress {
+		return types.ErrSelfVerification.Wrapf("validator %s submitted record %s", verifier, record.Id)
+	}
+
+	if has, err := k.RecordVotes.Has(ctx, collections.Join(record.Id, verifier)); err != nil {
+		return err
+	} else if has {
+		return types.ErrDuplicateVote.Wrapf("%s has already voted on record %s", verifier, record.Id)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.ExcludeRelatedVerifiers {
+		return nil
+	}
+
+	submitterAddrs, err := k.validatorAccountAddresses(ctx, record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	verifierAddrs, err := k.validatorAccountAddresses(ctx, verifier)
+	if err != nil {
+		return err
+	}
+
+	for _, submitterAddr := range submitterAddrs {
+		for _, verifierAddr := range verifierAddrs {
+			if submitterAddr.Equals(verifierAddr) {
+				return types.ErrConflictOfInterest.Wrapf(
+					"verifier %s and submitter %s share address %s",
+					verifier,
+					record.ValidatorAddress,
+					submitterAddr,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatorAccountAddresses returns the account addresses a validator controls:
+// its operator account and, if different, its distribution withdraw address
+func (k Keeper) validatorAccountAddresses(ctx context.Context, validatorAddr string) ([]sdk.AccAddress, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := sdk.AccAddress(valAddr)
+	withdraw, err := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if withdraw.Equals(operator) {
+		return []sdk.AccAddress{operator}, nil
+	}
+	return []sdk.AccAddress{operator, withdraw}, nil
+}
+
+// GetRecordVote returns a verifier's vote on a record
+func (k Keeper) GetRecordVote(ctx context.Context, recordID, verifier string) (types.RecordVote, error) {
+	vote, err := k.RecordVotes.Get(ctx, collections.Join(recordID, verifier))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecordVote{}, types.ErrRecordVoteNotFound.Wrapf(
+				"no vote from %s on record %s",
+				verifier,
+				recordID,
+			)
+		}
+		return types.RecordVote{}, err
+	}
+	return vote, nil
+}
+
+// TallyRecordVotes returns the approving and rejecting stake voted on a record
+func (k Keeper) TallyRecordVotes(ctx context.Context, recordID string) (approve, reject math.Int, err error) {
+	approve, reject = math.ZeroInt(), math.ZeroInt()
+
+	rng := collections.NewPrefixedPairRange[string, string](recordID)
+	err = k.RecordVotes.Walk(ctx, rng, func(_ collections.Pair[string, string], vote types.RecordVote) (bool, error) {
+		if vote.Approved {
+			approve = approve.Add(vote.Power)
+		} else {
+			reject = reject.Add(vote.Power)
+		}
+		return false, nil
+	})
+	return approve, reject, err
+}
+
+// recordVotingStake returns the bonded stake that can vote on a record: the
+// total bonded tokens less those of the submitter, who cannot verify its own
+// record
+func (k Keeper) recordVotingStake(ctx context.Context, record types.Record) (math.Int, error) {
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return math.Int{}, err
+	}
+	submitter, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return totalBonded, nil
+		}
+		return math.Int{}, err
+	}
+
+	return totalBonded.Sub(submitter.GetBondedTokens()), nil
+}
+
+// tallyRecord finalizes a pending record once the stake that voted on it
+// reaches the verification quorum. The record is verified when the approving
+// stake meets the verification threshold and rejected otherwise, in which case
+// the submitter is penalized as InvalidRecordPenalty sets.
+func (k Keeper) tallyRecord(ctx context.Context, record types.Record) (types.RecordStatus, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	approve, reject, err := k.TallyRecordVotes(ctx, record.Id)
+	if err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	voted := approve.Add(reject)
+	if !voted.IsPositive() {
+		return record.Status, nil
+	}
+
+	votingStake, err := k.recordVotingStake(ctx, record)
+	if err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	// Wait for more votes until quorum is reached
+	if math.LegacyNewDecFromInt(voted).LT(params.VerificationQuorum.MulInt(votingStake)) {
+		return record.Status, nil
+	}
+
+	approved := math.LegacyNewDecFromInt(approve).GTE(params.VerificationThreshold.MulInt(voted))
+	if err := k.VerifyRecord(ctx, record.Id, approved); err != nil {
+		return types.RecordStatusUnspecified, err
+	}
+
+	if approved {
+		return types.RecordStatusVerified, nil
+	}
+
+	// The bond of the record was forfeited as it was rejected; slash the
+	// stake only if the invalid record penalty asks for it
+	if !params.SlashesInvalidRecords() {
+		return types.RecordStatusRejected, nil
+	}
+
+	// Slash for invalid record
+	if err := k.SlashValidatorForInvalidRecord(ctx, record.ValidatorAddress, record.Id); err != nil {
+		// Log error but don't fail the transaction
+		sdk.UnwrapSDKContext(ctx).Logger().Error(
+			"failed to slash validator for invalid record",
+			"validator", record.ValidatorAddress,
+			"record_id", record.Id,
+			"error", err,
+		)
+	}
+
+	return types.RecordStatusRejected, nil
+}
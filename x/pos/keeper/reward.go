@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// RewardPayoutIndexes defines the secondary indexes kept alongside
+// Keeper.RewardPayouts
+type RewardPayoutIndexes struct {
+	// ByValidator maps a validator address to the epochs it was paid for
+	ByValidator *indexes.ReversePair[uint64, string, types.RewardPayout]
+}
+
+// IndexesList implements collections.Indexes
+func (i RewardPayoutIndexes) IndexesList() []collections.Index[collections.Pair[uint64, string], types.RewardPayout] {
+	return []collections.Index[collections.Pair[uint64, string], types.RewardPayout]{
+		i.ByValidator,
+	}
+}
+
+func newRewardPayoutIndexes(sb *collections.SchemaBuilder) RewardPayoutIndexes {
+	return RewardPayoutIndexes{
+		ByValidator: indexes.NewReversePair[types.RewardPayout](
+			sb,
+			types.RewardPayoutsByValidatorKey,
+			"reward_payouts_by_validator",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+	}
+}
+
+// GetRewardPool returns the reward pool, empty until it is first funded
+func (k Keeper) GetRewardPool(ctx context.Context) (types.RewardPool, error) {
+	pool, err := k.RewardPool.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RewardPool{Balance: sdk.NewCoins()}, nil
+	}
+	return pool, err
+}
+
+// FundRewardPool moves coins from depositor into the reward pool
+func (k Keeper) FundRewardPool(ctx context.Context, depositor string, amount sdk.Coins) error {
+	depositorAddr, err := k.addressCodec.StringToBytes(depositor)
+	if err != nil {
+		return fmt.Errorf("invalid depositor address: %w", err)
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return types.ErrInvalidRewardDeposit.Wrapf("invalid amount %s", amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return k.addToRewardPool(ctx, depositor, amount)
+}
+
+// CollectRewardPoolFees moves the RewardPoolFeeShare of the fee collector
+// balance to the reward pool. It runs at the end of the block, after
+// x/distribution allocated the fee collector balance in BeginBlock, so the
+// share is taken from the fees of the block's transactions.
+func (k Keeper) CollectRewardPoolFees(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.RewardPoolFeeShare.IsPositive() {
+		return nil
+	}
+
+	fees := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	share, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(params.RewardPoolFeeShare).TruncateDecimal()
+	if share.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share); err != nil {
+		return err
+	}
+
+	return k.addToRewardPool(ctx, authtypes.FeeCollectorName, share)
+}
+
+// PendingRewardPayouts returns what the reward pool would pay out if the
+// current epoch ended now, assuming every validator with verified records in
+// it stays eligible
+func (k Keeper) PendingRewardPayouts(ctx context.Context) (types.EpochInfo, []types.RewardPayout, error) {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return types.EpochInfo{}, nil, err
+	}
+
+	payouts, err := k.computeRewardPayouts(ctx, epoch.Number, func(types.ValidatorEpochSummary) bool { return true })
+	if err != nil {
+		return types.EpochInfo{}, nil, err
+	}
+
+	return epoch, payouts, nil
+}
+
+// distributeRewards pays the reward pool out to the validators found eligible
+// in the ending epoch, in proportion to the records they got verified in it.
+// Amounts are rounded down and the remainder stays in the pool, as does the
+// whole pool when no eligible validator got records verified.
+func (k Keeper) distributeRewards(ctx context.Context, epoch types.EpochInfo) (sdk.Coins, error) {
+	payouts, err := k.computeRewardPayouts(ctx, epoch.Number, func(validator types.ValidatorEpochSummary) bool {
+		return validator.Eligibility == types.EligibilityEligible
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	paid := sdk.NewCoins()
+	for _, payout := range payouts {
+		if payout.Amount.IsZero() {
+			continue
+		}
+
+		recipient, err := k.validatorPayoutAddress(ctx, payout.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, payout.Amount); err != nil {
+			return nil, err
+		}
+
+		payout.Recipient = recipient.String()
+		if err := k.RewardPayouts.Set(ctx, collections.Join(epoch.Number, payout.ValidatorAddress), payout); err != nil {
+			return nil, err
+		}
+		paid = paid.Add(payout.Amount...)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardPaid,
+				sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", epoch.Number)),
+				sdk.NewAttribute(types.AttributeKeyValidator, payout.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyRecipient, payout.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, payout.Amount.String()),
+				sdk.NewAttribute("verified_records", fmt.Sprintf("%d", payout.VerifiedRecords)),
+			),
+		)
+	}
+
+	if paid.IsZero() {
+		return paid, nil
+	}
+
+	pool, err := k.GetRewardPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pool.Balance = pool.Balance.Sub(paid...)
+	if err := k.RewardPool.Set(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+// validatorPayoutAddress returns the address payments to a validator are sent
+// to: the distribution withdraw address of its operator
+func (k Keeper) validatorPayoutAddress(ctx context.Context, validatorAddr string) (sdk.AccAddress, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+}
+
+// computeRewardPayouts splits the reward pool between the validators of an
+// epoch accepted by include, in proportion to their verified records
+func (k Keeper) computeRewardPayouts(
+	ctx context.Context,
+	epoch uint64,
+	include func(validator types.ValidatorEpochSummary) bool,
+) ([]types.RewardPayout, error) {
+	pool, err := k.GetRewardPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		payouts []types.RewardPayout
+		total   uint64
+	)
+	rng := collections.NewPrefixedPairRange[uint64, string](epoch)
+	err = k.EpochActivity.Walk(ctx, rng, func(_ collections.Pair[uint64, string], validator types.ValidatorEpochSummary) (bool, error) {
+		if validator.VerifiedRecords == 0 || !include(validator) {
+			return false, nil
+		}
+		payouts = append(payouts, types.RewardPayout{
+			Epoch:            epoch,
+			ValidatorAddress: validator.ValidatorAddress,
+			VerifiedRecords:  validator.VerifiedRecords,
+		})
+		total += validator.VerifiedRecords
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range payouts {
+		amount := sdk.NewCoins()
+		for _, coin := range pool.Balance {
+			share := coin.Amount.Mul(math.NewIntFromUint64(payouts[i].VerifiedRecords)).Quo(math.NewIntFromUint64(total))
+			amount = amount.Add(sdk.NewCoin(coin.Denom, share))
+		}
+		payouts[i].Amount = amount
+	}
+
+	return payouts, nil
+}
+
+// addToRewardPool adds coins already moved to the module account to the reward pool
+func (k Keeper) addToRewardPool(ctx context.Context, source string, amount sdk.Coins) error {
+	pool, err := k.GetRewardPool(ctx)
+	if err != nil {
+		return err
+	}
+
+	pool.Balance = pool.Balance.Add(amount...)
+	if err := k.RewardPool.Set(ctx, pool); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardPoolFunded,
+			sdk.NewAttribute("source", source),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
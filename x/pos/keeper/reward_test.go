@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// verifiedRecords submits and verifies n records of a validator
+func (f *fixture) verifiedRecords(t *testing.T, ctx context.Context, validator string, seed byte, n int) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		data := recordData(seed + byte(i))
+		id, err := f.keeper.CreateRecord(ctx, validator, "", data, types.RecordMerkleRoot(data))
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.VerifyRecord(ctx, id, true))
+	}
+}
+
+func TestFundRewardPool(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	depositor := sdk.AccAddress("depositor___________")
+	f.bankKeeper.balances[depositor.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	_, err := ms.FundRewardPool(f.ctx, &types.MsgFundRewardPool{Depositor: depositor.String()})
+	require.ErrorIs(t, err, types.ErrInvalidRewardDeposit)
+
+	_, err = ms.FundRewardPool(f.ctx, &types.MsgFundRewardPool{
+		Depositor: depositor.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)),
+	})
+	require.Error(t, err)
+
+	_, err = ms.FundRewardPool(f.ctx, &types.MsgFundRewardPool{
+		Depositor: depositor.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 600)),
+	})
+	require.NoError(t, err)
+
+	pool, err := f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), pool.Balance)
+	require.Equal(t, pool.Balance, f.bankKeeper.balances[authtypes.NewModuleAddress(types.ModuleName).String()])
+}
+
+func TestCollectRewardPoolFees(t *testing.T) {
+	f := initFixture(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	f.bankKeeper.balances[feeCollector] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1005))
+
+	// no share is taken by default
+	require.NoError(t, f.keeper.CollectRewardPoolFees(f.ctx))
+	pool, err := f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.True(t, pool.Balance.IsZero())
+
+	params := types.DefaultParams()
+	params.RewardPoolFeeShare = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, f.keeper.CollectRewardPoolFees(f.ctx))
+	pool, err = f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), pool.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 905)), f.bankKeeper.balances[feeCollector])
+}
+
+func TestDistributeRewards(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	busy := f.addBondedValidator(t)
+	steady := f.addBondedValidator(t)
+	ineligible := f.addBondedValidator(t)
+
+	// the rewards of steady go to its withdraw address
+	withdraw := sdk.AccAddress("withdraw____________")
+	f.distributionKeeper.withdrawAddrs[sdk.AccAddress(mustValAddr(t, steady)).String()] = withdraw
+
+	depositor := sdk.AccAddress("depositor___________")
+	f.bankKeeper.balances[depositor.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, f.keeper.FundRewardPool(f.ctx, depositor.String(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	ctx := f.beginBlock(t, 1)
+	for _, val := range []string{busy, steady, ineligible} {
+		require.NoError(t, f.keeper.InitializeValidatorStats(ctx, val))
+	}
+
+	ctx = f.beginBlock(t, 2)
+	f.verifiedRecords(t, ctx, busy, 10, 2)
+	f.verifiedRecords(t, ctx, steady, 20, 1)
+	f.verifiedRecords(t, ctx, ineligible, 30, 1)
+	require.NoError(t, f.keeper.UpdateValidatorEligibility(ctx, ineligible, false))
+
+	pending, err := qs.RewardPool(ctx, &types.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), pending.Epoch)
+	// pending payouts do not know yet who stays eligible
+	pendingAmounts := make(map[string]sdk.Coins)
+	for _, payout := range pending.Pending {
+		pendingAmounts[payout.ValidatorAddress] = payout.Amount
+	}
+	require.Equal(t, map[string]sdk.Coins{
+		busy:       sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		steady:     sdk.NewCoins(sdk.NewInt64Coin("stake", 250)),
+		ineligible: sdk.NewCoins(sdk.NewInt64Coin("stake", 250)),
+	}, pendingAmounts)
+
+	f.beginBlock(t, 10)
+
+	// the ineligible validator is skipped, 1000 is split 2:1 and the remainder stays in the pool
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 666)), f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, busy)).String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 333)), f.bankKeeper.balances[withdraw.String()])
+	require.True(t, f.bankKeeper.balances[sdk.AccAddress(mustValAddr(t, ineligible)).String()].IsZero())
+
+	pool, err := f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), pool.Balance)
+
+	summary, err := f.keeper.GetEpochSummary(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 999)), summary.RewardsPaid)
+
+	payouts, err := qs.RewardPayouts(f.ctx, &types.QueryRewardPayoutsRequest{ValidatorAddress: steady})
+	require.NoError(t, err)
+	require.Len(t, payouts.Payouts, 1)
+	require.Equal(t, types.RewardPayout{
+		Epoch:            0,
+		ValidatorAddress: steady,
+		VerifiedRecords:  1,
+		Amount:           sdk.NewCoins(sdk.NewInt64Coin("stake", 333)),
+		Recipient:        withdraw.String(),
+	}, payouts.Payouts[0])
+
+	payouts, err = qs.RewardPayouts(f.ctx, &types.QueryRewardPayoutsRequest{ValidatorAddress: ineligible})
+	require.NoError(t, err)
+	require.Empty(t, payouts.Payouts)
+
+	// without verified records the pool carries over to the next epoch
+	f.beginBlock(t, 20)
+	pool, err = f.keeper.GetRewardPool(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), pool.Balance)
+}
+
+func mustValAddr(t *testing.T, validator string) sdk.ValAddress {
+	t.Helper()
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(t, err)
+	return valAddr
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// SlashEventIndexes defines the secondary indexes kept alongside
+// Keeper.SlashEvents
+type SlashEventIndexes struct {
+	// ByHeight maps a block height to the slashes applied at it
+	ByHeight *indexes.Multi[uint64, collections.Pair[string, uint64], types.SlashEvent]
+}
+
+// IndexesList implements collections.Indexes
+func (i SlashEventIndexes) IndexesList() []collections.Index[collections.Pair[string, uint64], types.SlashEvent] {
+	return []collections.Index[collections.Pair[string, uint64], types.SlashEvent]{
+		i.ByHeight,
+	}
+}
+
+func newSlashEventIndexes(sb *collections.SchemaBuilder) SlashEventIndexes {
+	return SlashEventIndexes{
+		ByHeight: indexes.NewMulti(
+			sb,
+			types.SlashEventsByHeightKey,
+			"slash_events_by_height",
+			collections.Uint64Key,
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			func(_ collections.Pair[string, uint64], event types.SlashEvent) (uint64, error) {
+				return event.BlockHeight, nil
+			},
+		),
+	}
+}
+
+// recordSlashEvent adds a slash to the slash history of a validator
+func (k Keeper) recordSlashEvent(ctx context.Context, validatorAddr string, slash types.EpochSlash) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := k.SlashEventSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	event := types.SlashEvent{
+		Id:               id,
+		ValidatorAddress: validatorAddr,
+		Reason:           slash.Reason,
+		Fraction:         slash.Fraction,
+		Amount:           slash.Amount,
+		RecordId:         slash.RecordId,
+		Epoch:            epoch.Number,
+		BlockHeight:      slash.BlockHeight,
+		BlockTime:        sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+	}
+	return k.SlashEvents.Set(ctx, collections.Join(validatorAddr, id), event)
+}
+
+// GetSlashEventsInHeightRange returns the slashes applied between minHeight
+// and maxHeight, both inclusive, by height
+func (k Keeper) GetSlashEventsInHeightRange(ctx context.Context, minHeight, maxHeight uint64) ([]types.SlashEvent, error) {
+	var keys []collections.Pair[string, uint64]
+	rng := new(collections.Range[collections.Pair[uint64, collections.Pair[string, uint64]]]).
+		StartInclusive(collections.Join(minHeight, collections.Join("", uint64(0))))
+	err := k.SlashEvents.Indexes.ByHeight.Walk(ctx, rng, func(height uint64, key collections.Pair[string, uint64]) (bool, error) {
+		if height > maxHeight {
+			return true, nil
+		}
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]types.SlashEvent, 0, len(keys))
+	for _, key := range keys {
+		event, err := k.SlashEvents.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
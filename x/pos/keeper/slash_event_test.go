@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestSlashHistory(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// every missed epoch is slashed
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.MinVerifiedRecordsForEligibility = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	first := f.addBondedValidator(t)
+	second := f.addBondedValidator(t)
+	ctx := f.beginBlock(t, 1)
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, first))
+	require.NoError(t, f.keeper.InitializeValidatorStats(ctx, second))
+
+	// neither validator submits in epochs 1 and 2
+	for height := int64(10); height <= 30; height += 10 {
+		f.beginBlock(t, height)
+	}
+	require.Len(t, f.stakingKeeper.slashes, 4)
+
+	res, err := qs.ValidatorSlashes(f.ctx, &types.QueryValidatorSlashesRequest{ValidatorAddress: first})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 2)
+	for i, slash := range res.Slashes {
+		require.Equal(t, first, slash.ValidatorAddress)
+		require.Equal(t, "missing_records", slash.Reason)
+		require.Equal(t, params.SlashFractionMissingRecord, slash.Fraction)
+		require.True(t, slash.Amount.IsPositive())
+		require.Equal(t, uint64(i+1), slash.Epoch)
+		require.Equal(t, uint64(20+10*i), slash.BlockHeight)
+	}
+
+	res, err = qs.ValidatorSlashes(f.ctx, &types.QueryValidatorSlashesRequest{
+		ValidatorAddress: second,
+		Pagination:       &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	require.Equal(t, uint64(1), res.Slashes[0].Epoch)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	tests := []struct {
+		name      string
+		minHeight uint64
+		maxHeight uint64
+		expLen    int
+		expCode   codes.Code
+	}{
+		{name: "all", minHeight: 0, maxHeight: 100, expLen: 4},
+		{name: "single height", minHeight: 30, maxHeight: 30, expLen: 2},
+		{name: "no slashes", minHeight: 21, maxHeight: 29},
+		{name: "inverted range", minHeight: 30, maxHeight: 20, expCode: codes.InvalidArgument},
+		{name: "range too wide", minHeight: 0, maxHeight: types.MaxSlashHeightRange, expCode: codes.InvalidArgument},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := qs.Slashes(f.ctx, &types.QuerySlashesRequest{MinHeight: tc.minHeight, MaxHeight: tc.maxHeight})
+			if tc.expCode != codes.OK {
+				require.Equal(t, tc.expCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.Slashes, tc.expLen)
+			height := tc.minHeight
+			for _, slash := range res.Slashes {
+				require.GreaterOrEqual(t, slash.BlockHeight, height)
+				require.LessOrEqual(t, slash.BlockHeight, tc.maxHeight)
+				height = slash.BlockHeight
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NeomSense/PoS/x/pos/types"
@@ -12,7 +15,7 @@ import (
 func (k Keeper) GetValidatorStats(ctx context.Context, validatorAddr string) (types.ValidatorRecordStats, error) {
 	stats, err := k.ValidatorStats.Get(ctx, validatorAddr)
 	if err != nil {
-		if err != nil && err.Error() == "collections: not found" {
+		if errors.Is(err, collections.ErrNotFound) {
 			return types.ValidatorRecordStats{}, types.ErrValidatorStatsNotFound.Wrapf(
 				"stats for validator %s not found",
 				validatorAddr,
@@ -47,22 +50,25 @@ func (k Keeper) InitializeValidatorStats(ctx context.Context, validatorAddr stri
 		return nil // Already initialized
 	}
 
-	params, err := k.Params.Get(ctx)
+	epoch, err := k.GetCurrentEpoch(ctx)
 	if err != nil {
 		return err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentTime := sdkCtx.BlockTime().Unix()
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
 
 	stats := types.ValidatorRecordStats{
 		ValidatorAddress:        validatorAddr,
-		TotalRecords:           0,
-		VerifiedRecords:        0,
-		RejectedRecords:        0,
-		LastRecordTime:         0,
-		IsEligible:             true, // Start as eligible
-		NextRequiredRecordTime: currentTime + int64(params.EpochLength),
+		TotalRecords:            0,
+		VerifiedRecords:         0,
+		RejectedRecords:         0,
+		LastRecordTime:          0,
+		IsEligible:              true, // Start as eligible
+		NextRequiredRecordEpoch: epoch.Number + 1,
+		GraceEndEpoch:           epoch.Number + params.OnboardingEpochs,
 	}
 
 	return k.SetValidatorStats(ctx, validatorAddr, stats)
@@ -84,26 +90,38 @@ func (k Keeper) CheckValidatorEligibility(ctx context.Context, validatorAddr str
 		return false, err
 	}
 
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	// Check if validator has minimum verified records
-	if stats.VerifiedRecords < params.MinVerifiedRecordsForEligibility {
+	verified := stats.VerifiedRecords
+	if params.VerifiedRecordsWindow > 0 {
+		verified, err = k.verifiedRecordsInWindow(ctx, validatorAddr, epoch.Number, params.VerifiedRecordsWindow)
+		if err != nil {
+			return false, err
+		}
+	}
+	if verified < params.MinVerifiedRecordsForEligibility {
 		return false, nil
 	}
 
-	// Check if validator submitted record in current epoch
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentTime := sdkCtx.BlockTime().Unix()
-	blockHeight := uint64(sdkCtx.BlockHeight())
-	currentEpoch := blockHeight / params.EpochLength
-
-	// If we're past the required time and no recent record, not eligible
-	if currentTime > stats.NextRequiredRecordTime {
-		lastRecordEpoch := uint64(stats.LastRecordTime) / params.EpochLength
-		if lastRecordEpoch < currentEpoch {
-			return false, nil
-		}
+	// Before records are required the eligibility status stands
+	if epoch.Number < stats.NextRequiredRecordEpoch {
+		return stats.IsEligible, nil
 	}
 
-	return stats.IsEligible, nil
+	// Otherwise the validator must have submitted records in enough epochs of
+	// the signing window
+	info, err := k.RecordSigningInfos.Get(ctx, validatorAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return stats.IsEligible, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return info.MissedEpochsCounter <= params.MaxMissedEpochs(), nil
 }
 
 // UpdateValidatorEligibility updates a validator's eligibility status
@@ -117,125 +135,148 @@ func (k Keeper) UpdateValidatorEligibility(ctx context.Context, validatorAddr st
 	return k.SetValidatorStats(ctx, validatorAddr, stats)
 }
 
-// SlashValidatorForMissingRecords slashes a validator for not submitting required records
-func (k Keeper) SlashValidatorForMissingRecords(ctx context.Context, validatorAddr string) error {
+// SlashValidatorForInvalidRecord slashes a validator for submitting invalid record
+func (k Keeper) SlashValidatorForInvalidRecord(ctx context.Context, validatorAddr string, recordID string) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Get validator
-	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
-	if err != nil {
+	if _, err := k.slashValidator(ctx, validatorAddr, params.SlashFractionInvalidRecord, "invalid_record", recordID); err != nil {
 		return err
 	}
 
-	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
-	if err != nil {
-		return err
-	}
+	// Emit event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"validator_slashed",
+			sdk.NewAttribute("validator", validatorAddr),
+			sdk.NewAttribute("reason", "invalid_record"),
+			sdk.NewAttribute("record_id", recordID),
+			sdk.NewAttribute("slash_fraction", params.SlashFractionInvalidRecord.String()),
+		),
+	)
 
-	// Get consensus address for slashing
-	consAddr, err := validator.GetConsAddr()
+	return nil
+}
+
+// SlashValidatorForUnrevealedCommit slashes a verifier that committed to a vote on a record
+// and never revealed it
+func (k Keeper) SlashValidatorForUnrevealedCommit(ctx context.Context, validatorAddr string, recordID string) error {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Slash the validator
-	power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
-	_, err = k.stakingKeeper.Slash(
-		ctx,
-		consAddr,
-		sdkCtx.BlockHeight(),
-		power,
-		params.SlashFractionMissingRecord,
-	)
-	if err != nil {
+	if _, err := k.slashValidator(ctx, validatorAddr, params.SlashFractionUnrevealedCommit, "unrevealed_commit", recordID); err != nil {
 		return err
 	}
 
 	// Emit event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"validator_slashed",
 			sdk.NewAttribute("validator", validatorAddr),
-			sdk.NewAttribute("reason", "missing_records"),
-			sdk.NewAttribute("slash_fraction", params.SlashFractionMissingRecord.String()),
+			sdk.NewAttribute("reason", "unrevealed_commit"),
+			sdk.NewAttribute("record_id", recordID),
+			sdk.NewAttribute("slash_fraction", params.SlashFractionUnrevealedCommit.String()),
 		),
 	)
 
 	return nil
 }
 
-// SlashValidatorForInvalidRecord slashes a validator for submitting invalid record
-func (k Keeper) SlashValidatorForInvalidRecord(ctx context.Context, validatorAddr string, recordID string) error {
+// SlashValidatorForUnavailableData slashes the submitter of an off-chain record that failed
+// to answer a data availability challenge
+func (k Keeper) SlashValidatorForUnavailableData(ctx context.Context, validatorAddr string, recordID string) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
+	if _, err := k.slashValidator(ctx, validatorAddr, params.SlashFractionUnavailableData, "unavailable_data", recordID); err != nil {
+		return err
+	}
+
+	// Emit event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"validator_slashed",
+			sdk.NewAttribute("validator", validatorAddr),
+			sdk.NewAttribute("reason", "unavailable_data"),
+			sdk.NewAttribute("record_id", recordID),
+			sdk.NewAttribute("slash_fraction", params.SlashFractionUnavailableData.String()),
+		),
+	)
+
+	return nil
+}
+
+// slashValidator slashes a fraction of a validator's stake at the current height,
+// records the slash in the validator's epoch summary and slash history and
+// returns the amount of tokens burned
+func (k Keeper) slashValidator(ctx context.Context, validatorAddr string, fraction math.LegacyDec, reason, recordID string) (math.Int, error) {
 	// Get validator
 	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
 	if err != nil {
-		return err
+		return math.ZeroInt(), err
 	}
 
 	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
 	if err != nil {
-		return err
+		return math.ZeroInt(), err
 	}
 
 	// Get consensus address for slashing
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
-		return err
+		return math.ZeroInt(), err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Slash the validator
 	power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
-	_, err = k.stakingKeeper.Slash(
+	amount, err := k.stakingKeeper.Slash(
 		ctx,
 		consAddr,
 		sdkCtx.BlockHeight(),
 		power,
-		params.SlashFractionInvalidRecord,
+		fraction,
 	)
 	if err != nil {
-		return err
+		return math.ZeroInt(), err
 	}
 
-	// Emit event
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"validator_slashed",
-			sdk.NewAttribute("validator", validatorAddr),
-			sdk.NewAttribute("reason", "invalid_record"),
-			sdk.NewAttribute("record_id", recordID),
-			sdk.NewAttribute("slash_fraction", params.SlashFractionInvalidRecord.String()),
-		),
-	)
+	slash := types.EpochSlash{
+		Reason:      reason,
+		RecordId:    recordID,
+		Fraction:    fraction,
+		Amount:      amount,
+		BlockHeight: uint64(sdkCtx.BlockHeight()),
+	}
+	err = k.updateEpochActivity(ctx, validatorAddr, func(summary *types.ValidatorEpochSummary) {
+		summary.Slashes = append(summary.Slashes, slash)
+	})
+	if err != nil {
+		return math.ZeroInt(), err
+	}
 
-	return nil
+	return amount, k.recordSlashEvent(ctx, validatorAddr, slash)
 }
 
-// CheckAllValidatorsEligibility checks eligibility for all validators and slashes if needed
+// CheckAllValidatorsEligibility checks eligibility for all validators and slashes if needed.
+// It runs as the current epoch ends.
 func (k Keeper) CheckAllValidatorsEligibility(ctx context.Context) error {
-	params, err := k.Params.Get(ctx)
+	epoch, err := k.GetCurrentEpoch(ctx)
 	if err != nil {
 		return err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockHeight := uint64(sdkCtx.BlockHeight())
-
-	// Only check at epoch boundaries
-	if blockHeight%params.EpochLength != 0 {
-		return nil
-	}
 
 	// Get all validators from staking module
 	validators, err := k.stakingKeeper.GetAllValidators(ctx)
@@ -251,6 +292,16 @@ func (k Keeper) CheckAllValidatorsEligibility(ctx context.Context) error {
 
 		validatorAddr := validator.GetOperator()
 
+		// Count the epoch in the signing window
+		if err := k.countEpochRecords(ctx, validatorAddr, epoch.Number); err != nil {
+			sdkCtx.Logger().Error(
+				"failed to count validator records",
+				"validator", validatorAddr,
+				"error", err,
+			)
+			continue
+		}
+
 		// Check eligibility
 		eligible, err := k.CheckValidatorEligibility(ctx, validatorAddr)
 		if err != nil {
@@ -263,11 +314,35 @@ func (k Keeper) CheckAllValidatorsEligibility(ctx context.Context) error {
 			continue
 		}
 
-		// If not eligible, slash and update status
+		outcome := types.EligibilityEligible
+		if !eligible {
+			outcome = types.EligibilityIneligible
+		}
+		err = k.updateEpochActivity(ctx, validatorAddr, func(summary *types.ValidatorEpochSummary) {
+			summary.Eligibility = outcome
+		})
+		if err != nil {
+			return err
+		}
+
+		// If not eligible, penalize and update status. Validators in their
+		// onboarding grace period are not penalized.
 		if !eligible {
-			if err := k.SlashValidatorForMissingRecords(ctx, validatorAddr); err != nil {
+			if inGrace, err := k.inGracePeriod(ctx, validatorAddr, epoch.Number); err != nil {
+				sdkCtx.Logger().Error(
+					"failed to check validator grace period",
+					"validator", validatorAddr,
+					"error", err,
+				)
+			} else if inGrace {
+				sdkCtx.Logger().Info(
+					"validator in onboarding grace period not penalized",
+					"validator", validatorAddr,
+					"epoch", epoch.Number,
+				)
+			} else if err := k.penalizeMissedEpoch(ctx, validatorAddr); err != nil {
 				sdkCtx.Logger().Error(
-					"failed to slash validator",
+					"failed to penalize validator",
 					"validator", validatorAddr,
 					"error", err,
 				)
@@ -285,13 +360,15 @@ func (k Keeper) CheckAllValidatorsEligibility(ctx context.Context) error {
 			sdkCtx.Logger().Info(
 				"validator marked ineligible due to insufficient records",
 				"validator", validatorAddr,
-				"epoch", blockHeight/params.EpochLength,
+				"epoch", epoch.Number,
 			)
 		} else {
-			// Update next required record time
+			// Require a record in the next epoch
 			stats, err := k.GetValidatorStats(ctx, validatorAddr)
 			if err == nil {
-				stats.NextRequiredRecordTime = sdkCtx.BlockTime().Unix() + int64(params.EpochLength)
+				stats.NextRequiredRecordEpoch = epoch.Number + 1
+				stats.IsEligible = true
+				stats.ConsecutiveMissedEpochs = 0
 				_ = k.SetValidatorStats(ctx, validatorAddr, stats)
 			}
 		}
@@ -299,3 +376,16 @@ func (k Keeper) CheckAllValidatorsEligibility(ctx context.Context) error {
 
 	return nil
 }
+
+// inGracePeriod reports whether a validator is in its onboarding grace period
+// in the given epoch
+func (k Keeper) inGracePeriod(ctx context.Context, validatorAddr string, epoch uint64) (bool, error) {
+	stats, err := k.ValidatorStats.Get(ctx, validatorAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return stats.InGracePeriod(epoch), nil
+}
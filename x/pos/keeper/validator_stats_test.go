@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestOnboardingGracePeriod(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// a new validator has no verified records, so it fails the default
+	// eligibility requirement as soon as it is checked
+	params := types.DefaultParams()
+	params.EpochLength = 10
+	params.OnboardingEpochs = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	validator := f.addBondedValidator(t)
+	require.NoError(t, f.keeper.InitializeValidatorStats(f.beginBlock(t, 1), validator))
+
+	res, err := qs.ValidatorStats(f.ctx, &types.QueryValidatorStatsRequest{ValidatorAddress: validator})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Stats.GraceEndEpoch)
+	require.True(t, res.InGracePeriod)
+
+	// epochs 0 to 2 end without a penalty
+	for height := int64(10); height <= 30; height += 10 {
+		f.beginBlock(t, height)
+	}
+	require.Empty(t, f.stakingKeeper.slashes)
+
+	res, err = qs.ValidatorStats(f.ctx, &types.QueryValidatorStatsRequest{ValidatorAddress: validator})
+	require.NoError(t, err)
+	require.False(t, res.InGracePeriod)
+	require.Zero(t, res.Stats.ConsecutiveMissedEpochs)
+
+	// the first epoch after the grace period is penalized
+	f.beginBlock(t, 40)
+	require.Len(t, f.stakingKeeper.slashes, 1)
+
+	info, err := f.keeper.RecordSigningInfos.Get(f.ctx, validator)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), info.StartEpoch)
+}
+
+func TestValidatorStatsNotFound(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := f.keeper.GetValidatorStats(f.ctx, "unknown")
+	require.ErrorIs(t, err, types.ErrValidatorStatsNotFound)
+
+	eligible, err := f.keeper.CheckValidatorEligibility(f.ctx, "unknown")
+	require.NoError(t, err)
+	require.False(t, eligible)
+
+	res, err := qs.ValidatorStats(f.ctx, &types.QueryValidatorStatsRequest{ValidatorAddress: "unknown"})
+	require.NoError(t, err)
+	require.Equal(t, "unknown", res.Stats.ValidatorAddress)
+	require.False(t, res.Stats.IsEligible)
+}
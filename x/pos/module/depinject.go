@@ -1,18 +1,27 @@
 package pos
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/spf13/cast"
 
 	"github.com/NeomSense/PoS/x/pos/keeper"
+	"github.com/NeomSense/PoS/x/pos/offchain"
 	"github.com/NeomSense/PoS/x/pos/types"
 )
 
+// FlagDataArchiveDir is the app.toml option naming the local directory record
+// data is exported to before it is pruned
+const FlagDataArchiveDir = "pos.data-archive-dir"
+
 var _ depinject.OnePerModuleType = AppModule{}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -33,10 +42,13 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper     types.AuthKeeper
-	BankKeeper     types.BankKeeper
-	StakingKeeper  types.StakingKeeper
-	SlashingKeeper types.SlashingKeeper
+	AuthKeeper         types.AuthKeeper
+	BankKeeper         types.BankKeeper
+	StakingKeeper      types.StakingKeeper
+	SlashingKeeper     types.SlashingKeeper
+	DistributionKeeper types.DistributionKeeper
+
+	AppOpts servertypes.AppOptions `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -59,7 +71,20 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.StakingKeeper,
 		in.SlashingKeeper,
+		in.DistributionKeeper,
+		in.BankKeeper,
 	)
+
+	// Node operators can export record data to a local archive before it is pruned
+	if in.AppOpts != nil {
+		if dir := cast.ToString(in.AppOpts.Get(FlagDataArchiveDir)); dir != "" {
+			archive, err := offchain.NewDirStore(dir)
+			if err != nil {
+				panic(fmt.Errorf("failed to open record data archive: %w", err))
+			}
+			k.SetDataArchive(archive)
+		}
+	}
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
 	return ModuleOutputs{PosKeeper: k, Module: m}
@@ -90,6 +90,64 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	cfg, ok := registrar.(module.Configurator)
+	if !ok {
+		return nil
+	}
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 3 to 4: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 6 to 7: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 7 to 8: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 8 to 9: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 9 to 10: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 10, m.Migrate10to11); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 10 to 11: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 11, m.Migrate11to12); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 11 to 12: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 12, m.Migrate12to13); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 12 to 13: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 13, m.Migrate13to14); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 13 to 14: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 14, m.Migrate14to15); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 14 to 15: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 15, m.Migrate15to16); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 15 to 16: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 16, m.Migrate16to17); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 16 to 17: %w", types.ModuleName, err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 17, m.Migrate17to18); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 17 to 18: %w", types.ModuleName, err)
+	}
+
 	return nil
 }
 
@@ -140,17 +198,52 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 18 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
-// The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// Ends the current epoch and starts the next one at epoch boundaries; validator
+// eligibility is checked, and validators that don't meet record requirements
+// are slashed, as the epoch ends.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.AdvanceEpoch(ctx)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
-// Checks validator eligibility and slashes validators that don't meet record requirements.
+// Closes ended commit-reveal phases, verifies undisputed optimistic records,
+// expires stale pending records, prunes the data of old records, discards
+// abandoned uploads, fails expired availability challenges and moves a share
+// of the block's fees to the reward pool.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	// Check validator eligibility and slash if needed (runs at epoch boundaries)
-	return am.keeper.CheckAllValidatorsEligibility(ctx)
+	// Settle records whose reveal phase has ended
+	if err := am.keeper.ProcessRevealQueue(ctx); err != nil {
+		return err
+	}
+
+	// Verify optimistic records whose dispute window passed undisputed
+	if err := am.keeper.ProcessDisputeWindowQueue(ctx); err != nil {
+		return err
+	}
+
+	// Expire records still pending past their verification deadline
+	if err := am.keeper.ProcessExpiryQueue(ctx); err != nil {
+		return err
+	}
+
+	// Prune the data of records past the retention period
+	if err := am.keeper.ProcessPruneQueue(ctx); err != nil {
+		return err
+	}
+
+	// Discard chunked uploads that were not finalized in time
+	if err := am.keeper.ProcessUploadQueue(ctx); err != nil {
+		return err
+	}
+
+	// Slash submitters that did not answer an availability challenge in time
+	if err := am.keeper.ProcessChallengeQueue(ctx); err != nil {
+		return err
+	}
+
+	// Fund the reward pool with its share of the fees
+	return am.keeper.CollectRewardPoolFees(ctx)
 }
@@ -0,0 +1,128 @@
+// Package offchain provides stores for the data of off-chain records.
+//
+// Off-chain records keep only their merkle root, size and a locator on chain.
+// The stores here are content addressed by the record merkle root and are
+// meant as local stand-ins for a real data availability service, e.g. in tests
+// or on devnets.
+package offchain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+// Store stores record data off chain and returns a locator to fetch it back.
+type Store interface {
+	// Put stores data and returns its locator
+	Put(data []byte) (string, error)
+	// Get returns the data stored at locator
+	Get(locator string) ([]byte, error)
+}
+
+const (
+	memScheme  = "mem://"
+	fileScheme = "file://"
+)
+
+var (
+	_ Store = (*MemStore)(nil)
+	_ Store = DirStore{}
+)
+
+// MemStore is an in-memory Store
+type MemStore struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// NewMemStore returns an empty in-memory store
+func NewMemStore() *MemStore {
+	return &MemStore{data: make(map[string][]byte)}
+}
+
+// Put implements Store. Locators have the form mem://<merkle root>.
+func (s *MemStore) Put(data []byte) (string, error) {
+	root := types.RecordMerkleRoot(data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[root] = append([]byte(nil), data...)
+
+	return memScheme + root, nil
+}
+
+// Get implements Store
+func (s *MemStore) Get(locator string) ([]byte, error) {
+	root, ok := strings.CutPrefix(locator, memScheme)
+	if !ok {
+		return nil, fmt.Errorf("unsupported locator %q", locator)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.data[root]
+	if !ok {
+		return nil, fmt.Errorf("no data at %s", locator)
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// DirStore is a Store keeping each record's data in a file named after its
+// merkle root under a directory
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a store writing to dir, creating the directory if needed
+func NewDirStore(dir string) (DirStore, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return DirStore{}, err
+	}
+
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return DirStore{}, err
+	}
+	return DirStore{dir: abs}, nil
+}
+
+// Put implements Store. Locators have the form file://<dir>/<merkle root>.
+func (s DirStore) Put(data []byte) (string, error) {
+	path := filepath.Join(s.dir, types.RecordMerkleRoot(data))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+	return fileScheme + path, nil
+}
+
+// Get implements Store. Only files inside the store directory can be read.
+func (s DirStore) Get(locator string) ([]byte, error) {
+	path, ok := strings.CutPrefix(locator, fileScheme)
+	if !ok {
+		return nil, fmt.Errorf("unsupported locator %q", locator)
+	}
+
+	if filepath.Dir(filepath.Clean(path)) != s.dir {
+		return nil, fmt.Errorf("locator %s is outside of %s", locator, s.dir)
+	}
+	return os.ReadFile(path)
+}
+
+// Fetch returns the data at locator after checking it matches merkleRoot
+func Fetch(store Store, locator, merkleRoot string) ([]byte, error) {
+	data, err := store.Get(locator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateRecordMerkleRoot(data, merkleRoot); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,41 @@
+package offchain_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/offchain"
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestStores(t *testing.T) {
+	dirStore, err := offchain.NewDirStore(t.TempDir())
+	require.NoError(t, err)
+
+	stores := map[string]offchain.Store{
+		"mem": offchain.NewMemStore(),
+		"dir": dirStore,
+	}
+
+	data := bytes.Repeat([]byte("record"), 500)
+	root := types.RecordMerkleRoot(data)
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			locator, err := store.Put(data)
+			require.NoError(t, err)
+
+			got, err := offchain.Fetch(store, locator, root)
+			require.NoError(t, err)
+			require.Equal(t, data, got)
+
+			_, err = offchain.Fetch(store, locator, types.RecordMerkleRoot([]byte("other")))
+			require.ErrorIs(t, err, types.ErrInvalidMerkleRoot)
+
+			_, err = store.Get("ipfs://" + root)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/challenge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChallengeStatus defines the status of an availability challenge
+type ChallengeStatus int32
+
+const (
+	ChallengeStatusUnspecified ChallengeStatus = 0
+	ChallengeStatusOpen        ChallengeStatus = 1
+	ChallengeStatusAnswered    ChallengeStatus = 2
+	ChallengeStatusFailed      ChallengeStatus = 3
+)
+
+var ChallengeStatus_name = map[int32]string{
+	0: "CHALLENGE_STATUS_UNSPECIFIED",
+	1: "CHALLENGE_STATUS_OPEN",
+	2: "CHALLENGE_STATUS_ANSWERED",
+	3: "CHALLENGE_STATUS_FAILED",
+}
+
+var ChallengeStatus_value = map[string]int32{
+	"CHALLENGE_STATUS_UNSPECIFIED": 0,
+	"CHALLENGE_STATUS_OPEN":        1,
+	"CHALLENGE_STATUS_ANSWERED":    2,
+	"CHALLENGE_STATUS_FAILED":      3,
+}
+
+func (x ChallengeStatus) String() string {
+	return proto.EnumName(ChallengeStatus_name, int32(x))
+}
+
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d248b1b1c86f4717, []int{0}
+}
+
+// AvailabilityChallenge asks the submitter of an off-chain record to prove
+// that the record data is still available
+type AvailabilityChallenge struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecordId   string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// chunk_index is the chunk the submitter must prove when it does not answer
+	// with the full data
+	ChunkIndex  uint64 `protobuf:"varint,4,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// deadline_height is the height from which the challenge can no longer be
+	// answered; an unanswered challenge fails and the submitter is slashed
+	DeadlineHeight uint64          `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	Status         ChallengeStatus `protobuf:"varint,7,opt,name=status,proto3,enum=pos.pos.v1.ChallengeStatus" json:"status,omitempty"`
+	// bond is the challenge bond escrowed by the challenger
+	Bond types.Coin `protobuf:"bytes,8,opt,name=bond,proto3" json:"bond"`
+}
+
+func (m *AvailabilityChallenge) Reset()         { *m = AvailabilityChallenge{} }
+func (m *AvailabilityChallenge) String() string { return proto.CompactTextString(m) }
+func (*AvailabilityChallenge) ProtoMessage()    {}
+func (*AvailabilityChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d248b1b1c86f4717, []int{0}
+}
+func (m *AvailabilityChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AvailabilityChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AvailabilityChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AvailabilityChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AvailabilityChallenge.Merge(m, src)
+}
+func (m *AvailabilityChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *AvailabilityChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_AvailabilityChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AvailabilityChallenge proto.InternalMessageInfo
+
+func (m *AvailabilityChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AvailabilityChallenge) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *AvailabilityChallenge) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *AvailabilityChallenge) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *AvailabilityChallenge) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AvailabilityChallenge) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *AvailabilityChallenge) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ChallengeStatusUnspecified
+}
+
+func (m *AvailabilityChallenge) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("pos.pos.v1.ChallengeStatus", ChallengeStatus_name, ChallengeStatus_value)
+	proto.RegisterType((*AvailabilityChallenge)(nil), "pos.pos.v1.AvailabilityChallenge")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/challenge.proto", fileDescriptor_d248b1b1c86f4717) }
+
+var fileDescriptor_d248b1b1c86f4717 = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0xc7, 0xdb, 0x2e, 0xae, 0xbb, 0x83, 0x61, 0x71, 0x94, 0x50, 0x8a, 0x29, 0xd5, 0x98, 0x48,
+	0xf6, 0xd0, 0x06, 0x36, 0x31, 0x66, 0x0f, 0xc6, 0x2e, 0x14, 0x97, 0x84, 0xb0, 0x84, 0x2e, 0x31,
+	0xf1, 0x42, 0xda, 0xce, 0x08, 0x93, 0x2d, 0x33, 0x0d, 0x53, 0x70, 0xf7, 0xe8, 0xcd, 0x70, 0xf2,
+	0x0b, 0x90, 0x98, 0x78, 0xf1, 0xe8, 0xa7, 0x30, 0x7b, 0xdc, 0xa3, 0x27, 0x63, 0xe0, 0xa0, 0x1f,
+	0xc3, 0x30, 0xc0, 0x6a, 0xe0, 0xf0, 0x9a, 0x97, 0xdf, 0xfb, 0xff, 0x5f, 0xdb, 0x7f, 0x1e, 0xd0,
+	0x22, 0xc6, 0xad, 0x45, 0x8d, 0x4b, 0x56, 0xd0, 0xf7, 0xc2, 0x10, 0xd3, 0x1e, 0x36, 0xa3, 0x21,
+	0x8b, 0x19, 0x04, 0x11, 0xe3, 0xe6, 0xa2, 0xc6, 0x25, 0xed, 0xbe, 0x37, 0x20, 0x94, 0x59, 0xe2,
+	0xb9, 0x1c, 0x6b, 0x7a, 0xc0, 0xf8, 0x80, 0x71, 0xcb, 0xf7, 0x38, 0xb6, 0xc6, 0x25, 0x1f, 0xc7,
+	0x5e, 0xc9, 0x0a, 0x18, 0xa1, 0xab, 0xf9, 0xc3, 0x1e, 0xeb, 0x31, 0xd1, 0x5a, 0x8b, 0x6e, 0x49,
+	0x9f, 0x7c, 0x57, 0x40, 0xc6, 0x1e, 0x7b, 0x24, 0xf4, 0x7c, 0x12, 0x92, 0xf8, 0xaa, 0xb2, 0x7e,
+	0x29, 0x4c, 0x01, 0x85, 0x20, 0x55, 0x36, 0xe4, 0x62, 0xa2, 0xad, 0x10, 0x04, 0xf3, 0x60, 0x7f,
+	0x88, 0x03, 0x36, 0x44, 0x5d, 0x82, 0x54, 0xc5, 0x90, 0x8b, 0xfb, 0xed, 0xbd, 0x25, 0xa8, 0x23,
+	0xa8, 0x03, 0x70, 0xfb, 0xb9, 0x43, 0x75, 0x47, 0x4c, 0xff, 0x23, 0xb0, 0x00, 0x92, 0x41, 0x7f,
+	0x44, 0x2f, 0xba, 0x84, 0x22, 0x7c, 0xa9, 0x26, 0xc4, 0x56, 0x20, 0x50, 0x7d, 0x41, 0xe0, 0x63,
+	0x70, 0xcf, 0x0f, 0x59, 0x70, 0xd1, 0xed, 0x63, 0xd2, 0xeb, 0xc7, 0xea, 0x1d, 0xa1, 0x48, 0x0a,
+	0x76, 0x2a, 0x10, 0x7c, 0x06, 0x0e, 0x10, 0xf6, 0x50, 0x48, 0x28, 0x5e, 0xab, 0x76, 0x85, 0x2a,
+	0xb5, 0xc6, 0x2b, 0xe1, 0x11, 0xd8, 0xe5, 0xb1, 0x17, 0x8f, 0xb8, 0x7a, 0xd7, 0x90, 0x8b, 0xa9,
+	0x72, 0xde, 0xfc, 0x97, 0x9c, 0x79, 0xfb, 0x83, 0xae, 0x90, 0xb4, 0x57, 0x52, 0xf8, 0x02, 0x24,
+	0x7c, 0x46, 0x91, 0xba, 0x67, 0xc8, 0xc5, 0x64, 0x39, 0x67, 0x2e, 0xd3, 0x34, 0x17, 0x69, 0x9a,
+	0xab, 0x34, 0xcd, 0x0a, 0x23, 0xf4, 0x64, 0xff, 0xfa, 0x67, 0x41, 0xfa, 0xfa, 0xfb, 0xdb, 0xa1,
+	0xdc, 0x16, 0x8e, 0xe3, 0xc4, 0x9f, 0xcf, 0x05, 0xf9, 0xf0, 0x83, 0x02, 0x0e, 0x36, 0x76, 0xc3,
+	0x57, 0xe0, 0x51, 0xe5, 0xd4, 0x6e, 0x34, 0x9c, 0xe6, 0x6b, 0xa7, 0xeb, 0x9e, 0xdb, 0xe7, 0x1d,
+	0xb7, 0xdb, 0x69, 0xba, 0x2d, 0xa7, 0x52, 0xaf, 0xd5, 0x9d, 0x6a, 0x5a, 0xd2, 0xf4, 0xc9, 0xd4,
+	0xd0, 0x36, 0x6c, 0x1d, 0xca, 0x23, 0x1c, 0x90, 0x77, 0x04, 0x23, 0x58, 0x06, 0x99, 0xad, 0x0d,
+	0x67, 0x2d, 0xa7, 0x99, 0x96, 0xb5, 0xec, 0x64, 0x6a, 0x3c, 0xd8, 0xb0, 0x9e, 0x45, 0x98, 0xc2,
+	0x63, 0x90, 0xdb, 0xf2, 0xd8, 0x4d, 0xf7, 0x8d, 0xd3, 0x76, 0xaa, 0x69, 0x45, 0xcb, 0x4f, 0xa6,
+	0x46, 0x76, 0xc3, 0x67, 0x53, 0xfe, 0x1e, 0x0f, 0x31, 0x82, 0xcf, 0x41, 0x76, 0xcb, 0x5b, 0xb3,
+	0xeb, 0x0d, 0xa7, 0x9a, 0xde, 0xd1, 0x72, 0x93, 0xa9, 0x91, 0xd9, 0x70, 0xd6, 0x3c, 0x12, 0x62,
+	0xa4, 0x25, 0x3e, 0x7e, 0xd1, 0xa5, 0x93, 0x97, 0xd7, 0x33, 0x5d, 0xbe, 0x99, 0xe9, 0xf2, 0xaf,
+	0x99, 0x2e, 0x7f, 0x9a, 0xeb, 0xd2, 0xcd, 0x5c, 0x97, 0x7e, 0xcc, 0x75, 0xe9, 0xed, 0xd3, 0x1e,
+	0x89, 0xfb, 0x23, 0xdf, 0x0c, 0xd8, 0xc0, 0x6a, 0x62, 0x36, 0x70, 0x31, 0xe5, 0xd8, 0x6a, 0x31,
+	0xd7, 0xba, 0x14, 0xe7, 0x1e, 0x5f, 0x45, 0x98, 0xfb, 0xbb, 0xe2, 0x26, 0x8f, 0xfe, 0x06, 0x00,
+	0x00, 0xff, 0xff, 0xf0, 0x78, 0x7a, 0x02, 0x06, 0x03, 0x00, 0x00,
+}
+
+func (this *AvailabilityChallenge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AvailabilityChallenge)
+	if !ok {
+		that2, ok := that.(AvailabilityChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.Challenger != that1.Challenger {
+		return false
+	}
+	if this.ChunkIndex != that1.ChunkIndex {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !this.Bond.Equal(&that1.Bond) {
+		return false
+	}
+	return true
+}
+func (m *AvailabilityChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailabilityChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailabilityChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChallenge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Status != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChallenge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChallenge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AvailabilityChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovChallenge(uint64(m.Id))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovChallenge(uint64(m.ChunkIndex))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovChallenge(uint64(m.BlockHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovChallenge(uint64(m.DeadlineHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovChallenge(uint64(m.Status))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovChallenge(uint64(l))
+	return n
+}
+
+func sovChallenge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChallenge(x uint64) (n int) {
+	return sovChallenge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AvailabilityChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailabilityChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailabilityChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChallenge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChallenge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChallenge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChallenge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChallenge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChallenge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChallenge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChallenge = fmt.Errorf("proto: unexpected end of group")
+)
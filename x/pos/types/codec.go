@@ -11,6 +11,19 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgSubmitRecord{},
 		&MsgVerifyRecord{},
+		&MsgCommitVerification{},
+		&MsgRevealVerification{},
+		&MsgChallengeAvailability{},
+		&MsgRespondAvailabilityChallenge{},
+		&MsgDisputeRecord{},
+		&MsgSetRecordType{},
+		&MsgRemoveRecordType{},
+		&MsgBeginRecordUpload{},
+		&MsgUploadRecordChunk{},
+		&MsgFinalizeRecord{},
+		&MsgAmendRecord{},
+		&MsgFundRewardPool{},
+		&MsgUnjailForRecords{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &Msg_serviceDesc)
 }
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxDisputeReasonLength is the maximum length of a record dispute's reason
+const MaxDisputeReasonLength = 512
+
+// ParseDisputeStatus parses a dispute status from its enum name or its short
+// form, e.g. "DISPUTE_STATUS_UPHELD" or "upheld".
+func ParseDisputeStatus(s string) (DisputeStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "DISPUTE_STATUS_") {
+		name = "DISPUTE_STATUS_" + name
+	}
+
+	status, ok := DisputeStatus_value[name]
+	if !ok {
+		return DisputeStatusUnspecified, fmt.Errorf("unknown dispute status %q", s)
+	}
+	return DisputeStatus(status), nil
+}
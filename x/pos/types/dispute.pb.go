@@ -0,0 +1,647 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisputeStatus defines the status of a record dispute
+type DisputeStatus int32
+
+const (
+	DisputeStatusUnspecified DisputeStatus = 0
+	// the record has not been settled yet
+	DisputeStatusOpen DisputeStatus = 1
+	// the record was rejected and the bond returned to the disputer
+	DisputeStatusUpheld DisputeStatus = 2
+	// the record was verified and the bond burned
+	DisputeStatusDismissed DisputeStatus = 3
+	// the record expired before it was settled and the bond was returned
+	DisputeStatusVoided DisputeStatus = 4
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_UNSPECIFIED",
+	1: "DISPUTE_STATUS_OPEN",
+	2: "DISPUTE_STATUS_UPHELD",
+	3: "DISPUTE_STATUS_DISMISSED",
+	4: "DISPUTE_STATUS_VOIDED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_UNSPECIFIED": 0,
+	"DISPUTE_STATUS_OPEN":        1,
+	"DISPUTE_STATUS_UPHELD":      2,
+	"DISPUTE_STATUS_DISMISSED":   3,
+	"DISPUTE_STATUS_VOIDED":      4,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5638463af0065bef, []int{0}
+}
+
+// RecordDispute is a dispute filed against a record under optimistic
+// verification. The record is then settled by the verifier vote.
+type RecordDispute struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Disputer string `protobuf:"bytes,2,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	// bond is the amount the disputer escrowed with the dispute
+	Bond        types.Coin    `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+	Reason      string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockHeight uint64        `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Status      DisputeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=pos.pos.v1.DisputeStatus" json:"status,omitempty"`
+}
+
+func (m *RecordDispute) Reset()         { *m = RecordDispute{} }
+func (m *RecordDispute) String() string { return proto.CompactTextString(m) }
+func (*RecordDispute) ProtoMessage()    {}
+func (*RecordDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5638463af0065bef, []int{0}
+}
+func (m *RecordDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordDispute.Merge(m, src)
+}
+func (m *RecordDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordDispute proto.InternalMessageInfo
+
+func (m *RecordDispute) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *RecordDispute) GetDisputer() string {
+	if m != nil {
+		return m.Disputer
+	}
+	return ""
+}
+
+func (m *RecordDispute) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *RecordDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RecordDispute) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RecordDispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatusUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("pos.pos.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterType((*RecordDispute)(nil), "pos.pos.v1.RecordDispute")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/dispute.proto", fileDescriptor_5638463af0065bef) }
+
+var fileDescriptor_5638463af0065bef = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0xcf, 0x74, 0x63, 0xd9, 0x9d, 0x75, 0xa5, 0xce, 0xfe, 0x71, 0x36, 0x4a, 0x36, 0x8a, 0x87,
+	0x22, 0x98, 0xd0, 0xae, 0x07, 0x11, 0x11, 0xb6, 0x9b, 0xc8, 0x06, 0xb4, 0x2d, 0x99, 0x76, 0x0f,
+	0x5e, 0x4a, 0x93, 0x19, 0xdb, 0xc1, 0x6d, 0x26, 0x64, 0xd2, 0xa2, 0x6f, 0x20, 0xf5, 0xe2, 0x0b,
+	0x14, 0x04, 0xf1, 0x0d, 0x7c, 0x88, 0x3d, 0x2e, 0x9e, 0x3c, 0x89, 0xb4, 0x17, 0x1f, 0x43, 0xf2,
+	0x07, 0x25, 0x65, 0x0f, 0x1f, 0xcc, 0xf7, 0xfb, 0xc3, 0xef, 0xfb, 0x86, 0x0f, 0xe2, 0x48, 0x48,
+	0x2b, 0xad, 0x59, 0xc3, 0xa2, 0x5c, 0x46, 0xd3, 0x84, 0x99, 0x51, 0x2c, 0x12, 0x81, 0x60, 0x24,
+	0xa4, 0x99, 0xd6, 0xac, 0xa1, 0xe9, 0x81, 0x90, 0x13, 0x21, 0x2d, 0x7f, 0x28, 0x99, 0x35, 0x6b,
+	0xf8, 0x2c, 0x19, 0x36, 0xac, 0x40, 0xf0, 0x30, 0xd7, 0x6a, 0x87, 0x39, 0x3f, 0xc8, 0x3a, 0x2b,
+	0x6f, 0x0a, 0x6a, 0x6f, 0x24, 0x46, 0x22, 0xc7, 0xd3, 0x57, 0x8e, 0x3e, 0xf8, 0x54, 0x81, 0x3b,
+	0x1e, 0x0b, 0x44, 0x4c, 0xed, 0x3c, 0x14, 0xdd, 0x85, 0x5b, 0x71, 0x06, 0x0c, 0x38, 0xc5, 0xc0,
+	0x00, 0xf5, 0x2d, 0x6f, 0x33, 0x07, 0x5c, 0x8a, 0x9e, 0xc0, 0xcd, 0x62, 0xb8, 0x18, 0x57, 0x52,
+	0xae, 0x85, 0x7f, 0x7c, 0x7f, 0xbc, 0x57, 0x04, 0x9d, 0x50, 0x1a, 0x33, 0x29, 0x49, 0x12, 0xf3,
+	0x70, 0xe4, 0xfd, 0x53, 0xa2, 0x63, 0xa8, 0xfa, 0x22, 0xa4, 0x78, 0xc3, 0x00, 0xf5, 0xed, 0xe6,
+	0xa1, 0x59, 0xc8, 0xd3, 0x25, 0xcc, 0x62, 0x09, 0xf3, 0x54, 0xf0, 0xb0, 0xa5, 0x5e, 0xfe, 0x3a,
+	0x52, 0xbc, 0x4c, 0x8c, 0x0e, 0x60, 0x35, 0x66, 0x43, 0x29, 0x42, 0xac, 0x66, 0x43, 0x14, 0x1d,
+	0xba, 0x0f, 0x6f, 0xfa, 0x17, 0x22, 0x78, 0x37, 0x18, 0x33, 0x3e, 0x1a, 0x27, 0xf8, 0x86, 0x01,
+	0xea, 0xaa, 0xb7, 0x9d, 0x61, 0x67, 0x19, 0x84, 0x1a, 0xb0, 0x2a, 0x93, 0x61, 0x32, 0x95, 0xb8,
+	0x6a, 0x80, 0xfa, 0xad, 0xe6, 0xa1, 0xf9, 0xff, 0x0b, 0xcd, 0x62, 0x4f, 0x92, 0x09, 0xbc, 0x42,
+	0xf8, 0x4c, 0xfd, 0xf3, 0xe5, 0x08, 0x3c, 0xfa, 0x56, 0x81, 0x3b, 0x25, 0x1e, 0x3d, 0x87, 0x9a,
+	0xed, 0x92, 0x6e, 0xbf, 0xe7, 0x0c, 0x48, 0xef, 0xa4, 0xd7, 0x27, 0x83, 0x7e, 0x9b, 0x74, 0x9d,
+	0x53, 0xf7, 0xa5, 0xeb, 0xd8, 0x35, 0x45, 0xbb, 0x37, 0x5f, 0x18, 0xb8, 0x64, 0xe9, 0x87, 0x32,
+	0x62, 0x01, 0x7f, 0xcb, 0x19, 0x45, 0x26, 0xdc, 0x5d, 0x73, 0x77, 0xba, 0x4e, 0xbb, 0x06, 0xb4,
+	0xfd, 0xf9, 0xc2, 0xb8, 0x5d, 0xb2, 0x75, 0x22, 0x16, 0xa2, 0x26, 0xdc, 0x5f, 0x4f, 0xeb, 0x9e,
+	0x39, 0xaf, 0xec, 0x5a, 0x45, 0xbb, 0x33, 0x5f, 0x18, 0xbb, 0xe5, 0xa0, 0x68, 0xcc, 0x2e, 0x28,
+	0x7a, 0x0a, 0xf1, 0x9a, 0xc7, 0x76, 0xc9, 0x6b, 0x97, 0x10, 0xc7, 0xae, 0x6d, 0x68, 0xda, 0x7c,
+	0x61, 0x1c, 0x94, 0x6c, 0x36, 0x97, 0x13, 0x2e, 0x25, 0xa3, 0xd7, 0xa4, 0x9d, 0x77, 0x5c, 0xdb,
+	0xb1, 0x6b, 0xea, 0x35, 0x69, 0xe7, 0x82, 0x53, 0x46, 0x35, 0xf5, 0xe3, 0x57, 0x5d, 0x69, 0xbd,
+	0xb8, 0x5c, 0xea, 0xe0, 0x6a, 0xa9, 0x83, 0xdf, 0x4b, 0x1d, 0x7c, 0x5e, 0xe9, 0xca, 0xd5, 0x4a,
+	0x57, 0x7e, 0xae, 0x74, 0xe5, 0xcd, 0xc3, 0x11, 0x4f, 0xc6, 0x53, 0xdf, 0x0c, 0xc4, 0xc4, 0x6a,
+	0x33, 0x31, 0x21, 0x2c, 0x94, 0xcc, 0xea, 0x0a, 0x62, 0xbd, 0xcf, 0xae, 0x3b, 0xf9, 0x10, 0x31,
+	0xe9, 0x57, 0xb3, 0xe3, 0x3b, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x8e, 0xca, 0x63, 0xf5,
+	0x02, 0x00, 0x00,
+}
+
+func (this *RecordDispute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordDispute)
+	if !ok {
+		that2, ok := that.(RecordDispute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.Disputer != that1.Disputer {
+		return false
+	}
+	if !this.Bond.Equal(&that1.Bond) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (m *RecordDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Disputer) > 0 {
+		i -= len(m.Disputer)
+		copy(dAtA[i:], m.Disputer)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Disputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Disputer)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovDispute(uint64(m.BlockHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovDispute(uint64(m.Status))
+	}
+	return n
+}
+
+func sovDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDispute(x uint64) (n int) {
+	return sovDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecordDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDispute = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ParseContentEncoding parses a content encoding from its enum name or its
+// short form, e.g. "CONTENT_ENCODING_GZIP" or "gzip".
+func ParseContentEncoding(s string) (ContentEncoding, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if name == "" {
+		return ContentEncodingIdentity, nil
+	}
+	if !strings.HasPrefix(name, "CONTENT_ENCODING_") {
+		name = "CONTENT_ENCODING_" + name
+	}
+
+	encoding, ok := ContentEncoding_value[name]
+	if !ok {
+		return ContentEncodingIdentity, fmt.Errorf("unknown content encoding %q", s)
+	}
+	return ContentEncoding(encoding), nil
+}
+
+// EncodeRecordData compresses record data with the given content encoding.
+func EncodeRecordData(encoding ContentEncoding, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch encoding {
+	case ContentEncodingIdentity:
+		return data, nil
+	case ContentEncodingGzip:
+		w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case ContentEncodingZstd:
+		w, err := zstd.NewWriter(&buf, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidContentEncoding.Wrapf("unknown content encoding %d", encoding)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecodeRecordData decompresses record data with the given content encoding.
+// At most maxSize bytes are decoded: data that decodes to more is rejected
+// before it is fully inflated, so a decompression bomb can't exhaust memory.
+func DecodeRecordData(encoding ContentEncoding, data []byte, maxSize uint64) ([]byte, error) {
+	var (
+		r   io.Reader
+		src = bytes.NewReader(data)
+	)
+
+	switch encoding {
+	case ContentEncodingIdentity:
+		if uint64(len(data)) > maxSize {
+			return nil, ErrInvalidRecordSize.Wrapf("record size %d exceeds %d", len(data), maxSize)
+		}
+		return data, nil
+	case ContentEncodingGzip:
+		gr, err := gzip.NewReader(src)
+		if err != nil {
+			return nil, ErrInvalidContentEncoding.Wrapf("invalid gzip data: %s", err)
+		}
+		// Concatenated gzip members would let trailing data slip past the limit check
+		gr.Multistream(false)
+		r = gr
+	case ContentEncodingZstd:
+		// Bound the window the frame header can request, it is allocated upfront
+		window := maxSize
+		if window < zstd.MinWindowSize {
+			window = zstd.MinWindowSize
+		}
+		zr, err := zstd.NewReader(
+			src,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxWindow(window),
+			zstd.WithDecoderMaxMemory(maxSize+1),
+		)
+		if err != nil {
+			return nil, ErrInvalidContentEncoding.Wrapf("invalid zstd data: %s", err)
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return nil, ErrInvalidContentEncoding.Wrapf("unknown content encoding %d", encoding)
+	}
+
+	decoded, err := io.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+		return nil, ErrInvalidRecordSize.Wrapf("decoded record size exceeds %d", maxSize)
+	}
+	if err != nil {
+		return nil, ErrInvalidContentEncoding.Wrapf("failed to decode %s data: %s", encoding, err)
+	}
+
+	if uint64(len(decoded)) > maxSize {
+		return nil, ErrInvalidRecordSize.Wrapf("decoded record size exceeds %d", maxSize)
+	}
+
+	// Data after the gzip member would give the same payload another encoding
+	if encoding == ContentEncodingGzip && src.Len() != 0 {
+		return nil, ErrInvalidContentEncoding.Wrapf("%d bytes of trailing data after gzip stream", src.Len())
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestRecordDataEncoding(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"sensor": "s-1", "value": 21.5}`), 64)
+
+	for _, encoding := range []types.ContentEncoding{
+		types.ContentEncodingIdentity,
+		types.ContentEncodingGzip,
+		types.ContentEncodingZstd,
+	} {
+		t.Run(encoding.String(), func(t *testing.T) {
+			encoded, err := types.EncodeRecordData(encoding, data)
+			require.NoError(t, err)
+			if encoding != types.ContentEncodingIdentity {
+				require.Less(t, len(encoded), len(data)/5)
+			}
+
+			decoded, err := types.DecodeRecordData(encoding, encoded, uint64(len(data)))
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+
+			// data inflating past the limit is rejected
+			_, err = types.DecodeRecordData(encoding, encoded, uint64(len(data)-1))
+			require.ErrorIs(t, err, types.ErrInvalidRecordSize)
+		})
+	}
+}
+
+func TestDecodeRecordDataBomb(t *testing.T) {
+	// 64 MiB of zeros compresses to a few KiB
+	bomb := make([]byte, 64<<20)
+
+	for _, encoding := range []types.ContentEncoding{types.ContentEncodingGzip, types.ContentEncodingZstd} {
+		t.Run(encoding.String(), func(t *testing.T) {
+			encoded, err := types.EncodeRecordData(encoding, bomb)
+			require.NoError(t, err)
+			require.Less(t, len(encoded), 1<<20)
+
+			_, err = types.DecodeRecordData(encoding, encoded, 1<<20)
+			require.ErrorIs(t, err, types.ErrInvalidRecordSize)
+		})
+	}
+}
+
+func TestDecodeRecordDataInvalid(t *testing.T) {
+	for _, encoding := range []types.ContentEncoding{types.ContentEncodingGzip, types.ContentEncodingZstd} {
+		_, err := types.DecodeRecordData(encoding, []byte("not compressed"), 1024)
+		require.ErrorIs(t, err, types.ErrInvalidContentEncoding)
+	}
+
+	_, err := types.DecodeRecordData(types.ContentEncoding(7), []byte("data"), 1024)
+	require.ErrorIs(t, err, types.ErrInvalidContentEncoding)
+
+	// trailing bytes or a second member after a gzip stream are rejected
+	encoded, err := types.EncodeRecordData(types.ContentEncodingGzip, []byte("payload"))
+	require.NoError(t, err)
+	for _, trailer := range [][]byte{[]byte("x"), encoded} {
+		data := append(append([]byte{}, encoded...), trailer...)
+		_, err = types.DecodeRecordData(types.ContentEncodingGzip, data, 1024)
+		require.ErrorIs(t, err, types.ErrInvalidContentEncoding)
+	}
+}
+
+func TestParseContentEncoding(t *testing.T) {
+	for input, expected := range map[string]types.ContentEncoding{
+		"":                      types.ContentEncodingIdentity,
+		"gzip":                  types.ContentEncodingGzip,
+		"ZSTD":                  types.ContentEncodingZstd,
+		"CONTENT_ENCODING_GZIP": types.ContentEncodingGzip,
+	} {
+		encoding, err := types.ParseContentEncoding(input)
+		require.NoError(t, err)
+		require.Equal(t, expected, encoding)
+	}
+
+	_, err := types.ParseContentEncoding("brotli")
+	require.Error(t, err)
+}
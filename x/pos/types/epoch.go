@@ -0,0 +1,77 @@
+package types
+
+import "fmt"
+
+// MaxEpochSummaryRange is the largest number of epochs a range of epoch
+// summaries can be queried for at once
+const MaxEpochSummaryRange = 100
+
+// NewEpochInfo returns the epoch starting at startHeight and startTime,
+// measured as configured by params
+func NewEpochInfo(number, startHeight uint64, startTime int64, params Params) EpochInfo {
+	epoch := EpochInfo{
+		Number:      number,
+		StartHeight: startHeight,
+		StartTime:   startTime,
+		Mode:        params.EpochMode,
+	}
+
+	if params.EpochMode == EpochModeTime {
+		epoch.EndTime = startTime + int64(params.EpochDurationSeconds)
+	} else {
+		epoch.EndHeight = startHeight + params.EpochLength
+	}
+
+	return epoch
+}
+
+// HasEnded returns whether the epoch is over at a block of the given height and
+// time. A time measured epoch ends with the first block at or past its end time.
+func (e EpochInfo) HasEnded(height uint64, blockTime int64) bool {
+	if e.Mode == EpochModeTime {
+		return blockTime >= e.EndTime
+	}
+	return height >= e.EndHeight
+}
+
+// Validate checks that the epoch ends after it starts, as measured by its mode
+func (e EpochInfo) Validate() error {
+	switch e.Mode {
+	case EpochModeBlocks:
+		if e.EndHeight <= e.StartHeight {
+			return fmt.Errorf("epoch %d ends at height %d, not after its start height %d", e.Number, e.EndHeight, e.StartHeight)
+		}
+	case EpochModeTime:
+		if e.EndTime <= e.StartTime {
+			return fmt.Errorf("epoch %d ends at time %d, not after its start time %d", e.Number, e.EndTime, e.StartTime)
+		}
+	default:
+		return fmt.Errorf("epoch %d has unknown mode %d", e.Number, e.Mode)
+	}
+	return nil
+}
+
+// ValidateEpochHistory checks that epochs is an epoch history ordered oldest
+// first, with consecutive numbers and increasing start heights and times
+func ValidateEpochHistory(epochs []EpochInfo) error {
+	for i, epoch := range epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := epochs[i-1]
+		if epoch.Number != prev.Number+1 {
+			return fmt.Errorf("epoch %d follows epoch %d", epoch.Number, prev.Number)
+		}
+		if epoch.StartHeight <= prev.StartHeight {
+			return fmt.Errorf("epoch %d starts at height %d, not after epoch %d at %d", epoch.Number, epoch.StartHeight, prev.Number, prev.StartHeight)
+		}
+		if epoch.StartTime < prev.StartTime {
+			return fmt.Errorf("epoch %d starts before epoch %d", epoch.Number, prev.Number)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,2638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/epoch.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochMode defines how the length of epochs is measured.
+type EpochMode int32
+
+const (
+	// EPOCH_MODE_BLOCKS measures epochs in blocks, epoch_length long
+	EpochModeBlocks EpochMode = 0
+	// EPOCH_MODE_TIME measures epochs in block time, epoch_duration_seconds long
+	EpochModeTime EpochMode = 1
+)
+
+var EpochMode_name = map[int32]string{
+	0: "EPOCH_MODE_BLOCKS",
+	1: "EPOCH_MODE_TIME",
+}
+
+var EpochMode_value = map[string]int32{
+	"EPOCH_MODE_BLOCKS": 0,
+	"EPOCH_MODE_TIME":   1,
+}
+
+func (x EpochMode) String() string {
+	return proto.EnumName(EpochMode_name, int32(x))
+}
+
+func (EpochMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{0}
+}
+
+// EligibilityOutcome is the outcome of a validator eligibility check.
+type EligibilityOutcome int32
+
+const (
+	// ELIGIBILITY_OUTCOME_NOT_CHECKED is for validators that were not bonded
+	// when the epoch ended
+	EligibilityNotChecked EligibilityOutcome = 0
+	EligibilityEligible   EligibilityOutcome = 1
+	EligibilityIneligible EligibilityOutcome = 2
+)
+
+var EligibilityOutcome_name = map[int32]string{
+	0: "ELIGIBILITY_OUTCOME_NOT_CHECKED",
+	1: "ELIGIBILITY_OUTCOME_ELIGIBLE",
+	2: "ELIGIBILITY_OUTCOME_INELIGIBLE",
+}
+
+var EligibilityOutcome_value = map[string]int32{
+	"ELIGIBILITY_OUTCOME_NOT_CHECKED": 0,
+	"ELIGIBILITY_OUTCOME_ELIGIBLE":    1,
+	"ELIGIBILITY_OUTCOME_INELIGIBLE":  2,
+}
+
+func (x EligibilityOutcome) String() string {
+	return proto.EnumName(EligibilityOutcome_name, int32(x))
+}
+
+func (EligibilityOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{1}
+}
+
+// PenaltyAction is what a step of the missed epoch penalty schedule does.
+type PenaltyAction int32
+
+const (
+	// PENALTY_ACTION_NONE is for epochs no penalty was applied in
+	PenaltyNone PenaltyAction = 0
+	// PENALTY_ACTION_WARN only emits an event
+	PenaltyWarn PenaltyAction = 1
+	// PENALTY_ACTION_SLASH slashes the validator
+	PenaltySlash PenaltyAction = 2
+	// PENALTY_ACTION_JAIL jails the validator for a duration
+	PenaltyJail PenaltyAction = 3
+	// PENALTY_ACTION_TOMBSTONE jails the validator permanently
+	PenaltyTombstone PenaltyAction = 4
+)
+
+var PenaltyAction_name = map[int32]string{
+	0: "PENALTY_ACTION_NONE",
+	1: "PENALTY_ACTION_WARN",
+	2: "PENALTY_ACTION_SLASH",
+	3: "PENALTY_ACTION_JAIL",
+	4: "PENALTY_ACTION_TOMBSTONE",
+}
+
+var PenaltyAction_value = map[string]int32{
+	"PENALTY_ACTION_NONE":      0,
+	"PENALTY_ACTION_WARN":      1,
+	"PENALTY_ACTION_SLASH":     2,
+	"PENALTY_ACTION_JAIL":      3,
+	"PENALTY_ACTION_TOMBSTONE": 4,
+}
+
+func (x PenaltyAction) String() string {
+	return proto.EnumName(PenaltyAction_name, int32(x))
+}
+
+func (PenaltyAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{2}
+}
+
+// EpochInfo describes an epoch of the pos module. Record limits, eligibility
+// checks and every other per-epoch rule are measured against it. An epoch ends
+// at the start of the first block after it, when the next epoch starts.
+type EpochInfo struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// start_height is the height of the first block of the epoch
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the unix time of the first block of the epoch
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// mode is how the length of the epoch is measured, taken from the params
+	// when the epoch started
+	Mode EpochMode `protobuf:"varint,4,opt,name=mode,proto3,enum=pos.pos.v1.EpochMode" json:"mode,omitempty"`
+	// end_height is the height of the first block after a block measured epoch
+	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time is the unix time from which a block of a time measured epoch
+	// starts the next epoch
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
+func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
+func (*EpochInfo) ProtoMessage()    {}
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{0}
+}
+func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfo.Merge(m, src)
+}
+func (m *EpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfo proto.InternalMessageInfo
+
+func (m *EpochInfo) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetMode() EpochMode {
+	if m != nil {
+		return m.Mode
+	}
+	return EpochModeBlocks
+}
+
+func (m *EpochInfo) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// EpochSummary is a snapshot of an ended epoch, written as the epoch ends. The
+// counts are summed over the validators of the epoch; what each validator did
+// is kept in its ValidatorEpochSummary.
+type EpochSummary struct {
+	Epoch EpochInfo `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch"`
+	// ended_height and ended_time are of the block that started the next epoch
+	EndedHeight          uint64                `protobuf:"varint,2,opt,name=ended_height,json=endedHeight,proto3" json:"ended_height,omitempty"`
+	EndedTime            int64                 `protobuf:"varint,3,opt,name=ended_time,json=endedTime,proto3" json:"ended_time,omitempty"`
+	SubmittedRecords     uint64                `protobuf:"varint,4,opt,name=submitted_records,json=submittedRecords,proto3" json:"submitted_records,omitempty"`
+	VerifiedRecords      uint64                `protobuf:"varint,5,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	RejectedRecords      uint64                `protobuf:"varint,6,opt,name=rejected_records,json=rejectedRecords,proto3" json:"rejected_records,omitempty"`
+	ExpiredRecords       uint64                `protobuf:"varint,7,opt,name=expired_records,json=expiredRecords,proto3" json:"expired_records,omitempty"`
+	EligibleValidators   uint64                `protobuf:"varint,8,opt,name=eligible_validators,json=eligibleValidators,proto3" json:"eligible_validators,omitempty"`
+	IneligibleValidators uint64                `protobuf:"varint,9,opt,name=ineligible_validators,json=ineligibleValidators,proto3" json:"ineligible_validators,omitempty"`
+	Slashes              uint64                `protobuf:"varint,10,opt,name=slashes,proto3" json:"slashes,omitempty"`
+	SlashedAmount        cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	// rewards_paid is the amount of the reward pool paid out as the epoch ended
+	RewardsPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=rewards_paid,json=rewardsPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_paid"`
+}
+
+func (m *EpochSummary) Reset()         { *m = EpochSummary{} }
+func (m *EpochSummary) String() string { return proto.CompactTextString(m) }
+func (*EpochSummary) ProtoMessage()    {}
+func (*EpochSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{1}
+}
+func (m *EpochSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSummary.Merge(m, src)
+}
+func (m *EpochSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSummary proto.InternalMessageInfo
+
+func (m *EpochSummary) GetEpoch() EpochInfo {
+	if m != nil {
+		return m.Epoch
+	}
+	return EpochInfo{}
+}
+
+func (m *EpochSummary) GetEndedHeight() uint64 {
+	if m != nil {
+		return m.EndedHeight
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetEndedTime() int64 {
+	if m != nil {
+		return m.EndedTime
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetSubmittedRecords() uint64 {
+	if m != nil {
+		return m.SubmittedRecords
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetVerifiedRecords() uint64 {
+	if m != nil {
+		return m.VerifiedRecords
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetRejectedRecords() uint64 {
+	if m != nil {
+		return m.RejectedRecords
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetExpiredRecords() uint64 {
+	if m != nil {
+		return m.ExpiredRecords
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetEligibleValidators() uint64 {
+	if m != nil {
+		return m.EligibleValidators
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetIneligibleValidators() uint64 {
+	if m != nil {
+		return m.IneligibleValidators
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetSlashes() uint64 {
+	if m != nil {
+		return m.Slashes
+	}
+	return 0
+}
+
+func (m *EpochSummary) GetRewardsPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardsPaid
+	}
+	return nil
+}
+
+// ValidatorEpochSummary is what a validator did in an epoch. Records count in
+// the epoch they were submitted, verified, rejected or expired in.
+type ValidatorEpochSummary struct {
+	Epoch            uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SubmittedRecords uint64 `protobuf:"varint,3,opt,name=submitted_records,json=submittedRecords,proto3" json:"submitted_records,omitempty"`
+	VerifiedRecords  uint64 `protobuf:"varint,4,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	RejectedRecords  uint64 `protobuf:"varint,5,opt,name=rejected_records,json=rejectedRecords,proto3" json:"rejected_records,omitempty"`
+	ExpiredRecords   uint64 `protobuf:"varint,6,opt,name=expired_records,json=expiredRecords,proto3" json:"expired_records,omitempty"`
+	// eligibility is the outcome of the eligibility check as the epoch ended
+	Eligibility EligibilityOutcome `protobuf:"varint,7,opt,name=eligibility,proto3,enum=pos.pos.v1.EligibilityOutcome" json:"eligibility,omitempty"`
+	// slashes lists the slashes applied to the validator in the epoch
+	Slashes []EpochSlash `protobuf:"bytes,8,rep,name=slashes,proto3" json:"slashes"`
+	// missed_epochs is the number of consecutive epochs the validator had
+	// missed as the epoch ended, including it
+	MissedEpochs uint64 `protobuf:"varint,9,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+	// penalty is the step of the missed epoch penalty schedule applied as the
+	// epoch ended
+	Penalty PenaltyAction `protobuf:"varint,10,opt,name=penalty,proto3,enum=pos.pos.v1.PenaltyAction" json:"penalty,omitempty"`
+}
+
+func (m *ValidatorEpochSummary) Reset()         { *m = ValidatorEpochSummary{} }
+func (m *ValidatorEpochSummary) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochSummary) ProtoMessage()    {}
+func (*ValidatorEpochSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{2}
+}
+func (m *ValidatorEpochSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochSummary.Merge(m, src)
+}
+func (m *ValidatorEpochSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochSummary proto.InternalMessageInfo
+
+func (m *ValidatorEpochSummary) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorEpochSummary) GetSubmittedRecords() uint64 {
+	if m != nil {
+		return m.SubmittedRecords
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetVerifiedRecords() uint64 {
+	if m != nil {
+		return m.VerifiedRecords
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetRejectedRecords() uint64 {
+	if m != nil {
+		return m.RejectedRecords
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetExpiredRecords() uint64 {
+	if m != nil {
+		return m.ExpiredRecords
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetEligibility() EligibilityOutcome {
+	if m != nil {
+		return m.Eligibility
+	}
+	return EligibilityNotChecked
+}
+
+func (m *ValidatorEpochSummary) GetSlashes() []EpochSlash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *ValidatorEpochSummary) GetMissedEpochs() uint64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
+func (m *ValidatorEpochSummary) GetPenalty() PenaltyAction {
+	if m != nil {
+		return m.Penalty
+	}
+	return PenaltyNone
+}
+
+// EpochSlash is a slash applied to a validator in an epoch
+type EpochSlash struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// record_id is the record the slash relates to, if any
+	RecordId string                      `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the amount of tokens burned
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockHeight uint64                `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EpochSlash) Reset()         { *m = EpochSlash{} }
+func (m *EpochSlash) String() string { return proto.CompactTextString(m) }
+func (*EpochSlash) ProtoMessage()    {}
+func (*EpochSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{3}
+}
+func (m *EpochSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSlash.Merge(m, src)
+}
+func (m *EpochSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSlash proto.InternalMessageInfo
+
+func (m *EpochSlash) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EpochSlash) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *EpochSlash) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// MissedEpochPenalty is a step of the penalty schedule for validators that
+// miss their record requirement in consecutive epochs. The step applies once a
+// validator has missed missed_epochs epochs in a row and keeps applying to
+// every further missed epoch until a later step takes over.
+type MissedEpochPenalty struct {
+	MissedEpochs uint64        `protobuf:"varint,1,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+	Action       PenaltyAction `protobuf:"varint,2,opt,name=action,proto3,enum=pos.pos.v1.PenaltyAction" json:"action,omitempty"`
+	// slash_fraction is slashed by slash steps, and on top of the jail by jail
+	// and tombstone steps when positive
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// jail_duration_seconds is how long jail steps jail the validator for, in
+	// seconds of block time as x/slashing measures jail time
+	JailDurationSeconds uint64 `protobuf:"varint,4,opt,name=jail_duration_seconds,json=jailDurationSeconds,proto3" json:"jail_duration_seconds,omitempty"`
+}
+
+func (m *MissedEpochPenalty) Reset()         { *m = MissedEpochPenalty{} }
+func (m *MissedEpochPenalty) String() string { return proto.CompactTextString(m) }
+func (*MissedEpochPenalty) ProtoMessage()    {}
+func (*MissedEpochPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_942c81bd7042ad73, []int{4}
+}
+func (m *MissedEpochPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedEpochPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedEpochPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedEpochPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedEpochPenalty.Merge(m, src)
+}
+func (m *MissedEpochPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedEpochPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedEpochPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedEpochPenalty proto.InternalMessageInfo
+
+func (m *MissedEpochPenalty) GetMissedEpochs() uint64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
+func (m *MissedEpochPenalty) GetAction() PenaltyAction {
+	if m != nil {
+		return m.Action
+	}
+	return PenaltyNone
+}
+
+func (m *MissedEpochPenalty) GetJailDurationSeconds() uint64 {
+	if m != nil {
+		return m.JailDurationSeconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("pos.pos.v1.EpochMode", EpochMode_name, EpochMode_value)
+	proto.RegisterEnum("pos.pos.v1.EligibilityOutcome", EligibilityOutcome_name, EligibilityOutcome_value)
+	proto.RegisterEnum("pos.pos.v1.PenaltyAction", PenaltyAction_name, PenaltyAction_value)
+	proto.RegisterType((*EpochInfo)(nil), "pos.pos.v1.EpochInfo")
+	proto.RegisterType((*EpochSummary)(nil), "pos.pos.v1.EpochSummary")
+	proto.RegisterType((*ValidatorEpochSummary)(nil), "pos.pos.v1.ValidatorEpochSummary")
+	proto.RegisterType((*EpochSlash)(nil), "pos.pos.v1.EpochSlash")
+	proto.RegisterType((*MissedEpochPenalty)(nil), "pos.pos.v1.MissedEpochPenalty")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/epoch.proto", fileDescriptor_942c81bd7042ad73) }
+
+var fileDescriptor_942c81bd7042ad73 = []byte{
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x5b, 0x27, 0x1e, 0xe7, 0x87, 0x33, 0x49, 0x8a, 0xe3, 0x52, 0x67, 0x5b, 0x10,
+	0xb8, 0xa9, 0xea, 0x95, 0x53, 0x81, 0x04, 0x12, 0x15, 0xb6, 0x63, 0xc8, 0xb6, 0xfe, 0x11, 0xad,
+	0x0d, 0xa5, 0x5c, 0x56, 0xeb, 0xdd, 0xa9, 0x3d, 0x8d, 0x77, 0xc7, 0xda, 0x59, 0x87, 0xe6, 0x86,
+	0xc4, 0x05, 0xf9, 0xc4, 0x3f, 0x60, 0x09, 0x89, 0x0b, 0xe2, 0xc4, 0xa1, 0x7f, 0x44, 0x8f, 0x55,
+	0xc5, 0x01, 0x71, 0x28, 0xa8, 0x39, 0xc0, 0x9d, 0x3b, 0x42, 0x3b, 0x33, 0xbb, 0xde, 0xc4, 0x96,
+	0x68, 0xc5, 0xc1, 0xc9, 0xce, 0xf7, 0xbe, 0xf7, 0xde, 0xbe, 0x37, 0xdf, 0x9b, 0x59, 0x70, 0x79,
+	0x48, 0xa8, 0x1a, 0xfc, 0x4e, 0x4a, 0x2a, 0x1a, 0x12, 0xab, 0x5f, 0x1c, 0x7a, 0xc4, 0x27, 0x10,
+	0x0c, 0x09, 0x2d, 0x06, 0xbf, 0x93, 0x52, 0x6e, 0xc3, 0x74, 0xb0, 0x4b, 0x54, 0xf6, 0x97, 0x9b,
+	0x73, 0x79, 0x8b, 0x50, 0x87, 0x50, 0xb5, 0x6b, 0x52, 0xa4, 0x9e, 0x94, 0xba, 0xc8, 0x37, 0x4b,
+	0xaa, 0x45, 0xb0, 0x2b, 0xec, 0x3b, 0xdc, 0x6e, 0xb0, 0x95, 0xca, 0x17, 0xc2, 0xb4, 0xd5, 0x23,
+	0x3d, 0xc2, 0xf1, 0xe0, 0x89, 0xa3, 0xd7, 0x9f, 0x49, 0x20, 0x55, 0x0b, 0xf2, 0x6b, 0xee, 0x43,
+	0x02, 0x2f, 0x83, 0xa4, 0x3b, 0x72, 0xba, 0xc8, 0xcb, 0x4a, 0x8a, 0x54, 0x90, 0x75, 0xb1, 0x82,
+	0xd7, 0xc0, 0x0a, 0xf5, 0x4d, 0xcf, 0x37, 0xfa, 0x08, 0xf7, 0xfa, 0x7e, 0x76, 0x81, 0x59, 0xd3,
+	0x0c, 0x3b, 0x64, 0x10, 0xbc, 0x0a, 0x00, 0xa7, 0xf8, 0xd8, 0x41, 0xd9, 0x45, 0x45, 0x2a, 0x2c,
+	0xea, 0x29, 0x86, 0x74, 0xb0, 0x83, 0xe0, 0x0d, 0x20, 0x3b, 0xc4, 0x46, 0x59, 0x59, 0x91, 0x0a,
+	0x6b, 0xfb, 0xdb, 0xc5, 0x69, 0x99, 0x45, 0x96, 0xbe, 0x41, 0x6c, 0xa4, 0x33, 0x4a, 0x10, 0x09,
+	0xb9, 0x76, 0x98, 0xea, 0x12, 0x4b, 0x95, 0x42, 0xae, 0x2d, 0x12, 0xed, 0x80, 0xe5, 0xc0, 0xcc,
+	0xd2, 0x24, 0x59, 0x9a, 0x25, 0xe4, 0xda, 0x41, 0x92, 0x0f, 0xe5, 0xbf, 0xbe, 0xdf, 0x95, 0xae,
+	0xff, 0x23, 0x83, 0x15, 0x16, 0xb3, 0x3d, 0x72, 0x1c, 0xd3, 0x3b, 0x85, 0x25, 0x70, 0x89, 0xb5,
+	0x98, 0x15, 0x95, 0x9e, 0x93, 0x3c, 0xa8, 0xbd, 0x22, 0x3f, 0x7d, 0xb1, 0x9b, 0xd0, 0x39, 0x33,
+	0x28, 0x18, 0xb9, 0x36, 0xb2, 0x2f, 0x14, 0xcc, 0xb0, 0x69, 0xc1, 0x9c, 0x12, 0x2f, 0x98, 0x21,
+	0xac, 0xe0, 0x9b, 0x60, 0x83, 0x8e, 0xba, 0x0e, 0xf6, 0x7d, 0x64, 0x1b, 0x1e, 0xb2, 0x88, 0x67,
+	0x53, 0x56, 0xbd, 0xac, 0x67, 0x22, 0x83, 0xce, 0x71, 0x78, 0x03, 0x64, 0x4e, 0x90, 0x87, 0x1f,
+	0xe2, 0x18, 0x97, 0x17, 0xbe, 0x1e, 0xe2, 0x31, 0xaa, 0x87, 0x1e, 0x21, 0x2b, 0x1e, 0x36, 0xc9,
+	0xa9, 0x21, 0x1e, 0x52, 0xdf, 0x05, 0xeb, 0xe8, 0xf1, 0x10, 0x7b, 0x31, 0xe6, 0x12, 0x63, 0xae,
+	0x09, 0x38, 0x24, 0xaa, 0x60, 0x13, 0x0d, 0x70, 0x0f, 0x77, 0x07, 0xc8, 0x38, 0x31, 0x07, 0xd8,
+	0x36, 0x7d, 0xe2, 0xd1, 0xec, 0x32, 0x23, 0xc3, 0xd0, 0xf4, 0x79, 0x64, 0x81, 0xb7, 0xc1, 0x36,
+	0x76, 0xe7, 0xb9, 0xa4, 0x98, 0xcb, 0xd6, 0xd4, 0x18, 0x73, 0xca, 0x82, 0x25, 0x3a, 0x30, 0x69,
+	0x1f, 0xd1, 0x2c, 0x60, 0xb4, 0x70, 0x09, 0x75, 0xb0, 0xc6, 0x1f, 0x6d, 0xc3, 0x74, 0xc8, 0xc8,
+	0xf5, 0xb3, 0x69, 0x45, 0x2a, 0xa4, 0x2a, 0x37, 0x83, 0x2d, 0xf9, 0xed, 0xc5, 0xee, 0x36, 0x17,
+	0x32, 0xb5, 0x8f, 0x8b, 0x98, 0xa8, 0x8e, 0xe9, 0xf7, 0x8b, 0x9a, 0xeb, 0x3f, 0x7f, 0x72, 0x0b,
+	0x08, 0x85, 0x6b, 0xae, 0xaf, 0xaf, 0x8a, 0x10, 0x65, 0x16, 0x01, 0x52, 0xb0, 0xe2, 0xa1, 0xaf,
+	0x4c, 0xcf, 0xa6, 0xc6, 0xd0, 0xc4, 0x76, 0x76, 0x45, 0x59, 0x2c, 0xa4, 0xf7, 0x77, 0x8a, 0xc2,
+	0x23, 0x18, 0xa0, 0xa2, 0x18, 0xa0, 0x62, 0x95, 0x60, 0xb7, 0xf2, 0x5e, 0x90, 0xec, 0xa7, 0xdf,
+	0x77, 0x0b, 0x3d, 0xec, 0xf7, 0x47, 0xdd, 0xa2, 0x45, 0x1c, 0x31, 0x40, 0xe2, 0xdf, 0x2d, 0x6a,
+	0x1f, 0xab, 0xfe, 0xe9, 0x10, 0x51, 0xe6, 0x40, 0x7f, 0xfc, 0xf3, 0xe7, 0x3d, 0x49, 0x4f, 0x8b,
+	0x2c, 0x47, 0x26, 0xb6, 0x85, 0x00, 0xbf, 0x91, 0xc1, 0x76, 0x54, 0xf7, 0x39, 0x25, 0x6e, 0xc5,
+	0x95, 0x28, 0x87, 0x62, 0x6b, 0x82, 0x8d, 0xa8, 0x85, 0x86, 0x69, 0xdb, 0x1e, 0xa2, 0x94, 0x29,
+	0x2e, 0x55, 0xb9, 0xf6, 0xfc, 0xc9, 0xad, 0xab, 0xe2, 0x95, 0xa3, 0x90, 0x65, 0x4e, 0x69, 0xfb,
+	0x1e, 0x76, 0x7b, 0x7a, 0xe6, 0xe4, 0x02, 0x3e, 0x5f, 0x7a, 0x8b, 0xaf, 0x21, 0x3d, 0xf9, 0xd5,
+	0xa5, 0x77, 0xe9, 0x95, 0xa5, 0x97, 0x9c, 0x2b, 0xbd, 0x8f, 0x41, 0x9a, 0x4b, 0x05, 0x0f, 0xb0,
+	0x7f, 0xca, 0xf4, 0xb9, 0xb6, 0x9f, 0x3f, 0x37, 0xa1, 0x53, 0x73, 0x6b, 0xe4, 0x5b, 0xc4, 0x41,
+	0x7a, 0xdc, 0x05, 0xbe, 0x3f, 0x95, 0xd5, 0x32, 0xdb, 0xe3, 0xcb, 0x33, 0xf3, 0xdd, 0x0e, 0xec,
+	0x62, 0xc0, 0x23, 0xd1, 0xbd, 0x05, 0x56, 0x1d, 0x4c, 0x29, 0xb2, 0x0d, 0xb6, 0x0b, 0xa1, 0x76,
+	0x57, 0x38, 0xc8, 0xfc, 0x02, 0xa1, 0x2f, 0x0d, 0x91, 0x6b, 0x0e, 0xfc, 0x53, 0xa6, 0xd9, 0xb5,
+	0xfd, 0x9d, 0x78, 0xf0, 0x23, 0x6e, 0x2a, 0x5b, 0x3e, 0x26, 0xae, 0x1e, 0x32, 0xc3, 0x63, 0x48,
+	0x02, 0x60, 0x9a, 0x3d, 0x38, 0x5a, 0x3d, 0x64, 0x52, 0xe2, 0xb2, 0xbd, 0x4f, 0xe9, 0x62, 0x05,
+	0xaf, 0x80, 0x14, 0xef, 0x90, 0x81, 0x6d, 0xbe, 0xe9, 0xfa, 0x32, 0x07, 0x34, 0x1b, 0x36, 0xc0,
+	0xf2, 0x43, 0xcf, 0x64, 0xe1, 0xd9, 0x06, 0xa6, 0x2a, 0x25, 0x31, 0x12, 0x57, 0x66, 0x47, 0xa2,
+	0x8e, 0x7a, 0xa6, 0x75, 0x7a, 0x80, 0xac, 0xd8, 0x60, 0x1c, 0x20, 0x4b, 0x8f, 0x42, 0xc0, 0x2a,
+	0x48, 0x8a, 0xf9, 0x92, 0x5f, 0x7f, 0xbe, 0x84, 0x6b, 0x70, 0x34, 0x76, 0x07, 0xc4, 0x3a, 0x3e,
+	0x7f, 0x40, 0xa7, 0x19, 0xc6, 0x8f, 0x46, 0xd1, 0x80, 0xaf, 0x17, 0x00, 0x6c, 0x4c, 0x9b, 0x29,
+	0x9a, 0x35, 0xdb, 0x77, 0x69, 0x4e, 0xdf, 0x4b, 0x20, 0x29, 0xca, 0x5e, 0xf8, 0xaf, 0xb6, 0x0b,
+	0x22, 0xfc, 0x42, 0x1c, 0x22, 0xc6, 0xff, 0xef, 0x18, 0x3f, 0x4a, 0x3e, 0x09, 0xdb, 0xb6, 0x0f,
+	0xb6, 0x1f, 0x99, 0x78, 0x60, 0xd8, 0x23, 0xcf, 0x0c, 0x00, 0x83, 0x22, 0x8b, 0xb8, 0xd1, 0x9c,
+	0x6c, 0x06, 0xc6, 0x03, 0x61, 0x6b, 0x73, 0x13, 0x6f, 0xc1, 0x1e, 0x12, 0x97, 0x6b, 0x70, 0xbb,
+	0xc1, 0x3d, 0xb0, 0x51, 0x3b, 0x6a, 0x55, 0x0f, 0x8d, 0x46, 0xeb, 0xa0, 0x66, 0x54, 0xea, 0xad,
+	0xea, 0xbd, 0x76, 0x26, 0x91, 0xdb, 0x1c, 0x4f, 0x94, 0xf5, 0x88, 0x55, 0x09, 0xda, 0x48, 0xe1,
+	0x3b, 0x60, 0x3d, 0xc6, 0xed, 0x68, 0x8d, 0x5a, 0x46, 0xca, 0x6d, 0x8c, 0x27, 0xca, 0x6a, 0xc4,
+	0x0c, 0x6e, 0x99, 0x9c, 0xfc, 0xed, 0x0f, 0xf9, 0xc4, 0xde, 0x2f, 0x12, 0x80, 0xb3, 0x63, 0x02,
+	0xef, 0x80, 0xdd, 0x5a, 0x5d, 0xfb, 0x54, 0xab, 0x68, 0x75, 0xad, 0xf3, 0xc0, 0x68, 0x7d, 0xd6,
+	0xa9, 0xb6, 0x1a, 0x35, 0xa3, 0xd9, 0xea, 0x18, 0xd5, 0xc3, 0x5a, 0xf5, 0x5e, 0xed, 0x20, 0x93,
+	0xc8, 0xed, 0x8c, 0x27, 0xca, 0x76, 0xcc, 0xb9, 0x49, 0xfc, 0x6a, 0x1f, 0x59, 0xc7, 0xc8, 0x86,
+	0x1f, 0x80, 0x37, 0xe7, 0xf9, 0x73, 0xac, 0x1e, 0xbc, 0xd1, 0x1b, 0xe3, 0x89, 0xb2, 0x19, 0x73,
+	0xae, 0x89, 0x53, 0x1f, 0x7e, 0x04, 0xf2, 0xf3, 0x5c, 0xb5, 0x66, 0xe4, 0xbc, 0x30, 0x93, 0x59,
+	0x8b, 0x2e, 0x0d, 0x51, 0xd6, 0xdf, 0x12, 0x58, 0x3d, 0xb7, 0xd7, 0xb0, 0x00, 0x36, 0x8f, 0x6a,
+	0xcd, 0x72, 0xbd, 0xf3, 0xc0, 0x28, 0x57, 0x3b, 0x5a, 0xab, 0x69, 0x34, 0x5b, 0xcd, 0x5a, 0x26,
+	0x91, 0x5b, 0x1f, 0x4f, 0x94, 0xb4, 0xe0, 0x36, 0x89, 0x8b, 0xe6, 0x30, 0xef, 0x97, 0xf5, 0x66,
+	0x46, 0x3a, 0xc7, 0xbc, 0x6f, 0x7a, 0x2e, 0xdc, 0x03, 0x5b, 0x17, 0x98, 0xed, 0x7a, 0xb9, 0x7d,
+	0x98, 0x59, 0xc8, 0x65, 0xc6, 0x13, 0x65, 0x45, 0x50, 0xf9, 0x10, 0xcf, 0x46, 0xbd, 0x5b, 0xd6,
+	0xea, 0x99, 0xc5, 0x73, 0x51, 0xef, 0x9a, 0x78, 0x00, 0xf7, 0x41, 0xf6, 0x02, 0xb3, 0xd3, 0x6a,
+	0x54, 0xda, 0x9d, 0xe0, 0x75, 0xe5, 0xdc, 0xd6, 0x78, 0xa2, 0x64, 0x04, 0xbd, 0x43, 0x9c, 0x2e,
+	0xf5, 0x89, 0x2b, 0xaa, 0xae, 0xdc, 0x79, 0xfa, 0x32, 0x2f, 0x3d, 0x7b, 0x99, 0x97, 0xfe, 0x78,
+	0x99, 0x97, 0xbe, 0x3b, 0xcb, 0x27, 0x9e, 0x9d, 0xe5, 0x13, 0xbf, 0x9e, 0xe5, 0x13, 0x5f, 0xbe,
+	0x1d, 0xbb, 0x99, 0x9a, 0x88, 0x38, 0x6d, 0xe4, 0x52, 0xa4, 0x1e, 0x91, 0xb6, 0xfa, 0x98, 0x7d,
+	0x4a, 0xb2, 0xbb, 0xa9, 0x9b, 0x64, 0x1f, 0x76, 0xb7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x86,
+	0x4e, 0x28, 0xc2, 0x62, 0x0a, 0x00, 0x00,
+}
+
+func (this *EpochInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochInfo)
+	if !ok {
+		that2, ok := that.(EpochInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Number != that1.Number {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if this.EndTime != that1.EndTime {
+		return false
+	}
+	return true
+}
+func (this *EpochSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochSummary)
+	if !ok {
+		that2, ok := that.(EpochSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Epoch.Equal(&that1.Epoch) {
+		return false
+	}
+	if this.EndedHeight != that1.EndedHeight {
+		return false
+	}
+	if this.EndedTime != that1.EndedTime {
+		return false
+	}
+	if this.SubmittedRecords != that1.SubmittedRecords {
+		return false
+	}
+	if this.VerifiedRecords != that1.VerifiedRecords {
+		return false
+	}
+	if this.RejectedRecords != that1.RejectedRecords {
+		return false
+	}
+	if this.ExpiredRecords != that1.ExpiredRecords {
+		return false
+	}
+	if this.EligibleValidators != that1.EligibleValidators {
+		return false
+	}
+	if this.IneligibleValidators != that1.IneligibleValidators {
+		return false
+	}
+	if this.Slashes != that1.Slashes {
+		return false
+	}
+	if !this.SlashedAmount.Equal(that1.SlashedAmount) {
+		return false
+	}
+	if len(this.RewardsPaid) != len(that1.RewardsPaid) {
+		return false
+	}
+	for i := range this.RewardsPaid {
+		if !this.RewardsPaid[i].Equal(&that1.RewardsPaid[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorEpochSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorEpochSummary)
+	if !ok {
+		that2, ok := that.(ValidatorEpochSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.SubmittedRecords != that1.SubmittedRecords {
+		return false
+	}
+	if this.VerifiedRecords != that1.VerifiedRecords {
+		return false
+	}
+	if this.RejectedRecords != that1.RejectedRecords {
+		return false
+	}
+	if this.ExpiredRecords != that1.ExpiredRecords {
+		return false
+	}
+	if this.Eligibility != that1.Eligibility {
+		return false
+	}
+	if len(this.Slashes) != len(that1.Slashes) {
+		return false
+	}
+	for i := range this.Slashes {
+		if !this.Slashes[i].Equal(&that1.Slashes[i]) {
+			return false
+		}
+	}
+	if this.MissedEpochs != that1.MissedEpochs {
+		return false
+	}
+	if this.Penalty != that1.Penalty {
+		return false
+	}
+	return true
+}
+func (this *EpochSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochSlash)
+	if !ok {
+		that2, ok := that.(EpochSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	return true
+}
+func (this *MissedEpochPenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MissedEpochPenalty)
+	if !ok {
+		that2, ok := that.(MissedEpochPenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MissedEpochs != that1.MissedEpochs {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDurationSeconds != that1.JailDurationSeconds {
+		return false
+	}
+	return true
+}
+func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mode != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPaid) > 0 {
+		for iNdEx := len(m.RewardsPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEpoch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Slashes != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Slashes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IneligibleValidators != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.IneligibleValidators))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EligibleValidators != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EligibleValidators))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiredRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.ExpiredRecords))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RejectedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.RejectedRecords))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VerifiedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.VerifiedRecords))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmittedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.SubmittedRecords))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndedTime != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EndedTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndedHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.EndedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Epoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Penalty != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Penalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEpoch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Eligibility != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Eligibility))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiredRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.ExpiredRecords))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RejectedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.RejectedRecords))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifiedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.VerifiedRecords))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SubmittedRecords != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.SubmittedRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEpoch(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedEpochPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedEpochPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedEpochPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailDurationSeconds != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.JailDurationSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEpoch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEpoch(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpoch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpoch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovEpoch(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.StartHeight))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEpoch(uint64(m.StartTime))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovEpoch(uint64(m.Mode))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.EndHeight))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEpoch(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *EpochSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	if m.EndedHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.EndedHeight))
+	}
+	if m.EndedTime != 0 {
+		n += 1 + sovEpoch(uint64(m.EndedTime))
+	}
+	if m.SubmittedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.SubmittedRecords))
+	}
+	if m.VerifiedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.VerifiedRecords))
+	}
+	if m.RejectedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.RejectedRecords))
+	}
+	if m.ExpiredRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.ExpiredRecords))
+	}
+	if m.EligibleValidators != 0 {
+		n += 1 + sovEpoch(uint64(m.EligibleValidators))
+	}
+	if m.IneligibleValidators != 0 {
+		n += 1 + sovEpoch(uint64(m.IneligibleValidators))
+	}
+	if m.Slashes != 0 {
+		n += 1 + sovEpoch(uint64(m.Slashes))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	if len(m.RewardsPaid) > 0 {
+		for _, e := range m.RewardsPaid {
+			l = e.Size()
+			n += 1 + l + sovEpoch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorEpochSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEpoch(uint64(m.Epoch))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
+	if m.SubmittedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.SubmittedRecords))
+	}
+	if m.VerifiedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.VerifiedRecords))
+	}
+	if m.RejectedRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.RejectedRecords))
+	}
+	if m.ExpiredRecords != 0 {
+		n += 1 + sovEpoch(uint64(m.ExpiredRecords))
+	}
+	if m.Eligibility != 0 {
+		n += 1 + sovEpoch(uint64(m.Eligibility))
+	}
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovEpoch(uint64(l))
+		}
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEpoch(uint64(m.MissedEpochs))
+	}
+	if m.Penalty != 0 {
+		n += 1 + sovEpoch(uint64(m.Penalty))
+	}
+	return n
+}
+
+func (m *EpochSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovEpoch(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEpoch(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *MissedEpochPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEpoch(uint64(m.MissedEpochs))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEpoch(uint64(m.Action))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEpoch(uint64(l))
+	if m.JailDurationSeconds != 0 {
+		n += 1 + sovEpoch(uint64(m.JailDurationSeconds))
+	}
+	return n
+}
+
+func sovEpoch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpoch(x uint64) (n int) {
+	return sovEpoch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= EpochMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedHeight", wireType)
+			}
+			m.EndedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedTime", wireType)
+			}
+			m.EndedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedRecords", wireType)
+			}
+			m.SubmittedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecords", wireType)
+			}
+			m.VerifiedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRecords", wireType)
+			}
+			m.RejectedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredRecords", wireType)
+			}
+			m.ExpiredRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleValidators", wireType)
+			}
+			m.EligibleValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IneligibleValidators", wireType)
+			}
+			m.IneligibleValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IneligibleValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			m.Slashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPaid = append(m.RewardsPaid, types.Coin{})
+			if err := m.RewardsPaid[len(m.RewardsPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedRecords", wireType)
+			}
+			m.SubmittedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecords", wireType)
+			}
+			m.VerifiedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRecords", wireType)
+			}
+			m.RejectedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredRecords", wireType)
+			}
+			m.ExpiredRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			m.Eligibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eligibility |= EligibilityOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, EpochSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			m.Penalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Penalty |= PenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedEpochPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedEpochPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedEpochPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationSeconds", wireType)
+			}
+			m.JailDurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpoch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpoch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpoch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpoch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpoch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpoch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpoch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpoch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -20,4 +20,40 @@ var (
 	ErrDuplicateRecord        = errors.Register(ModuleName, 1109, "duplicate record submission")
 	ErrEpochRecordsExceeded   = errors.Register(ModuleName, 1110, "epoch record limit exceeded")
 	ErrValidatorStatsNotFound = errors.Register(ModuleName, 1111, "validator stats not found")
+	ErrRecordVoteNotFound     = errors.Register(ModuleName, 1112, "record vote not found")
+	ErrSelfVerification       = errors.Register(ModuleName, 1113, "validator cannot verify its own record")
+	ErrDuplicateVote          = errors.Register(ModuleName, 1114, "verifier has already voted on record")
+	ErrConflictOfInterest     = errors.Register(ModuleName, 1115, "verifier is related to the record submitter")
+	ErrCommitRevealRequired   = errors.Register(ModuleName, 1116, "votes must be cast through commit-reveal")
+	ErrCommitRevealDisabled   = errors.Register(ModuleName, 1117, "commit-reveal voting is disabled")
+	ErrCommitPhaseClosed      = errors.Register(ModuleName, 1118, "commit phase is closed")
+	ErrRevealPhaseNotOpen     = errors.Register(ModuleName, 1119, "reveal phase is not open")
+	ErrCommitNotFound         = errors.Register(ModuleName, 1120, "verification commit not found")
+	ErrCommitmentMismatch     = errors.Register(ModuleName, 1121, "reveal does not match commitment")
+	ErrInvalidDataLocator     = errors.Register(ModuleName, 1122, "invalid data locator")
+	ErrRecordDataOnChain      = errors.Register(ModuleName, 1123, "record data is stored on chain")
+	ErrSelfChallenge          = errors.Register(ModuleName, 1124, "validator cannot challenge its own record")
+	ErrChallengeOpen          = errors.Register(ModuleName, 1125, "record already has an open availability challenge")
+	ErrChallengeNotFound      = errors.Register(ModuleName, 1126, "availability challenge not found")
+	ErrChallengeClosed        = errors.Register(ModuleName, 1127, "availability challenge is closed")
+	ErrInvalidChallengeProof  = errors.Register(ModuleName, 1128, "invalid availability challenge response")
+	ErrNotOptimistic          = errors.Register(ModuleName, 1129, "record is not under optimistic verification")
+	ErrDisputeWindowClosed    = errors.Register(ModuleName, 1130, "dispute window is closed")
+	ErrRecordDisputed         = errors.Register(ModuleName, 1131, "record is already disputed")
+	ErrDisputeNotFound        = errors.Register(ModuleName, 1132, "record dispute not found")
+	ErrInvalidRecordType      = errors.Register(ModuleName, 1133, "invalid record type")
+	ErrRecordTypeNotFound     = errors.Register(ModuleName, 1134, "record type not found")
+	ErrSchemaValidation       = errors.Register(ModuleName, 1135, "record data does not match the record type schema")
+	ErrInvalidContentEncoding = errors.Register(ModuleName, 1136, "invalid record content encoding")
+	ErrUploadNotFound         = errors.Register(ModuleName, 1137, "record upload not found")
+	ErrInvalidUploadChunk     = errors.Register(ModuleName, 1138, "invalid record upload chunk")
+	ErrUploadIncomplete       = errors.Register(ModuleName, 1139, "record upload is incomplete")
+	ErrRecordNotAmendable     = errors.Register(ModuleName, 1140, "record cannot be amended")
+	ErrEpochSummaryNotFound   = errors.Register(ModuleName, 1141, "epoch summary not found")
+	ErrInvalidRewardDeposit   = errors.Register(ModuleName, 1142, "invalid reward pool deposit")
+	ErrNotJailedForRecords    = errors.Register(ModuleName, 1143, "validator is not jailed for missing records")
+	ErrUnjailNotAllowed       = errors.Register(ModuleName, 1144, "validator cannot unjail yet")
+	ErrRecordNotChallengeable = errors.Register(ModuleName, 1145, "record cannot be challenged")
+	ErrTooManyUploads         = errors.Register(ModuleName, 1146, "too many open record uploads")
+	ErrInvalidDisputeReason   = errors.Register(ModuleName, 1147, "invalid dispute reason")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
@@ -19,6 +20,10 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
@@ -26,16 +31,25 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error)
 	GetAllValidators(context.Context) ([]stakingtypes.Validator, error)
+	TotalBondedTokens(context.Context) (math.Int, error)
 	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
 	Slash(context.Context, sdk.ConsAddress, int64, int64, math.LegacyDec) (math.Int, error)
 	Jail(context.Context, sdk.ConsAddress) error
 	Unjail(context.Context, sdk.ConsAddress) error
+	GetDelegation(context.Context, sdk.AccAddress, sdk.ValAddress) (stakingtypes.Delegation, error)
 }
 
 // SlashingKeeper defines the expected interface for the Slashing module.
 type SlashingKeeper interface {
 	IsTombstoned(context.Context, sdk.ConsAddress) bool
-	JailUntil(context.Context, sdk.ConsAddress, int64) error
+	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
+	Tombstone(context.Context, sdk.ConsAddress) error
+}
+
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	GetDelegatorWithdrawAddr(context.Context, sdk.AccAddress) (sdk.AccAddress, error)
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,312 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.RecordTypes))
+	for _, rt := range gs.RecordTypes {
+		if seen[rt.Id] {
+			return fmt.Errorf("duplicate record type %s", rt.Id)
+		}
+		seen[rt.Id] = true
+
+		if err := rt.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateEpochHistory(gs.Epochs); err != nil {
+		return err
+	}
+
+	if err := gs.RewardPool.Balance.Validate(); err != nil {
+		return fmt.Errorf("invalid reward pool: %w", err)
+	}
+
+	records, err := gs.validateRecords()
+	if err != nil {
+		return err
+	}
+
+	if err := gs.validateRecordVotes(records); err != nil {
+		return err
+	}
+
+	if err := gs.validateVerificationCommits(records); err != nil {
+		return err
+	}
+
+	if err := gs.validateAvailabilityChallenges(records); err != nil {
+		return err
+	}
+
+	if err := gs.validateDisputes(records); err != nil {
+		return err
+	}
+
+	if err := gs.validateRecordUploads(); err != nil {
+		return err
+	}
+
+	if err := gs.validateEpochSummaries(); err != nil {
+		return err
+	}
+
+	if err := gs.validateRewardPayouts(); err != nil {
+		return err
+	}
+
+	if err := gs.validateRecordSigningInfos(); err != nil {
+		return err
+	}
+
+	if err := gs.validateSlashEvents(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
+
+// validateRecords validates the records, with the fees and bonds they escrow,
+// and the validator stats and returns the ids of the records
+func (gs GenesisState) validateRecords() (map[string]bool, error) {
+	records := make(map[string]bool, len(gs.Records))
+	for _, record := range gs.Records {
+		if records[record.Id] {
+			return nil, fmt.Errorf("duplicate record %s", record.Id)
+		}
+		records[record.Id] = true
+
+		if err := record.Fee.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid fee of record %s: %w", record.Id, err)
+		}
+		if err := record.Bond.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid bond of record %s: %w", record.Id, err)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.ValidatorRecordStats))
+	for _, stats := range gs.ValidatorRecordStats {
+		if validators[stats.ValidatorAddress] {
+			return nil, fmt.Errorf("duplicate stats of validator %s", stats.ValidatorAddress)
+		}
+		validators[stats.ValidatorAddress] = true
+	}
+
+	return records, nil
+}
+
+// validateRecordVotes validates the votes cast on the given records
+func (gs GenesisState) validateRecordVotes(records map[string]bool) error {
+	seen := make(map[string]bool, len(gs.RecordVotes))
+	for _, vote := range gs.RecordVotes {
+		if !records[vote.RecordId] {
+			return fmt.Errorf("vote on unknown record %s", vote.RecordId)
+		}
+
+		key := vote.RecordId + "/" + vote.Verifier
+		if seen[key] {
+			return fmt.Errorf("duplicate vote of %s on record %s", vote.Verifier, vote.RecordId)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateVerificationCommits validates the sealed votes on the given records
+func (gs GenesisState) validateVerificationCommits(records map[string]bool) error {
+	seen := make(map[string]bool, len(gs.VerificationCommits))
+	for _, commit := range gs.VerificationCommits {
+		if !records[commit.RecordId] {
+			return fmt.Errorf("commit on unknown record %s", commit.RecordId)
+		}
+
+		key := commit.RecordId + "/" + commit.Verifier
+		if seen[key] {
+			return fmt.Errorf("duplicate commit of %s on record %s", commit.Verifier, commit.RecordId)
+		}
+		seen[key] = true
+
+		if commit.RevealEndHeight < commit.RevealStartHeight {
+			return fmt.Errorf("commit of %s on record %s has its reveal phase end before it starts", commit.Verifier, commit.RecordId)
+		}
+	}
+	return nil
+}
+
+// validateAvailabilityChallenges validates the availability challenges of the
+// given records, with the bonds they escrow
+func (gs GenesisState) validateAvailabilityChallenges(records map[string]bool) error {
+	challenges := make(map[uint64]bool, len(gs.AvailabilityChallenges))
+	for _, challenge := range gs.AvailabilityChallenges {
+		if challenges[challenge.Id] {
+			return fmt.Errorf("duplicate availability challenge %d", challenge.Id)
+		}
+		challenges[challenge.Id] = true
+
+		if challenge.Id >= gs.NextAvailabilityChallengeId {
+			return fmt.Errorf("availability challenge %d is not below the next id %d", challenge.Id, gs.NextAvailabilityChallengeId)
+		}
+		if !records[challenge.RecordId] {
+			return fmt.Errorf("availability challenge %d of unknown record %s", challenge.Id, challenge.RecordId)
+		}
+		if err := challenge.Bond.Validate(); err != nil {
+			return fmt.Errorf("invalid bond of availability challenge %d: %w", challenge.Id, err)
+		}
+	}
+	return nil
+}
+
+// validateDisputes validates the disputes of the given records, with the bonds
+// they escrow
+func (gs GenesisState) validateDisputes(records map[string]bool) error {
+	disputed := make(map[string]bool, len(gs.Disputes))
+	for _, dispute := range gs.Disputes {
+		if disputed[dispute.RecordId] {
+			return fmt.Errorf("duplicate dispute of record %s", dispute.RecordId)
+		}
+		disputed[dispute.RecordId] = true
+
+		if !records[dispute.RecordId] {
+			return fmt.Errorf("dispute of unknown record %s", dispute.RecordId)
+		}
+		if err := dispute.Bond.Validate(); err != nil {
+			return fmt.Errorf("invalid bond of dispute of record %s: %w", dispute.RecordId, err)
+		}
+	}
+	return nil
+}
+
+// validateRecordUploads validates the open record uploads and their chunks
+func (gs GenesisState) validateRecordUploads() error {
+	uploads := make(map[uint64]bool, len(gs.RecordUploads))
+	for _, upload := range gs.RecordUploads {
+		if uploads[upload.Id] {
+			return fmt.Errorf("duplicate record upload %d", upload.Id)
+		}
+		uploads[upload.Id] = true
+
+		if upload.Id >= gs.NextRecordUploadId {
+			return fmt.Errorf("record upload %d is not below the next id %d", upload.Id, gs.NextRecordUploadId)
+		}
+	}
+
+	chunks := make(map[string]bool, len(gs.UploadChunks))
+	for _, chunk := range gs.UploadChunks {
+		if !uploads[chunk.UploadId] {
+			return fmt.Errorf("chunk %d of unknown record upload %d", chunk.Index, chunk.UploadId)
+		}
+
+		key := fmt.Sprintf("%d/%d", chunk.UploadId, chunk.Index)
+		if chunks[key] {
+			return fmt.Errorf("duplicate chunk %d of record upload %d", chunk.Index, chunk.UploadId)
+		}
+		chunks[key] = true
+	}
+	return nil
+}
+
+// validateEpochSummaries validates the summaries of the ended epochs and the
+// activity of the current epoch
+func (gs GenesisState) validateEpochSummaries() error {
+	// Without an epoch history the first epoch starts at genesis
+	current := uint64(0)
+	if n := len(gs.Epochs); n > 0 {
+		current = gs.Epochs[n-1].Number
+	}
+
+	summaries := make(map[uint64]bool, len(gs.EpochSummaries))
+	for _, summary := range gs.EpochSummaries {
+		if summaries[summary.Epoch.Number] {
+			return fmt.Errorf("duplicate summary of epoch %d", summary.Epoch.Number)
+		}
+		summaries[summary.Epoch.Number] = true
+
+		if summary.Epoch.Number >= current {
+			return fmt.Errorf("summary of epoch %d that has not ended", summary.Epoch.Number)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.ValidatorEpochSummaries))
+	for _, summary := range gs.ValidatorEpochSummaries {
+		key := fmt.Sprintf("%d/%s", summary.Epoch, summary.ValidatorAddress)
+		if validators[key] {
+			return fmt.Errorf("duplicate summary of validator %s in epoch %d", summary.ValidatorAddress, summary.Epoch)
+		}
+		validators[key] = true
+
+		if summary.Epoch >= current {
+			return fmt.Errorf("summary of validator %s in epoch %d that has not ended", summary.ValidatorAddress, summary.Epoch)
+		}
+	}
+
+	active := make(map[string]bool, len(gs.EpochActivity))
+	for _, activity := range gs.EpochActivity {
+		if active[activity.ValidatorAddress] {
+			return fmt.Errorf("duplicate epoch activity of validator %s", activity.ValidatorAddress)
+		}
+		active[activity.ValidatorAddress] = true
+
+		if activity.Epoch != current {
+			return fmt.Errorf("activity of validator %s in epoch %d is not of the current epoch %d", activity.ValidatorAddress, activity.Epoch, current)
+		}
+	}
+	return nil
+}
+
+// validateRewardPayouts validates the epoch reward payouts
+func (gs GenesisState) validateRewardPayouts() error {
+	seen := make(map[string]bool, len(gs.RewardPayouts))
+	for _, payout := range gs.RewardPayouts {
+		key := fmt.Sprintf("%d/%s", payout.Epoch, payout.ValidatorAddress)
+		if seen[key] {
+			return fmt.Errorf("duplicate reward payout of validator %s in epoch %d", payout.ValidatorAddress, payout.Epoch)
+		}
+		seen[key] = true
+
+		if err := payout.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid reward payout of validator %s in epoch %d: %w", payout.ValidatorAddress, payout.Epoch, err)
+		}
+	}
+	return nil
+}
+
+// validateRecordSigningInfos validates the record signing infos and the epochs
+// the validators missed in their signing windows
+func (gs GenesisState) validateRecordSigningInfos() error {
+	infos := make(map[string]bool, len(gs.RecordSigningInfos))
+	for _, info := range gs.RecordSigningInfos {
+		if infos[info.ValidatorAddress] {
+			return fmt.Errorf("duplicate record signing info of validator %s", info.ValidatorAddress)
+		}
+		infos[info.ValidatorAddress] = true
+	}
+
+	missed := make(map[string]bool, len(gs.RecordMissedEpochs))
+	for _, epoch := range gs.RecordMissedEpochs {
+		if !infos[epoch.ValidatorAddress] {
+			return fmt.Errorf("missed epoch %d of validator %s without signing info", epoch.Epoch, epoch.ValidatorAddress)
+		}
+
+		key := fmt.Sprintf("%s/%d", epoch.ValidatorAddress, epoch.Epoch)
+		if missed[key] {
+			return fmt.Errorf("duplicate missed epoch %d of validator %s", epoch.Epoch, epoch.ValidatorAddress)
+		}
+		missed[key] = true
+	}
+	return nil
+}
+
+// validateSlashEvents validates the slash history of the validators
+func (gs GenesisState) validateSlashEvents() error {
+	seen := make(map[uint64]bool, len(gs.SlashEvents))
+	for _, event := range gs.SlashEvents {
+		if seen[event.Id] {
+			return fmt.Errorf("duplicate slash event %d", event.Id)
+		}
+		seen[event.Id] = true
+
+		if event.Id >= gs.NextSlashEventId {
+			return fmt.Errorf("slash event %d is not below the next id %d", event.Id, gs.NextSlashEventId)
+		}
+	}
+	return nil
+}
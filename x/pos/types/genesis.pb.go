@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,46 @@ type GenesisState struct {
 	Records []Record `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
 	// validator_record_stats is the list of validator record statistics
 	ValidatorRecordStats []ValidatorRecordStats `protobuf:"bytes,3,rep,name=validator_record_stats,json=validatorRecordStats,proto3" json:"validator_record_stats"`
+	// record_types is the list of registered record types
+	RecordTypes []RecordType `protobuf:"bytes,4,rep,name=record_types,json=recordTypes,proto3" json:"record_types"`
+	// epochs is the epoch history, oldest first; the last epoch is the current one
+	Epochs []EpochInfo `protobuf:"bytes,5,rep,name=epochs,proto3" json:"epochs"`
+	// reward_pool is the part of the module account balance paid out as rewards
+	RewardPool RewardPool `protobuf:"bytes,6,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool"`
+	// record_votes is the list of votes cast on records
+	RecordVotes []RecordVote `protobuf:"bytes,7,rep,name=record_votes,json=recordVotes,proto3" json:"record_votes"`
+	// verification_commits is the list of sealed votes of open reveal phases
+	VerificationCommits []VerificationCommit `protobuf:"bytes,8,rep,name=verification_commits,json=verificationCommits,proto3" json:"verification_commits"`
+	// availability_challenges is the list of availability challenges
+	AvailabilityChallenges []AvailabilityChallenge `protobuf:"bytes,9,rep,name=availability_challenges,json=availabilityChallenges,proto3" json:"availability_challenges"`
+	// next_availability_challenge_id is the id of the next availability challenge
+	NextAvailabilityChallengeId uint64 `protobuf:"varint,10,opt,name=next_availability_challenge_id,json=nextAvailabilityChallengeId,proto3" json:"next_availability_challenge_id,omitempty"`
+	// disputes is the list of disputes filed against records
+	Disputes []RecordDispute `protobuf:"bytes,11,rep,name=disputes,proto3" json:"disputes"`
+	// record_uploads is the list of open record uploads
+	RecordUploads []RecordUpload `protobuf:"bytes,12,rep,name=record_uploads,json=recordUploads,proto3" json:"record_uploads"`
+	// upload_chunks is the list of chunks received by the open record uploads
+	UploadChunks []UploadChunk `protobuf:"bytes,13,rep,name=upload_chunks,json=uploadChunks,proto3" json:"upload_chunks"`
+	// next_record_upload_id is the id of the next record upload
+	NextRecordUploadId uint64 `protobuf:"varint,14,opt,name=next_record_upload_id,json=nextRecordUploadId,proto3" json:"next_record_upload_id,omitempty"`
+	// epoch_summaries is the list of summaries of the ended epochs
+	EpochSummaries []EpochSummary `protobuf:"bytes,15,rep,name=epoch_summaries,json=epochSummaries,proto3" json:"epoch_summaries"`
+	// validator_epoch_summaries is the list of validator summaries of the ended
+	// epochs
+	ValidatorEpochSummaries []ValidatorEpochSummary `protobuf:"bytes,16,rep,name=validator_epoch_summaries,json=validatorEpochSummaries,proto3" json:"validator_epoch_summaries"`
+	// epoch_activity is what the validators did so far in the current epoch
+	EpochActivity []ValidatorEpochSummary `protobuf:"bytes,17,rep,name=epoch_activity,json=epochActivity,proto3" json:"epoch_activity"`
+	// reward_payouts is the list of epoch reward payouts
+	RewardPayouts []RewardPayout `protobuf:"bytes,18,rep,name=reward_payouts,json=rewardPayouts,proto3" json:"reward_payouts"`
+	// record_signing_infos is the list of validator record signing infos
+	RecordSigningInfos []RecordSigningInfo `protobuf:"bytes,19,rep,name=record_signing_infos,json=recordSigningInfos,proto3" json:"record_signing_infos"`
+	// record_missed_epochs is the list of epochs in the signing windows the
+	// validators did not submit a record in
+	RecordMissedEpochs []RecordMissedEpoch `protobuf:"bytes,20,rep,name=record_missed_epochs,json=recordMissedEpochs,proto3" json:"record_missed_epochs"`
+	// slash_events is the slash history of the validators
+	SlashEvents []SlashEvent `protobuf:"bytes,21,rep,name=slash_events,json=slashEvents,proto3" json:"slash_events"`
+	// next_slash_event_id is the id of the next slash event
+	NextSlashEventId uint64 `protobuf:"varint,22,opt,name=next_slash_event_id,json=nextSlashEventId,proto3" json:"next_slash_event_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,32 +129,321 @@ func (m *GenesisState) GetValidatorRecordStats() []ValidatorRecordStats {
 	return nil
 }
 
+func (m *GenesisState) GetRecordTypes() []RecordType {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochs() []EpochInfo {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPool() RewardPool {
+	if m != nil {
+		return m.RewardPool
+	}
+	return RewardPool{}
+}
+
+func (m *GenesisState) GetRecordVotes() []RecordVote {
+	if m != nil {
+		return m.RecordVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerificationCommits() []VerificationCommit {
+	if m != nil {
+		return m.VerificationCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAvailabilityChallenges() []AvailabilityChallenge {
+	if m != nil {
+		return m.AvailabilityChallenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAvailabilityChallengeId() uint64 {
+	if m != nil {
+		return m.NextAvailabilityChallengeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDisputes() []RecordDispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordUploads() []RecordUpload {
+	if m != nil {
+		return m.RecordUploads
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUploadChunks() []UploadChunk {
+	if m != nil {
+		return m.UploadChunks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRecordUploadId() uint64 {
+	if m != nil {
+		return m.NextRecordUploadId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochSummaries() []EpochSummary {
+	if m != nil {
+		return m.EpochSummaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorEpochSummaries() []ValidatorEpochSummary {
+	if m != nil {
+		return m.ValidatorEpochSummaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochActivity() []ValidatorEpochSummary {
+	if m != nil {
+		return m.EpochActivity
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPayouts() []RewardPayout {
+	if m != nil {
+		return m.RewardPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordSigningInfos() []RecordSigningInfo {
+	if m != nil {
+		return m.RecordSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordMissedEpochs() []RecordMissedEpoch {
+	if m != nil {
+		return m.RecordMissedEpochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashEvents() []SlashEvent {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSlashEventId() uint64 {
+	if m != nil {
+		return m.NextSlashEventId
+	}
+	return 0
+}
+
+// UploadChunk is a chunk received by an open record upload
+type UploadChunk struct {
+	UploadId uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Index    uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *UploadChunk) Reset()         { *m = UploadChunk{} }
+func (m *UploadChunk) String() string { return proto.CompactTextString(m) }
+func (*UploadChunk) ProtoMessage()    {}
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c3be12094f45f99, []int{1}
+}
+func (m *UploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunk.Merge(m, src)
+}
+func (m *UploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunk proto.InternalMessageInfo
+
+func (m *UploadChunk) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// RecordMissedEpoch is an epoch in the signing window of a validator it did
+// not submit a record in
+type RecordMissedEpoch struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Epoch            uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *RecordMissedEpoch) Reset()         { *m = RecordMissedEpoch{} }
+func (m *RecordMissedEpoch) String() string { return proto.CompactTextString(m) }
+func (*RecordMissedEpoch) ProtoMessage()    {}
+func (*RecordMissedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c3be12094f45f99, []int{2}
+}
+func (m *RecordMissedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordMissedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordMissedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordMissedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordMissedEpoch.Merge(m, src)
+}
+func (m *RecordMissedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordMissedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordMissedEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordMissedEpoch proto.InternalMessageInfo
+
+func (m *RecordMissedEpoch) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RecordMissedEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pos.pos.v1.GenesisState")
+	proto.RegisterType((*UploadChunk)(nil), "pos.pos.v1.UploadChunk")
+	proto.RegisterType((*RecordMissedEpoch)(nil), "pos.pos.v1.RecordMissedEpoch")
 }
 
 func init() { proto.RegisterFile("pos/pos/v1/genesis.proto", fileDescriptor_0c3be12094f45f99) }
 
 var fileDescriptor_0c3be12094f45f99 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0xc8, 0x2f, 0xd6,
-	0x07, 0xe1, 0x32, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2, 0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc,
-	0x92, 0x7c, 0x21, 0xae, 0x82, 0xfc, 0x62, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd,
-	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x69, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f,
-	0xc4, 0x82, 0x8a, 0x8a, 0x23, 0x19, 0x57, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x8c, 0x45, 0xa2, 0x28,
-	0x35, 0x39, 0xbf, 0x28, 0x05, 0x22, 0xa1, 0x74, 0x9d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x71, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x29, 0x17, 0x1b, 0x44, 0xa7, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x90, 0x1e, 0xc2, 0x21, 0x7a, 0x01, 0x60, 0x19, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56,
-	0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0x55, 0x2c, 0x64, 0xc4, 0xc5, 0x0e, 0x31, 0xb7, 0x58, 0x82,
-	0x49, 0x81, 0x19, 0x5d, 0x5f, 0x10, 0x58, 0xca, 0x89, 0x05, 0xa4, 0x2f, 0x08, 0xa6, 0x50, 0x28,
-	0x86, 0x4b, 0xac, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0x28, 0x1e, 0x22, 0x18, 0x5f,
-	0x5c, 0x92, 0x58, 0x52, 0x2c, 0xc1, 0x0c, 0x36, 0x42, 0x01, 0xd9, 0x88, 0x30, 0x98, 0x4a, 0x88,
-	0x59, 0x20, 0xc7, 0x16, 0x43, 0x0d, 0x14, 0x29, 0xc3, 0x26, 0x67, 0x77, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
-	0xb9, 0xfa, 0x7e, 0xa9, 0xf9, 0xb9, 0xc1, 0xa9, 0x79, 0xc5, 0xa9, 0xfa, 0x01, 0xf9, 0xc1, 0xfa,
-	0x15, 0xe0, 0x30, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07, 0x90, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x37, 0x4f, 0xed, 0xb7, 0xa3, 0x01, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6e, 0x1b, 0x37,
+	0x10, 0xb5, 0x12, 0xc7, 0xb1, 0x29, 0xd9, 0xb1, 0x69, 0x59, 0xa6, 0x9d, 0x46, 0x55, 0x8c, 0x1e,
+	0x8c, 0x02, 0x91, 0xe0, 0x04, 0x3d, 0x15, 0x68, 0x61, 0xb9, 0x46, 0xe0, 0x43, 0x0d, 0x43, 0x4a,
+	0x52, 0xa0, 0x28, 0xb0, 0xa5, 0x77, 0x69, 0x99, 0xa8, 0x76, 0xb9, 0xd8, 0xa1, 0xb6, 0xd6, 0x5f,
+	0xf4, 0x33, 0x7a, 0xcc, 0xa1, 0x1f, 0x91, 0x63, 0xd0, 0x53, 0x4f, 0x45, 0x61, 0x1f, 0xfa, 0x1b,
+	0x05, 0x87, 0x94, 0x96, 0xd6, 0x6e, 0x0e, 0x3d, 0x48, 0xd8, 0x9d, 0xf7, 0xe6, 0xf1, 0xed, 0x70,
+	0x38, 0x24, 0x2c, 0x55, 0xd0, 0x33, 0xbf, 0xfc, 0xa8, 0x37, 0x12, 0x89, 0x00, 0x09, 0xdd, 0x34,
+	0x53, 0x5a, 0x51, 0x92, 0x2a, 0xe8, 0x9a, 0x5f, 0x7e, 0xb4, 0xbf, 0xc5, 0x63, 0x99, 0xa8, 0x1e,
+	0xfe, 0x5b, 0x78, 0x7f, 0x2f, 0x54, 0x10, 0x2b, 0x08, 0xf0, 0xad, 0x67, 0x5f, 0x1c, 0xd4, 0x1c,
+	0xa9, 0x91, 0xb2, 0x71, 0xf3, 0xe4, 0xa2, 0xfb, 0xde, 0x4a, 0xe1, 0x35, 0x1f, 0x8f, 0x45, 0x32,
+	0x12, 0x0e, 0xf3, 0x5d, 0x44, 0x12, 0xd2, 0x89, 0x9e, 0x21, 0x2d, 0x0f, 0x11, 0xa9, 0x0a, 0xaf,
+	0x5d, 0x7c, 0xd7, 0x8b, 0xa7, 0x3c, 0xe3, 0x31, 0x54, 0x00, 0x99, 0x08, 0x55, 0x16, 0x39, 0xe0,
+	0xb3, 0x12, 0x10, 0xe8, 0x69, 0x2a, 0x2a, 0xd3, 0x7e, 0xe5, 0xf3, 0x34, 0xdf, 0x00, 0x8c, 0x39,
+	0x54, 0x19, 0x98, 0xa4, 0x63, 0xc5, 0x5d, 0xc2, 0xc1, 0xfb, 0x06, 0x69, 0xbc, 0xb6, 0x95, 0x1c,
+	0x6a, 0xae, 0x05, 0xfd, 0x8a, 0xac, 0x58, 0x87, 0xac, 0xd6, 0xa9, 0x1d, 0xd6, 0x5f, 0xd2, 0x6e,
+	0x51, 0xd9, 0xee, 0x05, 0x22, 0xfd, 0xb5, 0x0f, 0x7f, 0x7f, 0xbe, 0xf4, 0xfb, 0xbf, 0xef, 0xbf,
+	0xac, 0x0d, 0x1c, 0x99, 0xbe, 0x24, 0x8f, 0xad, 0x4d, 0x60, 0x0f, 0x3a, 0x0f, 0x17, 0xf3, 0x06,
+	0x08, 0xf5, 0x97, 0x4d, 0xde, 0x60, 0x46, 0xa4, 0x3f, 0x91, 0x56, 0xce, 0xc7, 0x32, 0xe2, 0x5a,
+	0x65, 0x81, 0xfb, 0x48, 0xd0, 0x5c, 0x03, 0x7b, 0x88, 0x12, 0x1d, 0x5f, 0xe2, 0xdd, 0x8c, 0x69,
+	0xb5, 0x8c, 0x59, 0x70, 0x82, 0xcd, 0xbc, 0x02, 0xa3, 0xdf, 0x92, 0x86, 0x57, 0x38, 0x60, 0xcb,
+	0xa8, 0xd9, 0x2a, 0xdb, 0x7a, 0x33, 0x4d, 0x85, 0x53, 0xaa, 0x67, 0xf3, 0x08, 0xd0, 0x57, 0x64,
+	0x05, 0xf7, 0x10, 0xd8, 0x23, 0x4c, 0xdd, 0xf1, 0x53, 0x4f, 0x0d, 0x72, 0x96, 0x5c, 0x29, 0x97,
+	0xe9, 0xa8, 0xb4, 0x4f, 0xea, 0x76, 0x43, 0x82, 0x54, 0xa9, 0x31, 0x5b, 0xc1, 0x1a, 0x2e, 0x2c,
+	0x6a, 0xe0, 0x0b, 0xa5, 0xc6, 0x7e, 0x1d, 0x49, 0x36, 0x0f, 0x7b, 0xce, 0x73, 0xa5, 0x05, 0xb0,
+	0xc7, 0x9f, 0x72, 0xfe, 0x4e, 0xe9, 0x05, 0xe7, 0x26, 0x02, 0xf4, 0x07, 0xd2, 0xcc, 0x45, 0x26,
+	0xaf, 0x64, 0xc8, 0xb5, 0x54, 0x49, 0x10, 0xaa, 0x38, 0x96, 0x1a, 0xd8, 0x2a, 0x0a, 0xb5, 0xef,
+	0x95, 0xd5, 0xe3, 0x9d, 0x20, 0xcd, 0x09, 0x6e, 0xe7, 0x25, 0x04, 0xe8, 0xcf, 0x64, 0x97, 0xe7,
+	0x5c, 0x8e, 0xf9, 0xa5, 0x1c, 0x4b, 0x3d, 0x0d, 0xe6, 0x27, 0x03, 0xd8, 0x1a, 0x6a, 0x3f, 0xf7,
+	0xb5, 0x8f, 0x3d, 0xea, 0xc9, 0x8c, 0xe9, 0xe4, 0x5b, 0xbc, 0x0a, 0x04, 0x7a, 0x42, 0xda, 0x89,
+	0xb8, 0xd1, 0x41, 0xf5, 0x32, 0x81, 0x8c, 0x18, 0xe9, 0xd4, 0x0e, 0x97, 0x07, 0x4f, 0x0d, 0xab,
+	0x72, 0x81, 0xb3, 0x88, 0x7e, 0x4d, 0x56, 0xdd, 0xb9, 0x04, 0x56, 0x47, 0x5f, 0x7b, 0xe5, 0xe2,
+	0x7d, 0x67, 0x19, 0xce, 0xcf, 0x3c, 0x81, 0x9e, 0x92, 0x0d, 0x57, 0x7d, 0x7b, 0x50, 0x80, 0x35,
+	0x50, 0x82, 0x95, 0x25, 0xde, 0x22, 0xc1, 0x29, 0xac, 0x67, 0x5e, 0xcc, 0x34, 0xc2, 0xba, 0xcd,
+	0x0f, 0xc2, 0xeb, 0x49, 0xf2, 0x0b, 0xb0, 0x75, 0x54, 0xd9, 0xf5, 0x55, 0x2c, 0xf7, 0xc4, 0xe0,
+	0x4e, 0xa4, 0x31, 0x29, 0x42, 0x40, 0x8f, 0xc8, 0x0e, 0x16, 0xe3, 0x9e, 0x1f, 0x53, 0x83, 0x0d,
+	0xac, 0x01, 0x35, 0xa0, 0xef, 0xe4, 0x2c, 0xa2, 0xaf, 0xc9, 0x13, 0xec, 0xc4, 0x00, 0x26, 0x71,
+	0xcc, 0x33, 0x29, 0x80, 0x3d, 0x29, 0xdb, 0xc7, 0xee, 0x1d, 0x22, 0x63, 0xea, 0x56, 0xde, 0x10,
+	0x45, 0x4c, 0x0a, 0xa0, 0x21, 0xd9, 0x2b, 0x0e, 0xe7, 0xa2, 0xe4, 0x66, 0x79, 0xb3, 0xe7, 0xe7,
+	0xb3, 0x42, 0x7b, 0x37, 0xaf, 0x00, 0xcd, 0x22, 0xe7, 0xc4, 0x2e, 0x1b, 0xf0, 0x50, 0xcb, 0x5c,
+	0xea, 0x29, 0xdb, 0xfa, 0x7f, 0xca, 0xeb, 0x98, 0x7e, 0xec, 0xb2, 0xed, 0xde, 0xd9, 0xd3, 0xc7,
+	0xa7, 0x6a, 0xa2, 0x81, 0xd1, 0xaa, 0xbd, 0xc3, 0x93, 0x86, 0x84, 0x62, 0xef, 0x8a, 0x18, 0xd0,
+	0xb7, 0xa4, 0x39, 0x1b, 0x47, 0x72, 0x94, 0xc8, 0x64, 0x14, 0xc8, 0xe4, 0x4a, 0x01, 0xdb, 0x46,
+	0xb1, 0x67, 0xe5, 0x46, 0x18, 0x5a, 0x9a, 0x37, 0x0f, 0x68, 0xb6, 0x08, 0xf8, 0xb2, 0xb1, 0x04,
+	0x10, 0x51, 0xe0, 0xc6, 0x4b, 0xf3, 0x53, 0xb2, 0xdf, 0x23, 0x0d, 0x3f, 0xfb, 0xbe, 0xac, 0x07,
+	0xe0, 0xa0, 0xc3, 0x51, 0x1f, 0x88, 0x5c, 0x24, 0x1a, 0xd8, 0x4e, 0x79, 0x5c, 0x0c, 0x0d, 0x7e,
+	0x6a, 0xe0, 0xd9, 0xb8, 0x80, 0x79, 0x04, 0xe8, 0x0b, 0xb2, 0x8d, 0x6d, 0xe6, 0xa9, 0x98, 0x26,
+	0x6b, 0x61, 0x93, 0x6d, 0x1a, 0xa8, 0xc8, 0x3f, 0x8b, 0x0e, 0xde, 0x90, 0xba, 0xd7, 0xb8, 0xf4,
+	0x29, 0x59, 0x2b, 0x1a, 0xb3, 0x86, 0x39, 0xab, 0x93, 0x59, 0x3b, 0x36, 0xc9, 0x23, 0x99, 0x44,
+	0xe2, 0x86, 0x3d, 0x40, 0xc0, 0xbe, 0x50, 0x4a, 0x96, 0x23, 0xae, 0x39, 0x7b, 0xd8, 0xa9, 0x1d,
+	0x36, 0x06, 0xf8, 0x7c, 0x30, 0x25, 0x5b, 0xa5, 0x8f, 0xa6, 0xe7, 0x64, 0xab, 0x68, 0x42, 0x1e,
+	0x45, 0x99, 0x00, 0x7b, 0x2f, 0xad, 0xf5, 0x9f, 0xff, 0xf9, 0xc7, 0x8b, 0x67, 0xee, 0x22, 0x9f,
+	0x77, 0xc8, 0xb1, 0xa5, 0x0c, 0x75, 0x26, 0x93, 0xd1, 0x60, 0x33, 0x5f, 0x88, 0x1b, 0x3b, 0x58,
+	0xf3, 0x99, 0x1d, 0x7c, 0xe9, 0x7f, 0xf3, 0xe1, 0xb6, 0x5d, 0xfb, 0x78, 0xdb, 0xae, 0xfd, 0x73,
+	0xdb, 0xae, 0xfd, 0x76, 0xd7, 0x5e, 0xfa, 0x78, 0xd7, 0x5e, 0xfa, 0xeb, 0xae, 0xbd, 0xf4, 0xe3,
+	0x17, 0x23, 0xa9, 0xaf, 0x27, 0x97, 0xdd, 0x50, 0xc5, 0xbd, 0x73, 0xa1, 0xe2, 0xa1, 0x48, 0x40,
+	0xf4, 0x2e, 0xd4, 0xb0, 0x77, 0x83, 0xb7, 0x29, 0xde, 0x2c, 0x97, 0x2b, 0x78, 0x95, 0xbe, 0xfa,
+	0x2f, 0x00, 0x00, 0xff, 0xff, 0x37, 0x81, 0x90, 0xc2, 0x9e, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,88 +466,1299 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorRecordStats) > 0 {
-		for iNdEx := len(m.ValidatorRecordStats) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextSlashEventId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSlashEventId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.SlashEvents) > 0 {
+		for iNdEx := len(m.SlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValidatorRecordStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RecordMissedEpochs) > 0 {
+		for iNdEx := len(m.RecordMissedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordMissedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RecordSigningInfos) > 0 {
+		for iNdEx := len(m.RecordSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.RewardPayouts) > 0 {
+		for iNdEx := len(m.RewardPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.EpochActivity) > 0 {
+		for iNdEx := len(m.EpochActivity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochActivity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ValidatorEpochSummaries) > 0 {
+		for iNdEx := len(m.ValidatorEpochSummaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorEpochSummaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EpochSummaries) > 0 {
+		for iNdEx := len(m.EpochSummaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSummaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextRecordUploadId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRecordUploadId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.UploadChunks) > 0 {
+		for iNdEx := len(m.UploadChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RecordUploads) > 0 {
+		for iNdEx := len(m.RecordUploads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordUploads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextAvailabilityChallengeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAvailabilityChallengeId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AvailabilityChallenges) > 0 {
+		for iNdEx := len(m.AvailabilityChallenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvailabilityChallenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VerificationCommits) > 0 {
+		for iNdEx := len(m.VerificationCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RecordVotes) > 0 {
+		for iNdEx := len(m.RecordVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorRecordStats) > 0 {
+		for iNdEx := len(m.ValidatorRecordStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRecordStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UploadId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordMissedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordMissedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordMissedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRecordStats) > 0 {
+		for _, e := range m.ValidatorRecordStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, e := range m.RecordTypes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecordVotes) > 0 {
+		for _, e := range m.RecordVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerificationCommits) > 0 {
+		for _, e := range m.VerificationCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AvailabilityChallenges) > 0 {
+		for _, e := range m.AvailabilityChallenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAvailabilityChallengeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextAvailabilityChallengeId))
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordUploads) > 0 {
+		for _, e := range m.RecordUploads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UploadChunks) > 0 {
+		for _, e := range m.UploadChunks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRecordUploadId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRecordUploadId))
+	}
+	if len(m.EpochSummaries) > 0 {
+		for _, e := range m.EpochSummaries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorEpochSummaries) > 0 {
+		for _, e := range m.ValidatorEpochSummaries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochActivity) > 0 {
+		for _, e := range m.EpochActivity {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPayouts) > 0 {
+		for _, e := range m.RewardPayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordSigningInfos) > 0 {
+		for _, e := range m.RecordSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordMissedEpochs) > 0 {
+		for _, e := range m.RecordMissedEpochs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashEvents) > 0 {
+		for _, e := range m.SlashEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSlashEventId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextSlashEventId))
+	}
+	return n
+}
+
+func (m *UploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		n += 1 + sovGenesis(uint64(m.UploadId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordMissedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRecordStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRecordStats = append(m.ValidatorRecordStats, ValidatorRecordStats{})
+			if err := m.ValidatorRecordStats[len(m.ValidatorRecordStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, RecordType{})
+			if err := m.RecordTypes[len(m.RecordTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordVotes = append(m.RecordVotes, RecordVote{})
+			if err := m.RecordVotes[len(m.RecordVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationCommits = append(m.VerificationCommits, VerificationCommit{})
+			if err := m.VerificationCommits[len(m.VerificationCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailabilityChallenges = append(m.AvailabilityChallenges, AvailabilityChallenge{})
+			if err := m.AvailabilityChallenges[len(m.AvailabilityChallenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAvailabilityChallengeId", wireType)
+			}
+			m.NextAvailabilityChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAvailabilityChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, RecordDispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordUploads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordUploads = append(m.RecordUploads, RecordUpload{})
+			if err := m.RecordUploads[len(m.RecordUploads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadChunks = append(m.UploadChunks, UploadChunk{})
+			if err := m.UploadChunks[len(m.UploadChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecordUploadId", wireType)
+			}
+			m.NextRecordUploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecordUploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSummaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSummaries = append(m.EpochSummaries, EpochSummary{})
+			if err := m.EpochSummaries[len(m.EpochSummaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEpochSummaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorEpochSummaries = append(m.ValidatorEpochSummaries, ValidatorEpochSummary{})
+			if err := m.ValidatorEpochSummaries[len(m.ValidatorEpochSummaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochActivity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochActivity = append(m.EpochActivity, ValidatorEpochSummary{})
+			if err := m.EpochActivity[len(m.EpochActivity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPayouts = append(m.RewardPayouts, RewardPayout{})
+			if err := m.RewardPayouts[len(m.RewardPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordSigningInfos = append(m.RecordSigningInfos, RecordSigningInfo{})
+			if err := m.RecordSigningInfos[len(m.RecordSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordMissedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordMissedEpochs = append(m.RecordMissedEpochs, RecordMissedEpoch{})
+			if err := m.RecordMissedEpochs[len(m.RecordMissedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvents = append(m.SlashEvents, SlashEvent{})
+			if err := m.SlashEvents[len(m.SlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlashEventId", wireType)
+			}
+			m.NextSlashEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSlashEventId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.ValidatorRecordStats) > 0 {
-		for _, e := range m.ValidatorRecordStats {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *UploadChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -240,17 +1781,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
 			}
-			var msglen int
+			m.UploadId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -260,30 +1801,35 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UploadId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -293,31 +1839,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordMissedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordMissedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordMissedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRecordStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -327,26 +1923,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorRecordStats = append(m.ValidatorRecordStats, ValidatorRecordStats{})
-			if err := m.ValidatorRecordStats[len(m.ValidatorRecordStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
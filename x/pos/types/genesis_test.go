@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/NeomSense/PoS/x/pos/types"
 )
@@ -20,9 +22,292 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
+			genState: &types.GenesisState{Params: types.DefaultParams()},
 			valid:    true,
 		},
+		{
+			desc: "epoch history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 4, StartHeight: 400, StartTime: 1000, EndHeight: 500},
+					{Number: 5, StartHeight: 500, StartTime: 1600, Mode: types.EpochModeTime, EndTime: 2200},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "epoch missing from history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 4, StartHeight: 400, StartTime: 1000, EndHeight: 500},
+					{Number: 6, StartHeight: 600, StartTime: 2200, EndHeight: 700},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch ending before it starts",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 4, StartHeight: 400, StartTime: 1000, Mode: types.EpochModeTime, EndTime: 900},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "records and validator stats",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}, {Id: "b"}},
+				ValidatorRecordStats: []types.ValidatorRecordStats{
+					{ValidatorAddress: "validator-a"},
+					{ValidatorAddress: "validator-b"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate record",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}, {Id: "a"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate validator stats",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ValidatorRecordStats: []types.ValidatorRecordStats{
+					{ValidatorAddress: "validator-a"},
+					{ValidatorAddress: "validator-a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vote on unknown record",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				RecordVotes: []types.RecordVote{{RecordId: "a", Verifier: "verifier"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate vote",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}},
+				RecordVotes: []types.RecordVote{
+					{RecordId: "a", Verifier: "verifier"},
+					{RecordId: "a", Verifier: "verifier"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "commit on unknown record",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				VerificationCommits: []types.VerificationCommit{
+					{RecordId: "a", Verifier: "verifier", RevealStartHeight: 10, RevealEndHeight: 20},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "commit reveal phase ending before it starts",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}},
+				VerificationCommits: []types.VerificationCommit{
+					{RecordId: "a", Verifier: "verifier", RevealStartHeight: 20, RevealEndHeight: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid challenge bond",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}},
+				AvailabilityChallenges: []types.AvailabilityChallenge{
+					{Id: 0, RecordId: "a", Bond: sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000)}},
+				},
+				NextAvailabilityChallengeId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "challenge id not below the next id",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}},
+				AvailabilityChallenges: []types.AvailabilityChallenge{
+					{Id: 0, RecordId: "a", Bond: sdk.NewInt64Coin("stake", 1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid dispute bond",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Records: []types.Record{{Id: "a"}},
+				Disputes: []types.RecordDispute{
+					{RecordId: "a", Bond: sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000)}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "dispute of unknown record",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Disputes: []types.RecordDispute{
+					{RecordId: "a", Bond: sdk.NewInt64Coin("stake", 1000)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "record uploads",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				RecordUploads: []types.RecordUpload{{Id: 0}, {Id: 1}},
+				UploadChunks: []types.UploadChunk{
+					{UploadId: 0, Index: 0, Data: []byte("chunk")},
+					{UploadId: 1, Index: 0, Data: []byte("chunk")},
+				},
+				NextRecordUploadId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "upload id not below the next id",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				RecordUploads: []types.RecordUpload{{Id: 0}},
+			},
+			valid: false,
+		},
+		{
+			desc: "chunk of unknown upload",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				RecordUploads:      []types.RecordUpload{{Id: 0}},
+				UploadChunks:       []types.UploadChunk{{UploadId: 1, Index: 0, Data: []byte("chunk")}},
+				NextRecordUploadId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch summaries",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 4, StartHeight: 400, StartTime: 1000, EndHeight: 500},
+					{Number: 5, StartHeight: 500, StartTime: 1600, EndHeight: 600},
+				},
+				EpochSummaries: []types.EpochSummary{
+					{Epoch: types.EpochInfo{Number: 4, StartHeight: 400, StartTime: 1000, EndHeight: 500}},
+				},
+				ValidatorEpochSummaries: []types.ValidatorEpochSummary{{Epoch: 4, ValidatorAddress: "validator-a"}},
+				EpochActivity:           []types.ValidatorEpochSummary{{Epoch: 5, ValidatorAddress: "validator-a"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "summary of the current epoch",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 5, StartHeight: 500, StartTime: 1600, EndHeight: 600},
+				},
+				ValidatorEpochSummaries: []types.ValidatorEpochSummary{{Epoch: 5, ValidatorAddress: "validator-a"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "activity of an ended epoch",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Epochs: []types.EpochInfo{
+					{Number: 5, StartHeight: 500, StartTime: 1600, EndHeight: 600},
+				},
+				EpochActivity: []types.ValidatorEpochSummary{{Epoch: 4, ValidatorAddress: "validator-a"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate reward payout",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardPayouts: []types.RewardPayout{
+					{Epoch: 4, ValidatorAddress: "validator-a"},
+					{Epoch: 4, ValidatorAddress: "validator-a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid record fee",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Records: []types.Record{
+					{Id: "a", Fee: sdk.Coins{{Denom: "", Amount: math.NewInt(101)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "escrowed fees and bonds",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Records: []types.Record{
+					{Id: "a", Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), Bond: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))},
+				},
+				Disputes: []types.RecordDispute{
+					{RecordId: "a", Bond: sdk.NewInt64Coin("stake", 1000), Status: types.DisputeStatusOpen},
+				},
+				AvailabilityChallenges: []types.AvailabilityChallenge{
+					{Id: 0, RecordId: "a", Bond: sdk.NewInt64Coin("stake", 1000), Status: types.ChallengeStatusOpen},
+				},
+				NextAvailabilityChallengeId: 1,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid record bond",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Records: []types.Record{
+					{Id: "a", Bond: sdk.Coins{{Denom: "stake", Amount: math.NewInt(-500)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "missed epoch without signing info",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				RecordSigningInfos: []types.RecordSigningInfo{{ValidatorAddress: "validator-a"}},
+				RecordMissedEpochs: []types.RecordMissedEpoch{{ValidatorAddress: "validator-b", Epoch: 4}},
+			},
+			valid: false,
+		},
+		{
+			desc: "slash event id not below the next id",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				SlashEvents:      []types.SlashEvent{{Id: 0, ValidatorAddress: "validator-a"}, {Id: 1, ValidatorAddress: "validator-a"}},
+				NextSlashEventId: 1,
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
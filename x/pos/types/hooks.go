@@ -0,0 +1,42 @@
+package types
+
+import "context"
+
+// EpochHooks is implemented by modules that act on pos epoch transitions
+type EpochHooks interface {
+	// BeforeEpochEnd is called when an epoch ends, before the next one starts
+	BeforeEpochEnd(ctx context.Context, epoch EpochInfo) error
+	// AfterEpochStart is called once an epoch has started
+	AfterEpochStart(ctx context.Context, epoch EpochInfo) error
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, all hook functions are run
+// in array sequence
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns hooks running each of hooks in turn
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// BeforeEpochEnd runs the BeforeEpochEnd hook of each of the hooks
+func (h MultiEpochHooks) BeforeEpochEnd(ctx context.Context, epoch EpochInfo) error {
+	for _, hook := range h {
+		if err := hook.BeforeEpochEnd(ctx, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterEpochStart runs the AfterEpochStart hook of each of the hooks
+func (h MultiEpochHooks) AfterEpochStart(ctx context.Context, epoch EpochInfo) error {
+	for _, hook := range h {
+		if err := hook.AfterEpochStart(ctx, epoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,23 +9,73 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// FeeEscrowName is the name of the module account escrowing record
+	// submission fees until the records are settled
+	FeeEscrowName = "pos_fee_escrow"
+
 	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
 
 	// Event types
-	EventTypeRecordSubmitted = "record_submitted"
-	EventTypeRecordVerified  = "record_verified"
-	EventTypeValidatorSlashed = "validator_slashed"
+	EventTypeRecordSubmitted    = "record_submitted"
+	EventTypeRecordVerified     = "record_verified"
+	EventTypeRecordVote         = "record_vote"
+	EventTypeRecordCommit       = "record_commit"
+	EventTypeRecordReveal       = "record_reveal"
+	EventTypeCommitUnrevealed   = "commit_unrevealed"
+	EventTypeChallenge          = "availability_challenge"
+	EventTypeChallengeAnswer    = "availability_challenge_answered"
+	EventTypeChallengeFailed    = "availability_challenge_failed"
+	EventTypeRecordDisputed     = "record_disputed"
+	EventTypeDisputeSettled     = "dispute_settled"
+	EventTypeAutoVerified       = "record_auto_verified"
+	EventTypeRecordExpired      = "record_expired"
+	EventTypeDataPruned         = "record_data_pruned"
+	EventTypeRecordTypeSet      = "record_type_set"
+	EventTypeRecordTypeRemove   = "record_type_removed"
+	EventTypeUploadBegun        = "record_upload_begun"
+	EventTypeUploadChunk        = "record_upload_chunk"
+	EventTypeUploadExpired      = "record_upload_expired"
+	EventTypeRecordAmended      = "record_amended"
+	EventTypeEpochEnd           = "epoch_end"
+	EventTypeEpochStart         = "epoch_start"
+	EventTypeEpochSummary       = "epoch_summary"
+	EventTypeValidatorSlashed   = "validator_slashed"
+	EventTypeRewardPoolFunded   = "reward_pool_funded"
+	EventTypeRewardPaid         = "reward_paid"
+	EventTypeRecordFeePaid      = "record_fee_paid"
+	EventTypeRecordFeeSettled   = "record_fee_settled"
+	EventTypeRecordBondPosted   = "record_bond_posted"
+	EventTypeRecordBondSettled  = "record_bond_settled"
+	EventTypeMissedEpochPenalty = "missed_epoch_penalty"
+	EventTypeValidatorUnjailed  = "validator_unjailed"
 
 	// Event attributes
-	AttributeKeyRecordID    = "record_id"
-	AttributeKeyValidator   = "validator"
-	AttributeKeyVerifier    = "verifier"
-	AttributeKeyApproved    = "approved"
-	AttributeKeySlashAmount = "slash_amount"
-	AttributeKeyReason      = "reason"
+	AttributeKeyRecordID        = "record_id"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyVerifier        = "verifier"
+	AttributeKeyApproved        = "approved"
+	AttributeKeySlashAmount     = "slash_amount"
+	AttributeKeyReason          = "reason"
+	AttributeKeyPower           = "power"
+	AttributeKeyStatus          = "status"
+	AttributeKeyChallengeID     = "challenge_id"
+	AttributeKeyChallenger      = "challenger"
+	AttributeKeyChunkIndex      = "chunk_index"
+	AttributeKeyDisputer        = "disputer"
+	AttributeKeyBond            = "bond"
+	AttributeKeyRecordType      = "record_type"
+	AttributeKeyUploadID        = "upload_id"
+	AttributeKeySupersedes      = "supersedes_id"
+	AttributeKeyEpoch           = "epoch"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyMissedEpochs    = "missed_epochs"
+	AttributeKeyPenalty         = "penalty"
+	AttributeKeyJailedUntil     = "jailed_until"
+	AttributeKeyVerifiedRecords = "verified_records"
 )
 
 // Store key prefixes
@@ -36,6 +86,114 @@ var (
 	// RecordsKey is the prefix for storing records
 	RecordsKey = collections.NewPrefix("r_pos")
 
+	// RecordsByValidatorKey is the prefix for the records-by-validator index
+	RecordsByValidatorKey = collections.NewPrefix("rv_pos")
+
+	// RecordsByValidatorEpochKey is the prefix for the records-by-(validator, epoch) index
+	RecordsByValidatorEpochKey = collections.NewPrefix("rve_pos")
+
+	// RecordsByStatusKey is the prefix for the records-by-status index
+	RecordsByStatusKey = collections.NewPrefix("rs_pos")
+
+	// RecordsByEpochKey is the prefix for the records-by-epoch index
+	RecordsByEpochKey = collections.NewPrefix("re_pos")
+
+	// RecordsByHeightKey is the prefix for the records-by-block-height index
+	RecordsByHeightKey = collections.NewPrefix("rh_pos")
+
 	// ValidatorStatsKey is the prefix for validator statistics
 	ValidatorStatsKey = collections.NewPrefix("vs_pos")
+
+	// RecordVotesKey is the prefix for verifier votes on records
+	RecordVotesKey = collections.NewPrefix("vt_pos")
+
+	// VerificationCommitsKey is the prefix for sealed verifier votes on records
+	VerificationCommitsKey = collections.NewPrefix("vc_pos")
+
+	// RevealQueueKey is the prefix for the queue of reveal phase deadlines
+	RevealQueueKey = collections.NewPrefix("rq_pos")
+
+	// AvailabilityChallengesKey is the prefix for data availability challenges
+	AvailabilityChallengesKey = collections.NewPrefix("ac_pos")
+
+	// AvailabilityChallengeSeqKey is the key of the availability challenge id sequence
+	AvailabilityChallengeSeqKey = collections.NewPrefix("acs_pos")
+
+	// OpenChallengesKey is the prefix mapping records to their open availability challenge
+	OpenChallengesKey = collections.NewPrefix("oc_pos")
+
+	// ChallengeQueueKey is the prefix for the queue of availability challenge deadlines
+	ChallengeQueueKey = collections.NewPrefix("cq_pos")
+
+	// DisputesKey is the prefix for record disputes
+	DisputesKey = collections.NewPrefix("d_pos")
+
+	// DisputeWindowQueueKey is the prefix for the queue of optimistic dispute window deadlines
+	DisputeWindowQueueKey = collections.NewPrefix("dq_pos")
+
+	// ExpiryQueueKey is the prefix for the queue of pending record verification deadlines
+	ExpiryQueueKey = collections.NewPrefix("eq_pos")
+
+	// PruneQueueKey is the prefix for the queue of finalized records whose data can be pruned
+	PruneQueueKey = collections.NewPrefix("pq_pos")
+
+	// RecordTypesKey is the prefix for registered record types
+	RecordTypesKey = collections.NewPrefix("rt_pos")
+
+	// RecordUploadsKey is the prefix for open chunked record uploads
+	RecordUploadsKey = collections.NewPrefix("ru_pos")
+
+	// RecordUploadsByValidatorKey is the prefix for the open uploads by-validator index
+	RecordUploadsByValidatorKey = collections.NewPrefix("ruv_pos")
+
+	// RecordUploadSeqKey is the key of the record upload id sequence
+	RecordUploadSeqKey = collections.NewPrefix("rus_pos")
+
+	// UploadChunksKey is the prefix for the chunks of open record uploads
+	UploadChunksKey = collections.NewPrefix("ruc_pos")
+
+	// UploadQueueKey is the prefix for the queue of record upload deadlines
+	UploadQueueKey = collections.NewPrefix("uq_pos")
+
+	// EpochAmendmentsKey is the prefix for the number of amendments a validator submitted in an epoch
+	EpochAmendmentsKey = collections.NewPrefix("ea_pos")
+
+	// EpochsKey is the prefix for the epoch history, including the current epoch
+	EpochsKey = collections.NewPrefix("ep_pos")
+
+	// EpochActivityKey is the prefix for what validators did so far in the current epoch
+	EpochActivityKey = collections.NewPrefix("eac_pos")
+
+	// EpochSummariesKey is the prefix for the summaries of ended epochs
+	EpochSummariesKey = collections.NewPrefix("es_pos")
+
+	// ValidatorEpochSummariesKey is the prefix for what validators did in ended epochs
+	ValidatorEpochSummariesKey = collections.NewPrefix("ves_pos")
+
+	// ValidatorEpochSummariesByValidatorKey is the prefix for the validator epoch summaries by-validator index
+	ValidatorEpochSummariesByValidatorKey = collections.NewPrefix("vesv_pos")
+
+	// RewardPoolKey is the key of the reward pool held in the module account
+	RewardPoolKey = collections.NewPrefix("rwp_pos")
+
+	// RewardPayoutsKey is the prefix for the reward payouts of ended epochs
+	RewardPayoutsKey = collections.NewPrefix("rwo_pos")
+
+	// RewardPayoutsByValidatorKey is the prefix for the reward payouts by-validator index
+	RewardPayoutsByValidatorKey = collections.NewPrefix("rwov_pos")
+
+	// RecordSigningInfosKey is the prefix for the record signing info of validators
+	RecordSigningInfosKey = collections.NewPrefix("rsi_pos")
+
+	// RecordMissedEpochsKey is the prefix for the epochs validators missed in the signing window
+	RecordMissedEpochsKey = collections.NewPrefix("rme_pos")
+
+	// SlashEventsKey is the prefix for the slash history of validators
+	SlashEventsKey = collections.NewPrefix("sl_pos")
+
+	// SlashEventsByHeightKey is the prefix for the slash history by-height index
+	SlashEventsByHeightKey = collections.NewPrefix("slh_pos")
+
+	// SlashEventSeqKey is the key of the slash event ID sequence
+	SlashEventSeqKey = collections.NewPrefix("sls_pos")
 )
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// RecordChunkSize is the number of bytes of record data hashed into each leaf
+// of a record's merkle tree. The last chunk holds the remaining bytes.
+const RecordChunkSize = 1024
+
+// RecordChunks splits record data into the chunks that form the leaves of the
+// record's merkle tree.
+func RecordChunks(data []byte) [][]byte {
+	chunks := make([][]byte, 0, (len(data)+RecordChunkSize-1)/RecordChunkSize)
+	for start := 0; start < len(data); start += RecordChunkSize {
+		end := min(start+RecordChunkSize, len(data))
+		chunks = append(chunks, data[start:end])
+	}
+	return chunks
+}
+
+// RecordMerkleRoot returns the hex encoded merkle root of record data. The data
+// is split into RecordChunkSize chunks which are hashed into an RFC 6962 tree.
+func RecordMerkleRoot(data []byte) string {
+	return hex.EncodeToString(merkle.HashFromByteSlices(RecordChunks(data)))
+}
+
+// ValidateRecordMerkleRoot checks that merkleRoot is the merkle root of data.
+// The root is compared case-insensitively.
+func ValidateRecordMerkleRoot(data []byte, merkleRoot string) error {
+	if len(merkleRoot) == 0 {
+		return ErrInvalidMerkleRoot.Wrap("merkle root cannot be empty")
+	}
+
+	expected := RecordMerkleRoot(data)
+	if !strings.EqualFold(merkleRoot, expected) {
+		return ErrInvalidMerkleRoot.Wrapf("merkle root %s does not match record data, expected %s", merkleRoot, expected)
+	}
+	return nil
+}
+
+// ParseMerkleRoot decodes a hex encoded merkle root, checking only its shape.
+// It is used when the data is not available to recompute the root.
+func ParseMerkleRoot(merkleRoot string) ([]byte, error) {
+	root, err := hex.DecodeString(merkleRoot)
+	if err != nil || len(root) != sha256.Size {
+		return nil, ErrInvalidMerkleRoot.Wrapf("merkle root must be a hex encoded %d byte hash", sha256.Size)
+	}
+	return root, nil
+}
+
+// RecordChunkProof returns the chunk of data at index and the proof of its
+// inclusion under the record's merkle root.
+func RecordChunkProof(data []byte, index uint64) ([]byte, MerkleProof, error) {
+	chunks := RecordChunks(data)
+	if index >= uint64(len(chunks)) {
+		return nil, MerkleProof{}, fmt.Errorf("chunk index %d out of range, record has %d chunks", index, len(chunks))
+	}
+
+	_, proofs := merkle.ProofsFromByteSlices(chunks)
+	proof := proofs[index]
+
+	return chunks[index], MerkleProof{
+		Total:    uint64(proof.Total),
+		Index:    uint64(proof.Index),
+		LeafHash: proof.LeafHash,
+		Aunts:    proof.Aunts,
+	}, nil
+}
+
+// Verify checks that chunk is included under the hex encoded merkle root. It
+// lets a chunk of a record be verified without the rest of the record's data.
+func (p MerkleProof) Verify(merkleRoot string, chunk []byte) error {
+	root, err := hex.DecodeString(merkleRoot)
+	if err != nil {
+		return ErrInvalidMerkleRoot.Wrapf("merkle root is not hex encoded: %s", err)
+	}
+
+	proof := merkle.Proof{
+		Total:    int64(p.Total),
+		Index:    int64(p.Index),
+		LeafHash: p.LeafHash,
+		Aunts:    p.Aunts,
+	}
+	if err := proof.Verify(root, chunk); err != nil {
+		return ErrInvalidMerkleRoot.Wrap(err.Error())
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewParams creates a new Params instance.
@@ -27,17 +28,172 @@ func NewParams(
 	}
 }
 
+var (
+	// DefaultVerificationQuorum is the default fraction of bonded stake that
+	// must vote on a record before it is finalized
+	DefaultVerificationQuorum = math.LegacyNewDecWithPrec(334, 3)
+
+	// DefaultVerificationThreshold is the default fraction of the voting stake
+	// that must approve a record for it to be verified
+	DefaultVerificationThreshold = math.LegacyNewDecWithPrec(5, 1)
+
+	// DefaultSlashFractionUnrevealedCommit is the default slash fraction for
+	// verifiers that commit to a vote and never reveal it
+	DefaultSlashFractionUnrevealedCommit = math.LegacyNewDecWithPrec(1, 3)
+
+	// DefaultSlashFractionUnavailableData is the default slash fraction for
+	// submitters that fail to answer a data availability challenge
+	DefaultSlashFractionUnavailableData = math.LegacyNewDecWithPrec(1, 2)
+)
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(
-		100,                            // MinRecordSize: 100 bytes
-		1024*1024,                      // MaxRecordSize: 1MB
-		10,                             // RecordsPerEpoch: 10 records per epoch
-		100,                            // EpochLength: 100 blocks (~10 minutes with 6s blocks)
+	params := NewParams(
+		100,                             // MinRecordSize: 100 bytes
+		1024*1024,                       // MaxRecordSize: 1MB
+		10,                              // RecordsPerEpoch: 10 records per epoch
+		100,                             // EpochLength: 100 blocks (~10 minutes with 6s blocks)
 		math.LegacyNewDecWithPrec(1, 2), // SlashFractionMissingRecord: 0.01 (1%)
 		math.LegacyNewDecWithPrec(5, 2), // SlashFractionInvalidRecord: 0.05 (5%)
-		5,                              // MinVerifiedRecordsForEligibility: 5 verified records
+		5,                               // MinVerifiedRecordsForEligibility: 5 verified records
+	)
+
+	// Verification: 33.4% of bonded stake must vote and 50% of it approve,
+	// related validators cannot verify each other
+	params.VerificationQuorum = DefaultVerificationQuorum
+	params.VerificationThreshold = DefaultVerificationThreshold
+	params.ExcludeRelatedVerifiers = true
+
+	// Commit-reveal voting: off by default; when enabled commits are accepted
+	// for 50 blocks after submission and reveals for the following 50 blocks
+	params.CommitRevealEnabled = false
+	params.CommitPeriodBlocks = 50
+	params.RevealPeriodBlocks = 50
+	params.SlashFractionUnrevealedCommit = DefaultSlashFractionUnrevealedCommit
+
+	// Off-chain data: submitters have 100 blocks to answer an availability
+	// challenge before being slashed, challengers escrow a bond of 1000000 of
+	// the bond denom
+	params.AvailabilityChallengePeriodBlocks = 100
+	params.SlashFractionUnavailableData = DefaultSlashFractionUnavailableData
+	params.ChallengeBond = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+
+	// Optimistic verification: off by default; when enabled records can be
+	// disputed for 100 blocks with a bond of 1000000 of the bond denom
+	params.OptimisticVerificationEnabled = false
+	params.DisputeWindowBlocks = 100
+	params.DisputeBond = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+
+	// Expiry: records still pending a day after submission expire
+	params.VerificationDeadlineSeconds = 24 * 60 * 60
+
+	// Retention: record data is kept forever until governance sets a period
+	params.DataRetentionBlocks = 0
+
+	// Uploads: chunked record uploads not finalized within 600 blocks
+	// (~1 hour with 6s blocks) are discarded, a validator can have 4 uploads
+	// open at once
+	params.UploadTimeoutBlocks = 600
+	params.MaxOpenUploadsPerValidator = 4
+
+	// Epochs: measured in blocks; when measured in time instead, an epoch
+	// lasts 10 minutes of block time
+	params.EpochMode = EpochModeBlocks
+	params.EpochDurationSeconds = 10 * 60
+
+	// Rewards: the reward pool is only funded by deposits until governance
+	// sets a share of the collected fees
+	params.RewardPoolFeeShare = math.LegacyZeroDec()
+
+	// Record fees: submissions are free until governance sets a fee
+	params.RecordFeeFlat = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	params.RecordFeePerByte = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+
+	// Invalid records: the submitter's stake is slashed; when bonds are used
+	// instead, 1000000 of the bond denom is escrowed per record and burned on
+	// rejection
+	params.InvalidRecordPenalty = InvalidRecordPenaltySlash
+	params.RecordBond = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+	params.RecordBondForfeiture = BondForfeitureBurn
+
+	// Missed epochs: no schedule, every missed epoch is slashed by
+	// SlashFractionMissingRecord
+	params.MissedEpochPenalties = nil
+
+	// Signing window: a record is required in every epoch, as the window
+	// covers only the epoch being checked
+	params.SignedEpochsWindow = 1
+	params.MinSignedPerWindow = math.LegacyOneDec()
+
+	// Verified records: counted over the lifetime of the validator until
+	// governance sets a window
+	params.VerifiedRecordsWindow = 0
+
+	// Onboarding: no grace period, new validators are required to submit from
+	// the epoch after the one they bonded in
+	params.OnboardingEpochs = 0
+
+	// Unjailing: a validator jailed for missing records can unjail itself
+	// once its jail ended, at least an hour after it was jailed, and 5 of its
+	// records were verified since
+	params.UnjailVerifiedRecords = 5
+	params.UnjailMinJailSeconds = 60 * 60
+
+	return params
+}
+
+// MaxMissedEpochs returns the number of epochs in the signing window a
+// validator can miss and stay eligible
+func (p Params) MaxMissedEpochs() uint64 {
+	minSigned := p.MinSignedPerWindow.MulInt64(int64(p.SignedEpochsWindow)).RoundInt64()
+	return p.SignedEpochsWindow - uint64(minSigned)
+}
+
+// MissedEpochPenalty returns the step of the penalty schedule that applies to
+// a validator that has missed missedEpochs epochs in a row, and false if none
+// does yet. Without a schedule every missed epoch is slashed by
+// SlashFractionMissingRecord.
+func (p Params) MissedEpochPenalty(missedEpochs uint64) (MissedEpochPenalty, bool) {
+	if len(p.MissedEpochPenalties) == 0 {
+		return MissedEpochPenalty{
+			MissedEpochs:  1,
+			Action:        PenaltySlash,
+			SlashFraction: p.SlashFractionMissingRecord,
+		}, missedEpochs > 0
+	}
+
+	var (
+		step  MissedEpochPenalty
+		found bool
 	)
+	for _, penalty := range p.MissedEpochPenalties {
+		if penalty.MissedEpochs > missedEpochs {
+			break
+		}
+		step, found = penalty, true
+	}
+	return step, found
+}
+
+// UsesRecordBond reports whether records are submitted with a bond that is
+// forfeited on rejection
+func (p Params) UsesRecordBond() bool {
+	return p.InvalidRecordPenalty == InvalidRecordPenaltyBond || p.InvalidRecordPenalty == InvalidRecordPenaltyBoth
+}
+
+// SlashesInvalidRecords reports whether the stake of the submitter of a
+// rejected record is slashed
+func (p Params) SlashesInvalidRecords() bool {
+	return p.InvalidRecordPenalty == InvalidRecordPenaltySlash || p.InvalidRecordPenalty == InvalidRecordPenaltyBoth
+}
+
+// RecordFee returns the fee for submitting a record of dataSize stored bytes
+func (p Params) RecordFee(dataSize uint64) sdk.Coins {
+	fee := sdk.NewCoins(p.RecordFeeFlat)
+	if p.RecordFeePerByte.IsPositive() {
+		fee = fee.Add(sdk.NewCoin(p.RecordFeePerByte.Denom, p.RecordFeePerByte.Amount.Mul(math.NewIntFromUint64(dataSize))))
+	}
+	return fee
 }
 
 // Validate validates the set of params.
@@ -63,6 +219,114 @@ func (p Params) Validate() error {
 	if p.SlashFractionInvalidRecord.IsNegative() || p.SlashFractionInvalidRecord.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("slash fraction for invalid record must be between 0 and 1")
 	}
+	if p.VerificationQuorum.IsNil() || p.VerificationQuorum.IsNegative() || p.VerificationQuorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("verification quorum must be between 0 and 1")
+	}
+	if p.VerificationThreshold.IsNil() || !p.VerificationThreshold.IsPositive() || p.VerificationThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("verification threshold must be greater than 0 and at most 1")
+	}
+	if p.CommitRevealEnabled && (p.CommitPeriodBlocks == 0 || p.RevealPeriodBlocks == 0) {
+		return fmt.Errorf("commit and reveal periods must be positive when commit-reveal is enabled")
+	}
+	if p.SlashFractionUnrevealedCommit.IsNil() || p.SlashFractionUnrevealedCommit.IsNegative() || p.SlashFractionUnrevealedCommit.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction for unrevealed commit must be between 0 and 1")
+	}
+	if p.AvailabilityChallengePeriodBlocks == 0 {
+		return fmt.Errorf("availability challenge period must be positive")
+	}
+	if p.SlashFractionUnavailableData.IsNil() || p.SlashFractionUnavailableData.IsNegative() || p.SlashFractionUnavailableData.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction for unavailable data must be between 0 and 1")
+	}
+	if p.OptimisticVerificationEnabled && p.DisputeWindowBlocks == 0 {
+		return fmt.Errorf("dispute window must be positive when optimistic verification is enabled")
+	}
+	if err := p.DisputeBond.Validate(); err != nil {
+		return fmt.Errorf("invalid dispute bond: %w", err)
+	}
+	if err := p.ChallengeBond.Validate(); err != nil {
+		return fmt.Errorf("invalid challenge bond: %w", err)
+	}
+	if p.UploadTimeoutBlocks == 0 {
+		return fmt.Errorf("upload timeout must be positive")
+	}
+	if p.MaxOpenUploadsPerValidator == 0 {
+		return fmt.Errorf("max open uploads per validator must be positive")
+	}
+	if _, ok := EpochMode_name[int32(p.EpochMode)]; !ok {
+		return fmt.Errorf("unknown epoch mode %d", p.EpochMode)
+	}
+	if p.EpochMode == EpochModeTime && p.EpochDurationSeconds == 0 {
+		return fmt.Errorf("epoch duration must be positive when epochs are measured in time")
+	}
+	if p.RewardPoolFeeShare.IsNil() || p.RewardPoolFeeShare.IsNegative() || p.RewardPoolFeeShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reward pool fee share must be between 0 and 1")
+	}
+	if err := p.RecordFeeFlat.Validate(); err != nil {
+		return fmt.Errorf("invalid flat record fee: %w", err)
+	}
+	if err := p.RecordFeePerByte.Validate(); err != nil {
+		return fmt.Errorf("invalid per-byte record fee: %w", err)
+	}
+	if _, ok := InvalidRecordPenalty_name[int32(p.InvalidRecordPenalty)]; !ok {
+		return fmt.Errorf("unknown invalid record penalty %d", p.InvalidRecordPenalty)
+	}
+	if err := p.RecordBond.Validate(); err != nil {
+		return fmt.Errorf("invalid record bond: %w", err)
+	}
+	if p.UsesRecordBond() && !p.RecordBond.IsPositive() {
+		return fmt.Errorf("record bond must be positive when invalid records forfeit their bond")
+	}
+	if _, ok := BondForfeiture_name[int32(p.RecordBondForfeiture)]; !ok {
+		return fmt.Errorf("unknown record bond forfeiture %d", p.RecordBondForfeiture)
+	}
+	if err := validateMissedEpochPenalties(p.MissedEpochPenalties); err != nil {
+		return err
+	}
+	if p.SignedEpochsWindow == 0 {
+		return fmt.Errorf("signed epochs window must be positive")
+	}
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1")
+	}
+	if p.UnjailVerifiedRecords == 0 {
+		return fmt.Errorf("unjail verified records must be positive")
+	}
+
+	return nil
+}
+
+// validateMissedEpochPenalties checks that the steps of a missed epoch penalty
+// schedule are ordered by strictly increasing missed epochs and complete
+func validateMissedEpochPenalties(penalties []MissedEpochPenalty) error {
+	var prev uint64
+	for i, penalty := range penalties {
+		if penalty.MissedEpochs <= prev {
+			return fmt.Errorf("missed epoch penalty %d: missed epochs must be positive and increase from step to step", i)
+		}
+		prev = penalty.MissedEpochs
+
+		if penalty.SlashFraction.IsNil() || penalty.SlashFraction.IsNegative() || penalty.SlashFraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("missed epoch penalty %d: slash fraction must be between 0 and 1", i)
+		}
+
+		switch penalty.Action {
+		case PenaltyWarn:
+			if !penalty.SlashFraction.IsZero() {
+				return fmt.Errorf("missed epoch penalty %d: warnings cannot slash", i)
+			}
+		case PenaltySlash:
+			if !penalty.SlashFraction.IsPositive() {
+				return fmt.Errorf("missed epoch penalty %d: slash fraction must be positive", i)
+			}
+		case PenaltyJail:
+			if penalty.JailDurationSeconds == 0 {
+				return fmt.Errorf("missed epoch penalty %d: jail duration must be positive", i)
+			}
+		case PenaltyTombstone:
+		default:
+			return fmt.Errorf("missed epoch penalty %d: unknown action %d", i, penalty.Action)
+		}
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -39,8 +40,101 @@ type Params struct {
 	SlashFractionMissingRecord cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_missing_record,json=slashFractionMissingRecord,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_missing_record"`
 	// Slash fraction for invalid records
 	SlashFractionInvalidRecord cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction_invalid_record,json=slashFractionInvalidRecord,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_invalid_record"`
-	// Minimum number of verified records to remain eligible
+	// Minimum number of verified records, counted over verified_records_window,
+	// to remain eligible
 	MinVerifiedRecordsForEligibility uint64 `protobuf:"varint,7,opt,name=min_verified_records_for_eligibility,json=minVerifiedRecordsForEligibility,proto3" json:"min_verified_records_for_eligibility,omitempty"`
+	// Fraction of total bonded stake, less the submitter's, that must vote on a
+	// record before it is finalized
+	VerificationQuorum cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=verification_quorum,json=verificationQuorum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"verification_quorum"`
+	// Fraction of the voting stake that must approve a record for it to be
+	// verified; once quorum is reached the record is rejected otherwise
+	VerificationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=verification_threshold,json=verificationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"verification_threshold"`
+	// Forbid verifiers from voting on records of validators they are related
+	// to, i.e. validators sharing an operator or distribution withdraw address
+	ExcludeRelatedVerifiers bool `protobuf:"varint,10,opt,name=exclude_related_verifiers,json=excludeRelatedVerifiers,proto3" json:"exclude_related_verifiers,omitempty"`
+	// Require verifiers to vote through MsgCommitVerification and
+	// MsgRevealVerification instead of MsgVerifyRecord
+	CommitRevealEnabled bool `protobuf:"varint,11,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty"`
+	// Number of blocks after a record is submitted during which verifiers may
+	// commit to a vote; reveals open once this period ends
+	CommitPeriodBlocks uint64 `protobuf:"varint,12,opt,name=commit_period_blocks,json=commitPeriodBlocks,proto3" json:"commit_period_blocks,omitempty"`
+	// Number of blocks the reveal phase stays open after the commit period
+	RevealPeriodBlocks uint64 `protobuf:"varint,13,opt,name=reveal_period_blocks,json=revealPeriodBlocks,proto3" json:"reveal_period_blocks,omitempty"`
+	// Slash fraction for verifiers that commit to a vote and never reveal it
+	SlashFractionUnrevealedCommit cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=slash_fraction_unrevealed_commit,json=slashFractionUnrevealedCommit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_unrevealed_commit"`
+	// Number of blocks the submitter of an off-chain record has to answer a data
+	// availability challenge
+	AvailabilityChallengePeriodBlocks uint64 `protobuf:"varint,15,opt,name=availability_challenge_period_blocks,json=availabilityChallengePeriodBlocks,proto3" json:"availability_challenge_period_blocks,omitempty"`
+	// Slash fraction for submitters that fail to answer an availability challenge
+	SlashFractionUnavailableData cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=slash_fraction_unavailable_data,json=slashFractionUnavailableData,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_unavailable_data"`
+	// Verify records automatically once their dispute window has passed
+	// without a dispute
+	OptimisticVerificationEnabled bool `protobuf:"varint,17,opt,name=optimistic_verification_enabled,json=optimisticVerificationEnabled,proto3" json:"optimistic_verification_enabled,omitempty"`
+	// Number of blocks after submission during which a record can be disputed
+	DisputeWindowBlocks uint64 `protobuf:"varint,18,opt,name=dispute_window_blocks,json=disputeWindowBlocks,proto3" json:"dispute_window_blocks,omitempty"`
+	// Bond a disputer escrows with MsgDisputeRecord; it is returned when the
+	// record is rejected and burned when the record is verified
+	DisputeBond types.Coin `protobuf:"bytes,19,opt,name=dispute_bond,json=disputeBond,proto3" json:"dispute_bond"`
+	// Number of seconds after submission after which a record that is still
+	// pending expires; zero disables expiry
+	VerificationDeadlineSeconds uint64 `protobuf:"varint,20,opt,name=verification_deadline_seconds,json=verificationDeadlineSeconds,proto3" json:"verification_deadline_seconds,omitempty"`
+	// Number of blocks a finalized record keeps its data before the data is
+	// pruned from state; zero keeps record data forever
+	DataRetentionBlocks uint64 `protobuf:"varint,22,opt,name=data_retention_blocks,json=dataRetentionBlocks,proto3" json:"data_retention_blocks,omitempty"`
+	// Number of blocks a chunked record upload stays open before it is
+	// discarded if it was not finalized
+	UploadTimeoutBlocks uint64 `protobuf:"varint,23,opt,name=upload_timeout_blocks,json=uploadTimeoutBlocks,proto3" json:"upload_timeout_blocks,omitempty"`
+	// How the length of epochs is measured; a change takes effect when the
+	// current epoch ends
+	EpochMode EpochMode `protobuf:"varint,24,opt,name=epoch_mode,json=epochMode,proto3,enum=pos.pos.v1.EpochMode" json:"epoch_mode,omitempty"`
+	// Length of an epoch in seconds of block time when epochs are measured in
+	// time; epoch_length is used when they are measured in blocks
+	EpochDurationSeconds uint64 `protobuf:"varint,25,opt,name=epoch_duration_seconds,json=epochDurationSeconds,proto3" json:"epoch_duration_seconds,omitempty"`
+	// Share of the fees collected in a block that is moved to the reward pool
+	// at the end of the block; zero leaves all fees to x/distribution
+	RewardPoolFeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,26,opt,name=reward_pool_fee_share,json=rewardPoolFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_pool_fee_share"`
+	// Flat fee charged for every record submission, escrowed until the record
+	// is settled and then paid to the verifiers that voted with the outcome
+	RecordFeeFlat types.Coin `protobuf:"bytes,27,opt,name=record_fee_flat,json=recordFeeFlat,proto3" json:"record_fee_flat"`
+	// Fee charged per byte of stored record data on top of the flat fee
+	RecordFeePerByte types.Coin `protobuf:"bytes,28,opt,name=record_fee_per_byte,json=recordFeePerByte,proto3" json:"record_fee_per_byte"`
+	// How the submitter of a rejected record is penalized: by a stake slash,
+	// by forfeiting the record bond, or both
+	InvalidRecordPenalty InvalidRecordPenalty `protobuf:"varint,29,opt,name=invalid_record_penalty,json=invalidRecordPenalty,proto3,enum=pos.pos.v1.InvalidRecordPenalty" json:"invalid_record_penalty,omitempty"`
+	// Bond escrowed with every record submission when the invalid record
+	// penalty forfeits bonds; it is returned unless the record is rejected
+	RecordBond types.Coin `protobuf:"bytes,30,opt,name=record_bond,json=recordBond,proto3" json:"record_bond"`
+	// What happens to the bond of a rejected record
+	RecordBondForfeiture BondForfeiture `protobuf:"varint,31,opt,name=record_bond_forfeiture,json=recordBondForfeiture,proto3,enum=pos.pos.v1.BondForfeiture" json:"record_bond_forfeiture,omitempty"`
+	// Escalating penalties for validators that miss their record requirement
+	// in consecutive epochs, ordered by missed_epochs; when empty every missed
+	// epoch is slashed by slash_fraction_missing_record
+	MissedEpochPenalties []MissedEpochPenalty `protobuf:"bytes,32,rep,name=missed_epoch_penalties,json=missedEpochPenalties,proto3" json:"missed_epoch_penalties"`
+	// Number of epochs in the sliding window record submissions are counted
+	// over for eligibility
+	SignedEpochsWindow uint64 `protobuf:"varint,33,opt,name=signed_epochs_window,json=signedEpochsWindow,proto3" json:"signed_epochs_window,omitempty"`
+	// Minimum fraction of the epochs in the window a validator must have
+	// submitted a record in to stay eligible
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,34,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// Number of epochs after the epoch a validator bonded in during which it is
+	// not penalized for missing its record requirement
+	OnboardingEpochs uint64 `protobuf:"varint,35,opt,name=onboarding_epochs,json=onboardingEpochs,proto3" json:"onboarding_epochs,omitempty"`
+	// Number of records a validator jailed for missing its record requirement
+	// must get verified after it was jailed to unjail itself
+	UnjailVerifiedRecords uint64 `protobuf:"varint,36,opt,name=unjail_verified_records,json=unjailVerifiedRecords,proto3" json:"unjail_verified_records,omitempty"`
+	// Minimum number of seconds a validator jailed for missing its record
+	// requirement stays jailed before it can unjail itself
+	UnjailMinJailSeconds uint64 `protobuf:"varint,37,opt,name=unjail_min_jail_seconds,json=unjailMinJailSeconds,proto3" json:"unjail_min_jail_seconds,omitempty"`
+	// Bond a challenger escrows with MsgChallengeAvailability; it is returned
+	// when the submitter fails to answer and burned when the challenge is answered
+	ChallengeBond types.Coin `protobuf:"bytes,38,opt,name=challenge_bond,json=challengeBond,proto3" json:"challenge_bond"`
+	// Maximum number of chunked record uploads a validator can have open at
+	// once; further uploads are rejected until one is finalized or discarded
+	MaxOpenUploadsPerValidator uint64 `protobuf:"varint,39,opt,name=max_open_uploads_per_validator,json=maxOpenUploadsPerValidator,proto3" json:"max_open_uploads_per_validator,omitempty"`
+	// Number of epochs, up to and including the current one, the verified
+	// records of a validator are counted over for eligibility; zero counts
+	// every record of the validator that was ever verified
+	VerifiedRecordsWindow uint64 `protobuf:"varint,40,opt,name=verified_records_window,json=verifiedRecordsWindow,proto3" json:"verified_records_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -111,6 +205,188 @@ func (m *Params) GetMinVerifiedRecordsForEligibility() uint64 {
 	return 0
 }
 
+func (m *Params) GetExcludeRelatedVerifiers() bool {
+	if m != nil {
+		return m.ExcludeRelatedVerifiers
+	}
+	return false
+}
+
+func (m *Params) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
+func (m *Params) GetCommitPeriodBlocks() uint64 {
+	if m != nil {
+		return m.CommitPeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRevealPeriodBlocks() uint64 {
+	if m != nil {
+		return m.RevealPeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAvailabilityChallengePeriodBlocks() uint64 {
+	if m != nil {
+		return m.AvailabilityChallengePeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetOptimisticVerificationEnabled() bool {
+	if m != nil {
+		return m.OptimisticVerificationEnabled
+	}
+	return false
+}
+
+func (m *Params) GetDisputeWindowBlocks() uint64 {
+	if m != nil {
+		return m.DisputeWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeBond() types.Coin {
+	if m != nil {
+		return m.DisputeBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetVerificationDeadlineSeconds() uint64 {
+	if m != nil {
+		return m.VerificationDeadlineSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetDataRetentionBlocks() uint64 {
+	if m != nil {
+		return m.DataRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetUploadTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.UploadTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEpochMode() EpochMode {
+	if m != nil {
+		return m.EpochMode
+	}
+	return EpochModeBlocks
+}
+
+func (m *Params) GetEpochDurationSeconds() uint64 {
+	if m != nil {
+		return m.EpochDurationSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetRecordFeeFlat() types.Coin {
+	if m != nil {
+		return m.RecordFeeFlat
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRecordFeePerByte() types.Coin {
+	if m != nil {
+		return m.RecordFeePerByte
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetInvalidRecordPenalty() InvalidRecordPenalty {
+	if m != nil {
+		return m.InvalidRecordPenalty
+	}
+	return InvalidRecordPenaltySlash
+}
+
+func (m *Params) GetRecordBond() types.Coin {
+	if m != nil {
+		return m.RecordBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRecordBondForfeiture() BondForfeiture {
+	if m != nil {
+		return m.RecordBondForfeiture
+	}
+	return BondForfeitureBurn
+}
+
+func (m *Params) GetMissedEpochPenalties() []MissedEpochPenalty {
+	if m != nil {
+		return m.MissedEpochPenalties
+	}
+	return nil
+}
+
+func (m *Params) GetSignedEpochsWindow() uint64 {
+	if m != nil {
+		return m.SignedEpochsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOnboardingEpochs() uint64 {
+	if m != nil {
+		return m.OnboardingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetUnjailVerifiedRecords() uint64 {
+	if m != nil {
+		return m.UnjailVerifiedRecords
+	}
+	return 0
+}
+
+func (m *Params) GetUnjailMinJailSeconds() uint64 {
+	if m != nil {
+		return m.UnjailMinJailSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeBond() types.Coin {
+	if m != nil {
+		return m.ChallengeBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxOpenUploadsPerValidator() uint64 {
+	if m != nil {
+		return m.MaxOpenUploadsPerValidator
+	}
+	return 0
+}
+
+func (m *Params) GetVerifiedRecordsWindow() uint64 {
+	if m != nil {
+		return m.VerifiedRecordsWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pos.pos.v1.Params")
 }
@@ -118,34 +394,86 @@ func init() {
 func init() { proto.RegisterFile("pos/pos/v1/params.proto", fileDescriptor_1379ddf99a018d5b) }
 
 var fileDescriptor_1379ddf99a018d5b = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0xb6, 0x46, 0x1c, 0x95, 0xda, 0x20, 0xb8, 0x46, 0xdc, 0x44, 0x2d, 0x52, 0x7a,
-	0xd8, 0xa5, 0x78, 0xf3, 0xe0, 0xa1, 0xd8, 0x82, 0x50, 0x4b, 0x48, 0xc0, 0x83, 0x97, 0x61, 0xb2,
-	0x79, 0xd9, 0x7d, 0xb8, 0x33, 0x6f, 0x99, 0xb7, 0x86, 0xa4, 0x1f, 0xc1, 0x93, 0x1f, 0xc1, 0x8f,
-	0xe0, 0xc7, 0xe8, 0xb1, 0x47, 0xf1, 0x50, 0x64, 0x73, 0xd0, 0x8f, 0x21, 0x3b, 0xb3, 0x41, 0x05,
-	0x4f, 0x1e, 0x66, 0x19, 0xfe, 0xef, 0xc7, 0xef, 0x0d, 0x6f, 0x9f, 0xb8, 0x5f, 0x12, 0x27, 0xcd,
-	0x59, 0x1c, 0x26, 0xa5, 0xb2, 0x4a, 0x73, 0x5c, 0x5a, 0xaa, 0xa8, 0x27, 0x4a, 0xe2, 0xb8, 0x39,
-	0x8b, 0xc3, 0xfe, 0xae, 0xd2, 0x68, 0x28, 0x71, 0x5f, 0x5f, 0xee, 0xdf, 0xcb, 0x28, 0x23, 0x77,
-	0x4d, 0x9a, 0x9b, 0x4f, 0x9f, 0xd4, 0x5b, 0xa2, 0x3b, 0x72, 0x96, 0xde, 0x33, 0xb1, 0xa3, 0xd1,
-	0x48, 0x0b, 0x29, 0xd9, 0x99, 0x64, 0x3c, 0x87, 0x30, 0x18, 0x06, 0xfb, 0xdb, 0xe3, 0x3b, 0x1a,
-	0xcd, 0xd8, 0xa5, 0x13, 0x3c, 0x07, 0xc7, 0xa9, 0xe5, 0x5f, 0xdc, 0xb5, 0x96, 0x53, 0xcb, 0x3f,
-	0xb8, 0x03, 0xb1, 0xeb, 0x19, 0x96, 0x25, 0x58, 0x09, 0x25, 0xa5, 0x79, 0xb8, 0xe5, 0xc8, 0x9d,
-	0xb6, 0x30, 0x02, 0x7b, 0xdc, 0xc4, 0xbd, 0xc7, 0xe2, 0xb6, 0xab, 0xcb, 0x02, 0x4c, 0x56, 0xe5,
-	0xe1, 0xb6, 0xc3, 0x6e, 0xb9, 0xec, 0xd4, 0x45, 0xbd, 0xb9, 0x78, 0xc4, 0x85, 0xe2, 0x5c, 0xce,
-	0xad, 0x4a, 0x2b, 0x24, 0x23, 0x35, 0x32, 0xa3, 0xc9, 0xda, 0x97, 0x84, 0xd7, 0x87, 0xc1, 0xfe,
-	0xcd, 0xa3, 0xa7, 0x17, 0x57, 0x83, 0xce, 0xb7, 0xab, 0xc1, 0xc3, 0x94, 0x58, 0x13, 0xf3, 0xec,
-	0x7d, 0x8c, 0x94, 0x68, 0x55, 0xe5, 0xf1, 0x29, 0x64, 0x2a, 0x5d, 0xbd, 0x82, 0x74, 0xdc, 0x77,
-	0xa6, 0x93, 0x56, 0xf4, 0xc6, 0x7b, 0xfc, 0xd3, 0xff, 0xd1, 0x07, 0xcd, 0x42, 0x15, 0x38, 0xdb,
-	0xf4, 0xe9, 0xfe, 0x6f, 0x9f, 0xd7, 0xde, 0xd3, 0xf6, 0x39, 0x13, 0x7b, 0xcd, 0xb8, 0x17, 0x60,
-	0x71, 0x8e, 0xb0, 0xb1, 0xb3, 0x9c, 0x93, 0x95, 0x50, 0x60, 0x86, 0x53, 0x2c, 0xb0, 0x5a, 0x85,
-	0x37, 0xdc, 0x28, 0x86, 0x1a, 0xcd, 0xdb, 0x16, 0xf5, 0x02, 0x3e, 0x21, 0x7b, 0xfc, 0x9b, 0x7b,
-	0xf1, 0xe0, 0xe7, 0xe7, 0x41, 0xf0, 0xf1, 0xc7, 0x97, 0x83, 0xbb, 0xcd, 0x72, 0x2c, 0xdd, 0x8a,
-	0xf8, 0x3f, 0x7b, 0xf4, 0xf2, 0xa2, 0x8e, 0x82, 0xcb, 0x3a, 0x0a, 0xbe, 0xd7, 0x51, 0xf0, 0x69,
-	0x1d, 0x75, 0x2e, 0xd7, 0x51, 0xe7, 0xeb, 0x3a, 0xea, 0xbc, 0xdb, 0xcb, 0xb0, 0xca, 0x3f, 0x4c,
-	0xe3, 0x94, 0x74, 0x72, 0x06, 0xa4, 0x27, 0x60, 0x18, 0x92, 0x11, 0x4d, 0x5a, 0x41, 0xb5, 0x2a,
-	0x81, 0xa7, 0x5d, 0xb7, 0x2b, 0xcf, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x8f, 0x9f, 0xda,
-	0x7b, 0x02, 0x00, 0x00,
+	// 1264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x72, 0x13, 0x37,
+	0x1c, 0x8f, 0x0b, 0xa5, 0x44, 0xe1, 0x23, 0xd9, 0xd8, 0x8e, 0x12, 0x88, 0x63, 0x20, 0xa5, 0x1e,
+	0x3a, 0x63, 0x37, 0x29, 0xed, 0x81, 0x43, 0x0f, 0x26, 0x71, 0xa7, 0x94, 0x80, 0x6b, 0x43, 0x3a,
+	0xc3, 0x45, 0x23, 0xef, 0xfe, 0x6d, 0x0b, 0xb4, 0xd2, 0x56, 0x92, 0x4d, 0xc2, 0x23, 0xf4, 0xd4,
+	0x47, 0xe8, 0xb1, 0x47, 0x1e, 0x83, 0x23, 0xd3, 0x53, 0xa7, 0x07, 0xa6, 0x03, 0x07, 0xfa, 0x18,
+	0x9d, 0x95, 0xb4, 0xf6, 0x3a, 0x70, 0x88, 0x7b, 0xb0, 0x67, 0x47, 0xbf, 0x0f, 0xfd, 0xfe, 0xfa,
+	0x46, 0x6b, 0x89, 0xd4, 0x8d, 0xf4, 0x37, 0xde, 0x69, 0x24, 0x54, 0xd1, 0x58, 0xd7, 0x13, 0x25,
+	0x8d, 0x0c, 0x50, 0x22, 0x75, 0x3d, 0xfd, 0x8d, 0x77, 0x36, 0x56, 0x68, 0xcc, 0x84, 0x6c, 0xd8,
+	0x7f, 0x07, 0x6f, 0x54, 0x42, 0xa9, 0x63, 0xa9, 0x1b, 0x3d, 0xaa, 0xa1, 0x31, 0xde, 0xe9, 0x81,
+	0xa1, 0x3b, 0x8d, 0x50, 0x32, 0xe1, 0xf1, 0xe2, 0x40, 0x0e, 0xa4, 0xfd, 0x6c, 0xa4, 0x5f, 0xbe,
+	0xb5, 0x9c, 0xeb, 0x0d, 0x12, 0x19, 0x0e, 0x7d, 0x7b, 0x3e, 0x85, 0x82, 0x50, 0xaa, 0xc8, 0x01,
+	0xd7, 0xff, 0x2c, 0xa1, 0x73, 0x6d, 0x1b, 0x2b, 0xb8, 0x89, 0x2e, 0xc7, 0x4c, 0x10, 0x07, 0x13,
+	0xcd, 0x5e, 0x00, 0x2e, 0x54, 0x0b, 0xb5, 0xb3, 0x9d, 0x8b, 0x31, 0x13, 0x1d, 0xdb, 0xda, 0x65,
+	0x2f, 0xc0, 0xf2, 0xe8, 0xd1, 0x0c, 0xef, 0x13, 0xcf, 0xa3, 0x47, 0x39, 0xde, 0x2d, 0xb4, 0xe2,
+	0x38, 0x9a, 0x24, 0xa0, 0x88, 0x8d, 0x83, 0xcf, 0x58, 0xe6, 0x65, 0x0f, 0xb4, 0x41, 0xed, 0xa7,
+	0xcd, 0xc1, 0x35, 0x74, 0xc1, 0xe2, 0x84, 0x83, 0x18, 0x98, 0x21, 0x3e, 0x6b, 0x69, 0x4b, 0xb6,
+	0xed, 0xbe, 0x6d, 0x0a, 0xfa, 0x68, 0x53, 0x73, 0xaa, 0x87, 0xa4, 0xaf, 0x68, 0x68, 0x98, 0x14,
+	0x24, 0x66, 0x5a, 0x33, 0x31, 0xf0, 0x49, 0xf0, 0xa7, 0xd5, 0x42, 0x6d, 0xb1, 0x79, 0xe3, 0xd5,
+	0x9b, 0xad, 0x85, 0xbf, 0xdf, 0x6c, 0x5d, 0x71, 0xe3, 0xa7, 0xa3, 0x67, 0x75, 0x26, 0x1b, 0x31,
+	0x35, 0xc3, 0xfa, 0x7d, 0x18, 0xd0, 0xf0, 0x78, 0x0f, 0xc2, 0xce, 0x86, 0x75, 0x6a, 0x79, 0xa3,
+	0x03, 0xe7, 0xe3, 0xa2, 0x7f, 0xa4, 0x1f, 0x26, 0xc6, 0x94, 0xb3, 0x28, 0xeb, 0xe7, 0xdc, 0xff,
+	0xed, 0xe7, 0x07, 0xe7, 0xe3, 0xfb, 0x79, 0x80, 0xb6, 0xd3, 0xe1, 0x1e, 0x83, 0x62, 0x7d, 0x06,
+	0x99, 0xbb, 0x26, 0x7d, 0xa9, 0x08, 0x70, 0x36, 0x60, 0x3d, 0xc6, 0x99, 0x39, 0xc6, 0x9f, 0xd9,
+	0xa1, 0xa8, 0xc6, 0x4c, 0x1c, 0x7a, 0xaa, 0x33, 0xd0, 0x2d, 0xa9, 0xf6, 0xa7, 0xbc, 0xe0, 0x11,
+	0x5a, 0x75, 0x5e, 0x21, 0xb5, 0xa9, 0x7f, 0x19, 0x49, 0x35, 0x8a, 0xf1, 0xf9, 0xd3, 0xa7, 0x0d,
+	0xf2, 0xfa, 0x9f, 0xac, 0x3c, 0x78, 0x82, 0xca, 0x33, 0xae, 0x66, 0xa8, 0x40, 0x0f, 0x25, 0x8f,
+	0xf0, 0xe2, 0xe9, 0x8d, 0x4b, 0x79, 0x8b, 0x47, 0x99, 0x43, 0x70, 0x07, 0xad, 0xc3, 0x51, 0xc8,
+	0x47, 0x11, 0x10, 0x05, 0x9c, 0x1a, 0x88, 0xb2, 0xd1, 0x50, 0x1a, 0xa3, 0x6a, 0xa1, 0x76, 0xbe,
+	0xb3, 0xe6, 0x09, 0x1d, 0x87, 0x1f, 0x66, 0x70, 0xb0, 0x8b, 0x4a, 0xa1, 0x8c, 0x63, 0x66, 0x88,
+	0x82, 0x31, 0x50, 0x4e, 0x40, 0xd0, 0x1e, 0x87, 0x08, 0x2f, 0x59, 0xdd, 0xaa, 0x03, 0x3b, 0x16,
+	0xdb, 0x77, 0x50, 0xf0, 0x15, 0x2a, 0x7a, 0x4d, 0x02, 0x8a, 0xc9, 0x88, 0xf4, 0xb8, 0x0c, 0x9f,
+	0x69, 0x7c, 0xc1, 0x8e, 0x70, 0xe0, 0xb0, 0xb6, 0x85, 0x9a, 0x16, 0x49, 0x15, 0xde, 0x7e, 0x56,
+	0x71, 0xd1, 0x29, 0x1c, 0x36, 0xa3, 0xe0, 0xa8, 0x7a, 0x62, 0xf5, 0x8c, 0x84, 0xa3, 0x41, 0x44,
+	0x5c, 0x0f, 0xf8, 0xd2, 0xe9, 0x47, 0x6e, 0x73, 0x66, 0x01, 0x3d, 0x9e, 0x58, 0xdd, 0xb5, 0x4e,
+	0xc1, 0x43, 0xb4, 0x4d, 0xc7, 0x94, 0x71, 0xea, 0xd6, 0x00, 0x09, 0x87, 0x94, 0xa7, 0x3b, 0x08,
+	0x4e, 0xe4, 0xbd, 0x6c, 0xf3, 0x5e, 0xcb, 0x73, 0xef, 0x66, 0xd4, 0x99, 0xf8, 0x4f, 0xd1, 0xd6,
+	0x07, 0xf1, 0xbd, 0x8a, 0x03, 0x89, 0xa8, 0xa1, 0x78, 0xf9, 0xf4, 0xe9, 0xaf, 0x9e, 0x48, 0x3f,
+	0x71, 0xda, 0xa3, 0x86, 0x06, 0x2d, 0xb4, 0x25, 0x13, 0xc3, 0x62, 0xa6, 0x0d, 0x0b, 0xc9, 0xcc,
+	0x2a, 0xcb, 0x26, 0x73, 0xc5, 0x4e, 0xe6, 0xe6, 0x94, 0x76, 0x98, 0x63, 0x65, 0xd3, 0xba, 0x8b,
+	0x4a, 0x11, 0xd3, 0xc9, 0xc8, 0x00, 0x79, 0xce, 0x44, 0x24, 0x9f, 0x67, 0x55, 0x07, 0xb6, 0xea,
+	0x55, 0x0f, 0xfe, 0x6c, 0x31, 0x5f, 0xe7, 0xf7, 0xe8, 0x42, 0xa6, 0xe9, 0x49, 0x11, 0xe1, 0xd5,
+	0x6a, 0xa1, 0xb6, 0xb4, 0xbb, 0x5e, 0x77, 0xd5, 0xd4, 0xd3, 0x43, 0xb7, 0xee, 0x0f, 0xdd, 0xfa,
+	0x5d, 0xc9, 0x44, 0x73, 0x31, 0xad, 0xf7, 0x8f, 0xf7, 0x2f, 0x6f, 0x15, 0x3a, 0x4b, 0x5e, 0xd9,
+	0x94, 0x22, 0x0a, 0x9a, 0x68, 0x73, 0x26, 0x79, 0x04, 0x34, 0xe2, 0x4c, 0x00, 0xd1, 0x10, 0x4a,
+	0x11, 0x69, 0x5c, 0xb4, 0x21, 0xae, 0xe4, 0x49, 0x7b, 0x9e, 0xd3, 0x75, 0x14, 0x5b, 0x00, 0x35,
+	0x94, 0x28, 0x30, 0x20, 0xac, 0x8b, 0x2f, 0xa0, 0xec, 0x0b, 0xa0, 0x86, 0x76, 0x32, 0xcc, 0x17,
+	0xb0, 0x8b, 0x4a, 0xa3, 0x84, 0x4b, 0x1a, 0x11, 0xc3, 0x62, 0x90, 0x23, 0x93, 0x69, 0xd6, 0x9c,
+	0xc6, 0x81, 0x8f, 0x1c, 0xe6, 0x35, 0xb7, 0x11, 0x72, 0x87, 0x6c, 0x2c, 0x23, 0xc0, 0xb8, 0x5a,
+	0xa8, 0x5d, 0xda, 0x2d, 0xd5, 0xa7, 0xd7, 0x50, 0xdd, 0x9e, 0xc5, 0x07, 0x32, 0x82, 0xce, 0x22,
+	0x64, 0x9f, 0xc1, 0x6d, 0x54, 0x76, 0xaa, 0x68, 0xa4, 0x5c, 0x8d, 0x59, 0x69, 0xeb, 0xb6, 0xab,
+	0xa2, 0x45, 0xf7, 0x3c, 0x98, 0xd5, 0x74, 0x88, 0x4a, 0x0a, 0x9e, 0x53, 0x15, 0x91, 0x44, 0x4a,
+	0x4e, 0xfa, 0x00, 0x44, 0x0f, 0xa9, 0x02, 0xbc, 0x31, 0xc7, 0x79, 0xe4, 0x1c, 0xda, 0x52, 0xf2,
+	0x16, 0x40, 0x37, 0x95, 0x07, 0xf7, 0x91, 0xbf, 0x3b, 0xac, 0x65, 0x9f, 0x53, 0x83, 0xaf, 0xcc,
+	0x31, 0x77, 0x17, 0x9d, 0xb8, 0x05, 0xd0, 0xe2, 0xd4, 0x04, 0x5d, 0xb4, 0x9a, 0x73, 0x4b, 0x6f,
+	0xa9, 0xde, 0xb1, 0x01, 0x7c, 0x75, 0x0e, 0xc7, 0xe5, 0x89, 0x63, 0x1b, 0x54, 0xf3, 0xd8, 0x40,
+	0x70, 0x88, 0xca, 0xb3, 0x37, 0x06, 0x49, 0x40, 0x50, 0x6e, 0x8e, 0xf1, 0xa6, 0x1d, 0xf2, 0x6a,
+	0x7e, 0xc8, 0x67, 0xee, 0x84, 0xb6, 0xe3, 0x75, 0x8a, 0xec, 0x23, 0xad, 0xc1, 0x3e, 0x5a, 0xf2,
+	0x7e, 0x76, 0xc9, 0x56, 0xe6, 0x08, 0x89, 0x9c, 0xd0, 0xae, 0xd8, 0x36, 0x2a, 0xe7, 0x6c, 0xd2,
+	0xeb, 0xa6, 0x0f, 0xcc, 0x8c, 0x14, 0xe0, 0x2d, 0x1b, 0x6f, 0x23, 0x1f, 0x2f, 0x55, 0xb4, 0x26,
+	0x8c, 0x4e, 0x71, 0xea, 0x33, 0x6d, 0x0d, 0x08, 0x2a, 0xa7, 0x57, 0x31, 0x44, 0xee, 0x8e, 0xf7,
+	0xe5, 0x32, 0xd0, 0xb8, 0x5a, 0x3d, 0x53, 0x5b, 0xda, 0xad, 0xe4, 0x1d, 0x0f, 0x2c, 0xd3, 0xae,
+	0x34, 0x5f, 0x58, 0x3e, 0x68, 0x31, 0x3e, 0x09, 0x33, 0xb0, 0xc7, 0xb0, 0x66, 0x03, 0x91, 0x75,
+	0xa0, 0xfd, 0x3e, 0xc7, 0xd7, 0xdc, 0x31, 0xec, 0x30, 0xab, 0xd1, 0x6e, 0x97, 0xa7, 0xcb, 0x2f,
+	0xbd, 0x5c, 0xbd, 0x2a, 0x9d, 0x58, 0x2f, 0xb9, 0x3e, 0xc7, 0xf2, 0x8b, 0x99, 0xe8, 0x5a, 0x83,
+	0x36, 0x28, 0xef, 0xfb, 0x25, 0x5a, 0x91, 0xa2, 0x27, 0xa9, 0x8a, 0xd2, 0x87, 0x87, 0x4b, 0x83,
+	0x6f, 0xd8, 0x18, 0xcb, 0x53, 0xc0, 0x45, 0x09, 0xbe, 0x45, 0x6b, 0x23, 0xf1, 0x94, 0x32, 0xfe,
+	0xc1, 0x25, 0x8f, 0xb7, 0xad, 0xa4, 0xe4, 0xe0, 0x13, 0xf7, 0x7a, 0xf0, 0xcd, 0x44, 0x97, 0xd6,
+	0x60, 0x3f, 0xb2, 0x2d, 0xf7, 0xb9, 0xdb, 0x72, 0x0e, 0x3e, 0x60, 0xe2, 0x1e, 0x65, 0x3c, 0xdb,
+	0x72, 0x3f, 0xa2, 0x4b, 0xd3, 0xf3, 0xdf, 0x2e, 0x91, 0x9b, 0xf3, 0xec, 0x8c, 0x89, 0xd6, 0x9f,
+	0x6b, 0x95, 0xf4, 0x91, 0x27, 0x13, 0x10, 0xc4, 0x9d, 0x25, 0xee, 0x15, 0x67, 0xd7, 0x25, 0x35,
+	0x52, 0xe1, 0x2f, 0x6c, 0x94, 0x8d, 0x98, 0x1e, 0x3d, 0x4c, 0x40, 0x3c, 0x76, 0x9c, 0x36, 0xa8,
+	0xc3, 0x8c, 0x91, 0xd6, 0xff, 0xc1, 0xeb, 0xc6, 0x4f, 0x43, 0xcd, 0xd5, 0x3f, 0x9e, 0xad, 0xdc,
+	0x0d, 0xf2, 0x9d, 0xf5, 0x7f, 0x7f, 0xdf, 0x2a, 0xfc, 0xfa, 0xfe, 0xe5, 0xad, 0xe5, 0xf4, 0xc5,
+	0x7a, 0x64, 0xdf, 0xad, 0xee, 0x8d, 0x7a, 0xef, 0xec, 0xf9, 0xd2, 0x72, 0xb9, 0x53, 0x39, 0x71,
+	0x47, 0xd1, 0x9e, 0x06, 0x61, 0x26, 0x8f, 0x87, 0xe6, 0x77, 0xaf, 0xde, 0x56, 0x0a, 0xaf, 0xdf,
+	0x56, 0x0a, 0xff, 0xbc, 0xad, 0x14, 0x7e, 0x7b, 0x57, 0x59, 0x78, 0xfd, 0xae, 0xb2, 0xf0, 0xd7,
+	0xbb, 0xca, 0xc2, 0x93, 0xed, 0x01, 0x33, 0xc3, 0x51, 0xaf, 0x1e, 0xca, 0xb8, 0xf1, 0x00, 0x64,
+	0xdc, 0x05, 0xa1, 0xa1, 0xd1, 0x96, 0x5d, 0xdf, 0x8d, 0x39, 0x4e, 0x40, 0xf7, 0xce, 0xd9, 0xb7,
+	0xf1, 0xd7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x4d, 0xe3, 0xfe, 0x66, 0xbc, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -188,6 +516,107 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MinVerifiedRecordsForEligibility != that1.MinVerifiedRecordsForEligibility {
 		return false
 	}
+	if !this.VerificationQuorum.Equal(that1.VerificationQuorum) {
+		return false
+	}
+	if !this.VerificationThreshold.Equal(that1.VerificationThreshold) {
+		return false
+	}
+	if this.ExcludeRelatedVerifiers != that1.ExcludeRelatedVerifiers {
+		return false
+	}
+	if this.CommitRevealEnabled != that1.CommitRevealEnabled {
+		return false
+	}
+	if this.CommitPeriodBlocks != that1.CommitPeriodBlocks {
+		return false
+	}
+	if this.RevealPeriodBlocks != that1.RevealPeriodBlocks {
+		return false
+	}
+	if !this.SlashFractionUnrevealedCommit.Equal(that1.SlashFractionUnrevealedCommit) {
+		return false
+	}
+	if this.AvailabilityChallengePeriodBlocks != that1.AvailabilityChallengePeriodBlocks {
+		return false
+	}
+	if !this.SlashFractionUnavailableData.Equal(that1.SlashFractionUnavailableData) {
+		return false
+	}
+	if this.OptimisticVerificationEnabled != that1.OptimisticVerificationEnabled {
+		return false
+	}
+	if this.DisputeWindowBlocks != that1.DisputeWindowBlocks {
+		return false
+	}
+	if !this.DisputeBond.Equal(&that1.DisputeBond) {
+		return false
+	}
+	if this.VerificationDeadlineSeconds != that1.VerificationDeadlineSeconds {
+		return false
+	}
+	if this.DataRetentionBlocks != that1.DataRetentionBlocks {
+		return false
+	}
+	if this.UploadTimeoutBlocks != that1.UploadTimeoutBlocks {
+		return false
+	}
+	if this.EpochMode != that1.EpochMode {
+		return false
+	}
+	if this.EpochDurationSeconds != that1.EpochDurationSeconds {
+		return false
+	}
+	if !this.RewardPoolFeeShare.Equal(that1.RewardPoolFeeShare) {
+		return false
+	}
+	if !this.RecordFeeFlat.Equal(&that1.RecordFeeFlat) {
+		return false
+	}
+	if !this.RecordFeePerByte.Equal(&that1.RecordFeePerByte) {
+		return false
+	}
+	if this.InvalidRecordPenalty != that1.InvalidRecordPenalty {
+		return false
+	}
+	if !this.RecordBond.Equal(&that1.RecordBond) {
+		return false
+	}
+	if this.RecordBondForfeiture != that1.RecordBondForfeiture {
+		return false
+	}
+	if len(this.MissedEpochPenalties) != len(that1.MissedEpochPenalties) {
+		return false
+	}
+	for i := range this.MissedEpochPenalties {
+		if !this.MissedEpochPenalties[i].Equal(&that1.MissedEpochPenalties[i]) {
+			return false
+		}
+	}
+	if this.SignedEpochsWindow != that1.SignedEpochsWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.OnboardingEpochs != that1.OnboardingEpochs {
+		return false
+	}
+	if this.UnjailVerifiedRecords != that1.UnjailVerifiedRecords {
+		return false
+	}
+	if this.UnjailMinJailSeconds != that1.UnjailMinJailSeconds {
+		return false
+	}
+	if !this.ChallengeBond.Equal(&that1.ChallengeBond) {
+		return false
+	}
+	if this.MaxOpenUploadsPerValidator != that1.MaxOpenUploadsPerValidator {
+		return false
+	}
+	if this.VerifiedRecordsWindow != that1.VerifiedRecordsWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -210,71 +639,358 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MinVerifiedRecordsForEligibility != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MinVerifiedRecordsForEligibility))
+	if m.VerifiedRecordsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VerifiedRecordsWindow))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxOpenUploadsPerValidator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenUploadsPerValidator))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
 	}
 	{
-		size := m.SlashFractionInvalidRecord.Size()
-		i -= size
-		if _, err := m.SlashFractionInvalidRecord.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.ChallengeBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
+	if m.UnjailMinJailSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnjailMinJailSeconds))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.UnjailVerifiedRecords != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnjailVerifiedRecords))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.OnboardingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OnboardingEpochs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
 	{
-		size := m.SlashFractionMissingRecord.Size()
+		size := m.MinSignedPerWindow.Size()
 		i -= size
-		if _, err := m.SlashFractionMissingRecord.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.EpochLength != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x92
+	if m.SignedEpochsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedEpochsWindow))
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.RecordsPerEpoch != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.RecordsPerEpoch))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x88
 	}
-	if m.MaxRecordSize != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordSize))
-		i--
-		dAtA[i] = 0x10
+	if len(m.MissedEpochPenalties) > 0 {
+		for iNdEx := len(m.MissedEpochPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedEpochPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if m.MinRecordSize != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MinRecordSize))
+	if m.RecordBondForfeiture != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecordBondForfeiture))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.RecordBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if m.InvalidRecordPenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InvalidRecordPenalty))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size, err := m.RecordFeePerByte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size, err := m.RecordFeeFlat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	{
+		size := m.RewardPoolFeeShare.Size()
+		i -= size
+		if _, err := m.RewardPoolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.EpochDurationSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochDurationSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.EpochMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.UploadTimeoutBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UploadTimeoutBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DataRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DataRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.VerificationDeadlineSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VerificationDeadlineSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size, err := m.DisputeBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.DisputeWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.OptimisticVerificationEnabled {
+		i--
+		if m.OptimisticVerificationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.SlashFractionUnavailableData.Size()
+		i -= size
+		if _, err := m.SlashFractionUnavailableData.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.AvailabilityChallengePeriodBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AvailabilityChallengePeriodBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.SlashFractionUnrevealedCommit.Size()
+		i -= size
+		if _, err := m.SlashFractionUnrevealedCommit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.RevealPeriodBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevealPeriodBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommitPeriodBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitPeriodBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExcludeRelatedVerifiers {
+		i--
+		if m.ExcludeRelatedVerifiers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.VerificationThreshold.Size()
+		i -= size
+		if _, err := m.VerificationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.VerificationQuorum.Size()
+		i -= size
+		if _, err := m.VerificationQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MinVerifiedRecordsForEligibility != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinVerifiedRecordsForEligibility))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SlashFractionInvalidRecord.Size()
+		i -= size
+		if _, err := m.SlashFractionInvalidRecord.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFractionMissingRecord.Size()
+		i -= size
+		if _, err := m.SlashFractionMissingRecord.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecordsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecordsPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxRecordSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinRecordSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRecordSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.MinRecordSize != 0 {
 		n += 1 + sovParams(uint64(m.MinRecordSize))
 	}
@@ -294,6 +1010,94 @@ func (m *Params) Size() (n int) {
 	if m.MinVerifiedRecordsForEligibility != 0 {
 		n += 1 + sovParams(uint64(m.MinVerifiedRecordsForEligibility))
 	}
+	l = m.VerificationQuorum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VerificationThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ExcludeRelatedVerifiers {
+		n += 2
+	}
+	if m.CommitRevealEnabled {
+		n += 2
+	}
+	if m.CommitPeriodBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CommitPeriodBlocks))
+	}
+	if m.RevealPeriodBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RevealPeriodBlocks))
+	}
+	l = m.SlashFractionUnrevealedCommit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AvailabilityChallengePeriodBlocks != 0 {
+		n += 1 + sovParams(uint64(m.AvailabilityChallengePeriodBlocks))
+	}
+	l = m.SlashFractionUnavailableData.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.OptimisticVerificationEnabled {
+		n += 3
+	}
+	if m.DisputeWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DisputeWindowBlocks))
+	}
+	l = m.DisputeBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.VerificationDeadlineSeconds != 0 {
+		n += 2 + sovParams(uint64(m.VerificationDeadlineSeconds))
+	}
+	if m.DataRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DataRetentionBlocks))
+	}
+	if m.UploadTimeoutBlocks != 0 {
+		n += 2 + sovParams(uint64(m.UploadTimeoutBlocks))
+	}
+	if m.EpochMode != 0 {
+		n += 2 + sovParams(uint64(m.EpochMode))
+	}
+	if m.EpochDurationSeconds != 0 {
+		n += 2 + sovParams(uint64(m.EpochDurationSeconds))
+	}
+	l = m.RewardPoolFeeShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RecordFeeFlat.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RecordFeePerByte.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.InvalidRecordPenalty != 0 {
+		n += 2 + sovParams(uint64(m.InvalidRecordPenalty))
+	}
+	l = m.RecordBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.RecordBondForfeiture != 0 {
+		n += 2 + sovParams(uint64(m.RecordBondForfeiture))
+	}
+	if len(m.MissedEpochPenalties) > 0 {
+		for _, e := range m.MissedEpochPenalties {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SignedEpochsWindow != 0 {
+		n += 2 + sovParams(uint64(m.SignedEpochsWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.OnboardingEpochs != 0 {
+		n += 2 + sovParams(uint64(m.OnboardingEpochs))
+	}
+	if m.UnjailVerifiedRecords != 0 {
+		n += 2 + sovParams(uint64(m.UnjailVerifiedRecords))
+	}
+	if m.UnjailMinJailSeconds != 0 {
+		n += 2 + sovParams(uint64(m.UnjailMinJailSeconds))
+	}
+	l = m.ChallengeBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxOpenUploadsPerValidator != 0 {
+		n += 2 + sovParams(uint64(m.MaxOpenUploadsPerValidator))
+	}
+	if m.VerifiedRecordsWindow != 0 {
+		n += 2 + sovParams(uint64(m.VerifiedRecordsWindow))
+	}
 	return n
 }
 
@@ -313,30 +1117,574 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowParams
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRecordSize", wireType)
+			}
+			m.MinRecordSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRecordSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordSize", wireType)
+			}
+			m.MaxRecordSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsPerEpoch", wireType)
+			}
+			m.RecordsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMissingRecord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMissingRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionInvalidRecord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionInvalidRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVerifiedRecordsForEligibility", wireType)
+			}
+			m.MinVerifiedRecordsForEligibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVerifiedRecordsForEligibility |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerificationQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerificationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeRelatedVerifiers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeRelatedVerifiers = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitPeriodBlocks", wireType)
+			}
+			m.CommitPeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitPeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriodBlocks", wireType)
+			}
+			m.RevealPeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionUnrevealedCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionUnrevealedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityChallengePeriodBlocks", wireType)
+			}
+			m.AvailabilityChallengePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailabilityChallengePeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionUnavailableData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionUnavailableData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticVerificationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptimisticVerificationEnabled = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindowBlocks", wireType)
+			}
+			m.DisputeWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationDeadlineSeconds", wireType)
+			}
+			m.VerificationDeadlineSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationDeadlineSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRetentionBlocks", wireType)
+			}
+			m.DataRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTimeoutBlocks", wireType)
+			}
+			m.UploadTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMode", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EpochMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochMode |= EpochMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRecordSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDurationSeconds", wireType)
 			}
-			m.MinRecordSize = 0
+			m.EpochDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -346,16 +1694,116 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinRecordSize |= uint64(b&0x7F) << shift
+				m.EpochDurationSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPoolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFeeFlat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordFeeFlat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordFeePerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordFeePerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidRecordPenalty", wireType)
 			}
-			m.MaxRecordSize = 0
+			m.InvalidRecordPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -365,16 +1813,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRecordSize |= uint64(b&0x7F) << shift
+				m.InvalidRecordPenalty |= InvalidRecordPenalty(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsPerEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordBondForfeiture", wireType)
 			}
-			m.RecordsPerEpoch = 0
+			m.RecordBondForfeiture = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -384,16 +1865,50 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordsPerEpoch |= uint64(b&0x7F) << shift
+				m.RecordBondForfeiture |= BondForfeiture(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedEpochPenalties = append(m.MissedEpochPenalties, MissedEpochPenalty{})
+			if err := m.MissedEpochPenalties[len(m.MissedEpochPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedEpochsWindow", wireType)
 			}
-			m.EpochLength = 0
+			m.SignedEpochsWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -403,14 +1918,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= uint64(b&0x7F) << shift
+				m.SignedEpochsWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMissingRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -438,15 +1953,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionMissingRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnboardingEpochs", wireType)
+			}
+			m.OnboardingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnboardingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailVerifiedRecords", wireType)
+			}
+			m.UnjailVerifiedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailVerifiedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailMinJailSeconds", wireType)
+			}
+			m.UnjailMinJailSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailMinJailSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionInvalidRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBond", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -456,31 +2028,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionInvalidRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChallengeBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 39:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVerifiedRecordsForEligibility", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenUploadsPerValidator", wireType)
 			}
-			m.MinVerifiedRecordsForEligibility = 0
+			m.MaxOpenUploadsPerValidator = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -490,7 +2061,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinVerifiedRecordsForEligibility |= uint64(b&0x7F) << shift
+				m.MaxOpenUploadsPerValidator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecordsWindow", wireType)
+			}
+			m.VerifiedRecordsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecordsWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+func TestMissedEpochPenalties(t *testing.T) {
+	warn := types.MissedEpochPenalty{MissedEpochs: 1, Action: types.PenaltyWarn, SlashFraction: math.LegacyZeroDec()}
+	slash := types.MissedEpochPenalty{MissedEpochs: 2, Action: types.PenaltySlash, SlashFraction: math.LegacyNewDecWithPrec(1, 2)}
+	jail := types.MissedEpochPenalty{MissedEpochs: 4, Action: types.PenaltyJail, SlashFraction: math.LegacyZeroDec(), JailDurationSeconds: 60}
+
+	tests := []struct {
+		name      string
+		penalties []types.MissedEpochPenalty
+		valid     bool
+	}{
+		{name: "empty", valid: true},
+		{name: "escalating", penalties: []types.MissedEpochPenalty{warn, slash, jail}, valid: true},
+		{name: "out of order", penalties: []types.MissedEpochPenalty{slash, warn}},
+		{name: "zero missed epochs", penalties: []types.MissedEpochPenalty{{Action: types.PenaltyWarn, SlashFraction: math.LegacyZeroDec()}}},
+		{name: "slash without fraction", penalties: []types.MissedEpochPenalty{{MissedEpochs: 1, Action: types.PenaltySlash, SlashFraction: math.LegacyZeroDec()}}},
+		{name: "jail without duration", penalties: []types.MissedEpochPenalty{{MissedEpochs: 1, Action: types.PenaltyJail, SlashFraction: math.LegacyZeroDec()}}},
+		{name: "no action", penalties: []types.MissedEpochPenalty{{MissedEpochs: 1, Action: types.PenaltyNone, SlashFraction: math.LegacyZeroDec()}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MissedEpochPenalties = tc.penalties
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	params := types.DefaultParams()
+	penalty, ok := params.MissedEpochPenalty(3)
+	require.True(t, ok)
+	require.Equal(t, types.PenaltySlash, penalty.Action)
+	require.Equal(t, params.SlashFractionMissingRecord, penalty.SlashFraction)
+
+	params.MissedEpochPenalties = []types.MissedEpochPenalty{slash, jail}
+	_, ok = params.MissedEpochPenalty(1)
+	require.False(t, ok)
+	penalty, _ = params.MissedEpochPenalty(3)
+	require.Equal(t, slash, penalty)
+	penalty, _ = params.MissedEpochPenalty(9)
+	require.Equal(t, jail, penalty)
+}
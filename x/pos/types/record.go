@@ -0,0 +1,74 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// ParseRecordStatus parses a record status from its enum name or its short
+// form, e.g. "RECORD_STATUS_VERIFIED" or "verified".
+func ParseRecordStatus(s string) (RecordStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, "RECORD_STATUS_") {
+		name = "RECORD_STATUS_" + name
+	}
+
+	status, ok := RecordStatus_value[name]
+	if !ok {
+		return RecordStatusUnspecified, fmt.Errorf("unknown record status %q", s)
+	}
+	return RecordStatus(status), nil
+}
+
+// VerificationCommitment returns the commitment a verifier submits with
+// MsgCommitVerification: sha256(record_id | verifier | approved | salt).
+func VerificationCommitment(recordID, verifier string, approved bool, salt []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(recordID))
+	hasher.Write([]byte("|"))
+	hasher.Write([]byte(verifier))
+	hasher.Write([]byte("|"))
+	hasher.Write([]byte(fmt.Sprintf("%t", approved)))
+	hasher.Write([]byte("|"))
+	hasher.Write(salt)
+	return hasher.Sum(nil)
+}
+
+const (
+	// MaxDataLocatorLength is the maximum length of an off-chain record's data locator
+	MaxDataLocatorLength = 512
+
+	// MaxRecordVersions is the maximum number of records in a version chain,
+	// the original submission included
+	MaxRecordVersions = 16
+)
+
+// IsOffChain reports whether the record data is kept off chain
+func (r Record) IsOffChain() bool {
+	return r.DataLocator != ""
+}
+
+// ChunkCount returns the number of merkle tree chunks of the record data
+func (r Record) ChunkCount() uint64 {
+	return (r.DataSize + RecordChunkSize - 1) / RecordChunkSize
+}
+
+// IsEncoded reports whether the record data is stored compressed
+func (r Record) IsEncoded() bool {
+	return r.ContentEncoding != ContentEncodingIdentity
+}
+
+// PayloadSize returns the size of the record data once decoded
+func (r Record) PayloadSize() uint64 {
+	if r.IsEncoded() {
+		return r.DecodedSize
+	}
+	return r.DataSize
+}
+
+// InGracePeriod reports whether the validator is in its onboarding grace
+// period in the given epoch
+func (s ValidatorRecordStats) InGracePeriod(epoch uint64) bool {
+	return epoch <= s.GraceEndEpoch
+}
@@ -5,7 +5,11 @@ package types
 
 import (
 	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +29,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ContentEncoding defines the compression applied to record data
+type ContentEncoding int32
+
+const (
+	// the data is stored as submitted
+	ContentEncodingIdentity ContentEncoding = 0
+	ContentEncodingGzip     ContentEncoding = 1
+	ContentEncodingZstd     ContentEncoding = 2
+)
+
+var ContentEncoding_name = map[int32]string{
+	0: "CONTENT_ENCODING_IDENTITY",
+	1: "CONTENT_ENCODING_GZIP",
+	2: "CONTENT_ENCODING_ZSTD",
+}
+
+var ContentEncoding_value = map[string]int32{
+	"CONTENT_ENCODING_IDENTITY": 0,
+	"CONTENT_ENCODING_GZIP":     1,
+	"CONTENT_ENCODING_ZSTD":     2,
+}
+
+func (x ContentEncoding) String() string {
+	return proto.EnumName(ContentEncoding_name, int32(x))
+}
+
+func (ContentEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{0}
+}
+
 // RecordStatus defines the status of a record
 type RecordStatus int32
 
@@ -33,6 +67,10 @@ const (
 	RecordStatusPending     RecordStatus = 1
 	RecordStatusVerified    RecordStatus = 2
 	RecordStatusRejected    RecordStatus = 3
+	// the record was still pending when its verification deadline passed
+	RecordStatusExpired RecordStatus = 4
+	// the record was amended while still pending and was never settled
+	RecordStatusSuperseded RecordStatus = 5
 )
 
 var RecordStatus_name = map[int32]string{
@@ -40,6 +78,8 @@ var RecordStatus_name = map[int32]string{
 	1: "RECORD_STATUS_PENDING",
 	2: "RECORD_STATUS_VERIFIED",
 	3: "RECORD_STATUS_REJECTED",
+	4: "RECORD_STATUS_EXPIRED",
+	5: "RECORD_STATUS_SUPERSEDED",
 }
 
 var RecordStatus_value = map[string]int32{
@@ -47,6 +87,8 @@ var RecordStatus_value = map[string]int32{
 	"RECORD_STATUS_PENDING":     1,
 	"RECORD_STATUS_VERIFIED":    2,
 	"RECORD_STATUS_REJECTED":    3,
+	"RECORD_STATUS_EXPIRED":     4,
+	"RECORD_STATUS_SUPERSEDED":  5,
 }
 
 func (x RecordStatus) String() string {
@@ -54,7 +96,67 @@ func (x RecordStatus) String() string {
 }
 
 func (RecordStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c0857de429b972bc, []int{0}
+	return fileDescriptor_c0857de429b972bc, []int{1}
+}
+
+// InvalidRecordPenalty defines how the submitter of a rejected record is penalized
+type InvalidRecordPenalty int32
+
+const (
+	// INVALID_RECORD_PENALTY_SLASH slashes the stake of the submitter
+	InvalidRecordPenaltySlash InvalidRecordPenalty = 0
+	// INVALID_RECORD_PENALTY_BOND forfeits the submission bond of the record
+	InvalidRecordPenaltyBond InvalidRecordPenalty = 1
+	// INVALID_RECORD_PENALTY_BOTH forfeits the bond and slashes the stake
+	InvalidRecordPenaltyBoth InvalidRecordPenalty = 2
+)
+
+var InvalidRecordPenalty_name = map[int32]string{
+	0: "INVALID_RECORD_PENALTY_SLASH",
+	1: "INVALID_RECORD_PENALTY_BOND",
+	2: "INVALID_RECORD_PENALTY_BOTH",
+}
+
+var InvalidRecordPenalty_value = map[string]int32{
+	"INVALID_RECORD_PENALTY_SLASH": 0,
+	"INVALID_RECORD_PENALTY_BOND":  1,
+	"INVALID_RECORD_PENALTY_BOTH":  2,
+}
+
+func (x InvalidRecordPenalty) String() string {
+	return proto.EnumName(InvalidRecordPenalty_name, int32(x))
+}
+
+func (InvalidRecordPenalty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{2}
+}
+
+// BondForfeiture defines what happens to a forfeited submission bond
+type BondForfeiture int32
+
+const (
+	// BOND_FORFEITURE_BURN burns the bond
+	BondForfeitureBurn BondForfeiture = 0
+	// BOND_FORFEITURE_COMMUNITY_POOL sends the bond to the community pool
+	BondForfeitureCommunityPool BondForfeiture = 1
+)
+
+var BondForfeiture_name = map[int32]string{
+	0: "BOND_FORFEITURE_BURN",
+	1: "BOND_FORFEITURE_COMMUNITY_POOL",
+}
+
+var BondForfeiture_value = map[string]int32{
+	"BOND_FORFEITURE_BURN":           0,
+	"BOND_FORFEITURE_COMMUNITY_POOL": 1,
+}
+
+func (x BondForfeiture) String() string {
+	return proto.EnumName(BondForfeiture_name, int32(x))
+}
+
+func (BondForfeiture) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{3}
 }
 
 // Record represents a proof-of-record submission by a validator
@@ -66,6 +168,46 @@ type Record struct {
 	Status           RecordStatus `protobuf:"varint,5,opt,name=status,proto3,enum=pos.pos.v1.RecordStatus" json:"status,omitempty"`
 	MerkleRoot       string       `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty" yaml:"merkle_root"`
 	BlockHeight      uint64       `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// epoch is the epoch the record was submitted in. It is fixed at submission
+	// time so records can be indexed by (validator, epoch).
+	Epoch uint64 `protobuf:"varint,8,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// data_size is the size of the record data in bytes
+	DataSize uint64 `protobuf:"varint,9,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	// data_locator tells where the data of an off-chain record can be fetched.
+	// Off-chain records keep only their merkle root, size and locator on chain
+	// and leave data empty.
+	DataLocator string `protobuf:"bytes,10,opt,name=data_locator,json=dataLocator,proto3" json:"data_locator,omitempty"`
+	// dispute_deadline_height is set for records submitted under optimistic
+	// verification: unless disputed before this height the record is verified
+	// automatically
+	DisputeDeadlineHeight uint64 `protobuf:"varint,11,opt,name=dispute_deadline_height,json=disputeDeadlineHeight,proto3" json:"dispute_deadline_height,omitempty"`
+	// data_pruned is set once the record data was deleted after the data
+	// retention period; id, status, merkle root, size and heights are kept
+	DataPruned   bool   `protobuf:"varint,12,opt,name=data_pruned,json=dataPruned,proto3" json:"data_pruned,omitempty"`
+	PrunedHeight uint64 `protobuf:"varint,13,opt,name=pruned_height,json=prunedHeight,proto3" json:"pruned_height,omitempty"`
+	// record_type is the id of the registered record type the data conforms to,
+	// empty for untyped records
+	RecordType string `protobuf:"bytes,14,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// content_encoding is the compression applied to data. Size limits and the
+	// record type schema apply to the decoded data, while the merkle root and
+	// data_size cover the data as stored.
+	ContentEncoding ContentEncoding `protobuf:"varint,15,opt,name=content_encoding,json=contentEncoding,proto3,enum=pos.pos.v1.ContentEncoding" json:"content_encoding,omitempty"`
+	// decoded_size is the size of the decoded data of an encoded record
+	DecodedSize uint64 `protobuf:"varint,16,opt,name=decoded_size,json=decodedSize,proto3" json:"decoded_size,omitempty"`
+	// supersedes_id is the id of the record this record amends, empty for an
+	// original submission
+	SupersedesId string `protobuf:"bytes,17,opt,name=supersedes_id,json=supersedesId,proto3" json:"supersedes_id,omitempty"`
+	// superseded_by is the id of the record amending this record
+	SupersededBy string `protobuf:"bytes,18,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	// version counts the amendments before this record in its version chain,
+	// zero for an original submission
+	Version uint64 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
+	// fee is the submission fee escrowed until the record is settled, when it is
+	// paid to the verifiers that voted with the outcome
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// bond is the submission bond escrowed until the record is settled; it is
+	// returned unless the record is rejected
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,21,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
 }
 
 func (m *Record) Reset()         { *m = Record{} }
@@ -150,15 +292,141 @@ func (m *Record) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *Record) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Record) GetDataSize() uint64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+func (m *Record) GetDataLocator() string {
+	if m != nil {
+		return m.DataLocator
+	}
+	return ""
+}
+
+func (m *Record) GetDisputeDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DisputeDeadlineHeight
+	}
+	return 0
+}
+
+func (m *Record) GetDataPruned() bool {
+	if m != nil {
+		return m.DataPruned
+	}
+	return false
+}
+
+func (m *Record) GetPrunedHeight() uint64 {
+	if m != nil {
+		return m.PrunedHeight
+	}
+	return 0
+}
+
+func (m *Record) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *Record) GetContentEncoding() ContentEncoding {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ContentEncodingIdentity
+}
+
+func (m *Record) GetDecodedSize() uint64 {
+	if m != nil {
+		return m.DecodedSize
+	}
+	return 0
+}
+
+func (m *Record) GetSupersedesId() string {
+	if m != nil {
+		return m.SupersedesId
+	}
+	return ""
+}
+
+func (m *Record) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+func (m *Record) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Record) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *Record) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
 // ValidatorRecordStats tracks record submission stats for a validator
 type ValidatorRecordStats struct {
-	ValidatorAddress       string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
-	TotalRecords           uint64 `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
-	VerifiedRecords        uint64 `protobuf:"varint,3,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
-	RejectedRecords        uint64 `protobuf:"varint,4,opt,name=rejected_records,json=rejectedRecords,proto3" json:"rejected_records,omitempty"`
-	LastRecordTime         int64  `protobuf:"varint,5,opt,name=last_record_time,json=lastRecordTime,proto3" json:"last_record_time,omitempty"`
-	IsEligible             bool   `protobuf:"varint,6,opt,name=is_eligible,json=isEligible,proto3" json:"is_eligible,omitempty"`
-	NextRequiredRecordTime int64  `protobuf:"varint,7,opt,name=next_required_record_time,json=nextRequiredRecordTime,proto3" json:"next_required_record_time,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	TotalRecords     uint64 `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	VerifiedRecords  uint64 `protobuf:"varint,3,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	RejectedRecords  uint64 `protobuf:"varint,4,opt,name=rejected_records,json=rejectedRecords,proto3" json:"rejected_records,omitempty"`
+	LastRecordTime   int64  `protobuf:"varint,5,opt,name=last_record_time,json=lastRecordTime,proto3" json:"last_record_time,omitempty"`
+	IsEligible       bool   `protobuf:"varint,6,opt,name=is_eligible,json=isEligible,proto3" json:"is_eligible,omitempty"`
+	// next_required_record_time is no longer maintained, see
+	// next_required_record_epoch
+	NextRequiredRecordTime int64  `protobuf:"varint,7,opt,name=next_required_record_time,json=nextRequiredRecordTime,proto3" json:"next_required_record_time,omitempty"` // Deprecated: Do not use.
+	ExpiredRecords         uint64 `protobuf:"varint,8,opt,name=expired_records,json=expiredRecords,proto3" json:"expired_records,omitempty"`
+	// amended_records counts the amendments the validator submitted; they are
+	// not included in total_records
+	AmendedRecords uint64 `protobuf:"varint,9,opt,name=amended_records,json=amendedRecords,proto3" json:"amended_records,omitempty"`
+	// last_record_epoch is the epoch of the last record the validator submitted
+	LastRecordEpoch uint64 `protobuf:"varint,10,opt,name=last_record_epoch,json=lastRecordEpoch,proto3" json:"last_record_epoch,omitempty"`
+	// next_required_record_epoch is the first epoch the validator must have
+	// submitted a record in by the time it ends to stay eligible
+	NextRequiredRecordEpoch uint64 `protobuf:"varint,11,opt,name=next_required_record_epoch,json=nextRequiredRecordEpoch,proto3" json:"next_required_record_epoch,omitempty"`
+	// consecutive_missed_epochs is the number of epochs in a row the validator
+	// ended without meeting its record requirement; it is reset by an epoch in
+	// which it met it
+	ConsecutiveMissedEpochs uint64 `protobuf:"varint,12,opt,name=consecutive_missed_epochs,json=consecutiveMissedEpochs,proto3" json:"consecutive_missed_epochs,omitempty"`
+	// jailed_until is the unix time until which the missed epoch penalties
+	// jailed the validator; zero while it is not jailed by them
+	JailedUntil int64 `protobuf:"varint,13,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// grace_end_epoch is the last epoch of the onboarding grace period of the
+	// validator, in which it is not penalized for missing its record
+	// requirement
+	GraceEndEpoch uint64 `protobuf:"varint,14,opt,name=grace_end_epoch,json=graceEndEpoch,proto3" json:"grace_end_epoch,omitempty"`
+	// jailed_at is the unix time the missed epoch penalties last jailed the
+	// validator at; zero while it is not jailed by them
+	JailedAt int64 `protobuf:"varint,15,opt,name=jailed_at,json=jailedAt,proto3" json:"jailed_at,omitempty"`
+	// verified_records_at_jail is verified_records when the validator was
+	// jailed; records verified since count towards unjailing it
+	VerifiedRecordsAtJail uint64 `protobuf:"varint,16,opt,name=verified_records_at_jail,json=verifiedRecordsAtJail,proto3" json:"verified_records_at_jail,omitempty"`
 }
 
 func (m *ValidatorRecordStats) Reset()         { *m = ValidatorRecordStats{} }
@@ -236,6 +504,7 @@ func (m *ValidatorRecordStats) GetIsEligible() bool {
 	return false
 }
 
+// Deprecated: Do not use.
 func (m *ValidatorRecordStats) GetNextRequiredRecordTime() int64 {
 	if m != nil {
 		return m.NextRequiredRecordTime
@@ -243,355 +512,2906 @@ func (m *ValidatorRecordStats) GetNextRequiredRecordTime() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("pos.pos.v1.RecordStatus", RecordStatus_name, RecordStatus_value)
-	proto.RegisterType((*Record)(nil), "pos.pos.v1.Record")
-	proto.RegisterType((*ValidatorRecordStats)(nil), "pos.pos.v1.ValidatorRecordStats")
+func (m *ValidatorRecordStats) GetExpiredRecords() uint64 {
+	if m != nil {
+		return m.ExpiredRecords
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("pos/pos/v1/record.proto", fileDescriptor_c0857de429b972bc) }
-
-var fileDescriptor_c0857de429b972bc = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xce, 0x26, 0xfe, 0xd3, 0xbf, 0xdb, 0x50, 0xd2, 0xa5, 0xb4, 0x6e, 0xa8, 0x1c, 0x13, 0x38,
-	0x04, 0x0e, 0x31, 0x2d, 0x48, 0x88, 0x1e, 0x90, 0xda, 0xc6, 0x40, 0x38, 0x84, 0x68, 0x93, 0xf6,
-	0xc0, 0xc5, 0x72, 0xe2, 0x25, 0x5d, 0x6a, 0x7b, 0x8d, 0x77, 0x1b, 0xb5, 0x6f, 0x80, 0x7a, 0xe2,
-	0x05, 0x2a, 0x21, 0x71, 0xe2, 0x4d, 0x38, 0xf6, 0xc8, 0xa9, 0x42, 0xed, 0xa5, 0x47, 0xd4, 0x27,
-	0x40, 0xde, 0x75, 0x1a, 0x57, 0xe1, 0xc4, 0x61, 0xad, 0xf1, 0x37, 0xdf, 0x37, 0x33, 0xfb, 0x8d,
-	0x6c, 0xb8, 0x1c, 0x31, 0x6e, 0x25, 0x67, 0xb4, 0x66, 0xc5, 0x64, 0xc0, 0x62, 0xaf, 0x11, 0xc5,
-	0x4c, 0x30, 0x04, 0x23, 0xc6, 0x1b, 0xc9, 0x19, 0xad, 0x55, 0x16, 0xdc, 0x80, 0x86, 0xcc, 0x92,
-	0x4f, 0x95, 0xae, 0x2c, 0x0e, 0xd9, 0x90, 0xc9, 0xd0, 0x4a, 0x22, 0x85, 0xd6, 0xbe, 0xe7, 0x61,
-	0x11, 0xcb, 0x2a, 0x68, 0x1e, 0xe6, 0xa9, 0xa7, 0x03, 0x13, 0xd4, 0x67, 0x71, 0x9e, 0x7a, 0xa8,
-	0x05, 0x17, 0x46, 0xae, 0x4f, 0x3d, 0x57, 0xb0, 0xd8, 0x71, 0x3d, 0x2f, 0x26, 0x9c, 0xeb, 0xf9,
-	0x24, 0xbd, 0xb5, 0x7a, 0x75, 0x56, 0xd5, 0x8f, 0xdc, 0xc0, 0xdf, 0xa8, 0x4d, 0x51, 0x6a, 0xb8,
-	0x7c, 0x8d, 0x6d, 0x2a, 0x08, 0x21, 0xa8, 0x79, 0xae, 0x70, 0xf5, 0x82, 0x09, 0xea, 0x25, 0x2c,
-	0x63, 0xb4, 0x0a, 0x67, 0x05, 0x0d, 0x08, 0x17, 0x6e, 0x10, 0xe9, 0x9a, 0x09, 0xea, 0x05, 0x3c,
-	0x01, 0xd0, 0x13, 0x58, 0xe4, 0xc2, 0x15, 0x07, 0x5c, 0xff, 0xcf, 0x04, 0xf5, 0xf9, 0x75, 0xbd,
-	0x31, 0xb9, 0x5d, 0x43, 0x0d, 0xdc, 0x95, 0x79, 0x9c, 0xf2, 0xd0, 0x73, 0x38, 0x17, 0x90, 0x78,
-	0xdf, 0x27, 0x4e, 0xcc, 0x98, 0xd0, 0x8b, 0x72, 0xd0, 0xa5, 0xab, 0xb3, 0x2a, 0x52, 0x83, 0x66,
-	0x92, 0x35, 0x0c, 0xd5, 0x1b, 0x66, 0x4c, 0xa0, 0xfb, 0xb0, 0xd4, 0xf7, 0xd9, 0x60, 0xdf, 0xd9,
-	0x23, 0x74, 0xb8, 0x27, 0xf4, 0x19, 0x13, 0xd4, 0x35, 0x3c, 0x27, 0xb1, 0x37, 0x12, 0xda, 0xd0,
-	0x2e, 0xbf, 0x56, 0x41, 0xed, 0x32, 0x0f, 0x17, 0x77, 0xc7, 0x57, 0x9b, 0xcc, 0xc0, 0xff, 0xee,
-	0x14, 0xf8, 0x27, 0xa7, 0x1e, 0xc0, 0x5b, 0x82, 0x09, 0xd7, 0x77, 0xd4, 0x6a, 0x95, 0xe1, 0x1a,
-	0x2e, 0x49, 0x50, 0xf5, 0xe4, 0xe8, 0x11, 0x2c, 0x8f, 0x48, 0x4c, 0x3f, 0x50, 0xe2, 0x5d, 0xf3,
-	0x0a, 0x92, 0x77, 0x7b, 0x8c, 0x67, 0xa8, 0x31, 0xf9, 0x48, 0x06, 0x22, 0x43, 0xd5, 0x14, 0x75,
-	0x8c, 0x8f, 0xa9, 0x75, 0x58, 0xf6, 0x5d, 0x2e, 0x52, 0x9a, 0x93, 0xec, 0x42, 0x9a, 0x5f, 0xc0,
-	0xf3, 0x09, 0xae, 0x68, 0x3d, 0x1a, 0x10, 0x54, 0x85, 0x73, 0x94, 0x3b, 0xc4, 0xa7, 0x43, 0xda,
-	0xf7, 0x89, 0xb4, 0xfa, 0x7f, 0x0c, 0x29, 0xb7, 0x53, 0x04, 0xbd, 0x80, 0x2b, 0x21, 0x39, 0x4c,
-	0x4a, 0x7d, 0x3a, 0xa0, 0xf1, 0x75, 0x6b, 0x55, 0x73, 0x46, 0xd6, 0x5c, 0x4a, 0x08, 0x38, 0xcd,
-	0x4f, 0x6a, 0x2b, 0xab, 0x1f, 0xff, 0x06, 0xb0, 0x94, 0xdd, 0x32, 0xda, 0x80, 0x2b, 0xd8, 0xde,
-	0x7e, 0x87, 0x9b, 0x4e, 0xb7, 0xb7, 0xd9, 0xdb, 0xe9, 0x3a, 0x3b, 0xed, 0x6e, 0xc7, 0xde, 0x6e,
-	0xbd, 0x6a, 0xd9, 0xcd, 0x72, 0xae, 0x72, 0xef, 0xf8, 0xc4, 0x5c, 0xce, 0x0a, 0x76, 0x42, 0x1e,
-	0x91, 0x81, 0xb4, 0x02, 0xad, 0xc3, 0xbb, 0x37, 0xb5, 0x1d, 0xbb, 0xdd, 0x6c, 0xb5, 0x5f, 0x97,
-	0x41, 0x65, 0xf9, 0xf8, 0xc4, 0xbc, 0x93, 0xd5, 0x75, 0x48, 0xe8, 0xd1, 0x70, 0x88, 0x9e, 0xc1,
-	0xa5, 0x9b, 0x9a, 0x5d, 0x1b, 0xab, 0x66, 0xf9, 0x8a, 0x7e, 0x7c, 0x62, 0x2e, 0x66, 0x45, 0xbb,
-	0xa9, 0xe9, 0xd3, 0x2a, 0x6c, 0xbf, 0xb5, 0xb7, 0x7b, 0x76, 0xb3, 0x5c, 0x98, 0x56, 0xe1, 0xd4,
-	0xff, 0x8a, 0xf6, 0xf9, 0x9b, 0x91, 0xdb, 0x7a, 0xf9, 0xe3, 0xdc, 0x00, 0xa7, 0xe7, 0x06, 0xf8,
-	0x75, 0x6e, 0x80, 0x2f, 0x17, 0x46, 0xee, 0xf4, 0xc2, 0xc8, 0xfd, 0xbc, 0x30, 0x72, 0xef, 0x1f,
-	0x0e, 0xa9, 0xd8, 0x3b, 0xe8, 0x37, 0x06, 0x2c, 0xb0, 0xda, 0x84, 0x05, 0x5d, 0x12, 0x72, 0x62,
-	0x75, 0x58, 0xd7, 0x3a, 0x94, 0x3f, 0x02, 0x71, 0x14, 0x11, 0xde, 0x2f, 0xca, 0x0f, 0xfa, 0xe9,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0x98, 0x6c, 0xfd, 0x20, 0x04, 0x00, 0x00,
+func (m *ValidatorRecordStats) GetAmendedRecords() uint64 {
+	if m != nil {
+		return m.AmendedRecords
+	}
+	return 0
 }
 
-func (this *Record) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorRecordStats) GetLastRecordEpoch() uint64 {
+	if m != nil {
+		return m.LastRecordEpoch
 	}
+	return 0
+}
 
-	that1, ok := that.(*Record)
-	if !ok {
-		that2, ok := that.(Record)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
+func (m *ValidatorRecordStats) GetNextRequiredRecordEpoch() uint64 {
+	if m != nil {
+		return m.NextRequiredRecordEpoch
 	}
-	if this.Timestamp != that1.Timestamp {
-		return false
+	return 0
+}
+
+func (m *ValidatorRecordStats) GetConsecutiveMissedEpochs() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedEpochs
 	}
-	if this.Status != that1.Status {
-		return false
+	return 0
+}
+
+func (m *ValidatorRecordStats) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
 	}
-	if this.MerkleRoot != that1.MerkleRoot {
-		return false
+	return 0
+}
+
+func (m *ValidatorRecordStats) GetGraceEndEpoch() uint64 {
+	if m != nil {
+		return m.GraceEndEpoch
 	}
-	if this.BlockHeight != that1.BlockHeight {
-		return false
+	return 0
+}
+
+func (m *ValidatorRecordStats) GetJailedAt() int64 {
+	if m != nil {
+		return m.JailedAt
 	}
-	return true
+	return 0
 }
-func (this *ValidatorRecordStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorRecordStats) GetVerifiedRecordsAtJail() uint64 {
+	if m != nil {
+		return m.VerifiedRecordsAtJail
 	}
+	return 0
+}
 
-	that1, ok := that.(*ValidatorRecordStats)
-	if !ok {
-		that2, ok := that.(ValidatorRecordStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RecordSigningInfo tracks the epochs a validator submitted records in over
+// the sliding window of the last signed_epochs_window epochs, the way
+// x/slashing tracks signed blocks. The epochs it missed are kept as a bitmap
+// keyed by epoch number.
+type RecordSigningInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_epoch is the first epoch the validator was required to submit in
+	StartEpoch uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last_epoch is the last epoch counted
+	LastEpoch uint64 `protobuf:"varint,3,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	// missed_epochs_counter is the number of epochs in the window the
+	// validator did not submit a record in
+	MissedEpochsCounter uint64 `protobuf:"varint,4,opt,name=missed_epochs_counter,json=missedEpochsCounter,proto3" json:"missed_epochs_counter,omitempty"`
+}
+
+func (m *RecordSigningInfo) Reset()         { *m = RecordSigningInfo{} }
+func (m *RecordSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*RecordSigningInfo) ProtoMessage()    {}
+func (*RecordSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{2}
+}
+func (m *RecordSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if this.TotalRecords != that1.TotalRecords {
-		return false
-	}
-	if this.VerifiedRecords != that1.VerifiedRecords {
-		return false
-	}
-	if this.RejectedRecords != that1.RejectedRecords {
-		return false
-	}
-	if this.LastRecordTime != that1.LastRecordTime {
-		return false
-	}
-	if this.IsEligible != that1.IsEligible {
-		return false
-	}
-	if this.NextRequiredRecordTime != that1.NextRequiredRecordTime {
-		return false
+}
+func (m *RecordSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordSigningInfo.Merge(m, src)
+}
+func (m *RecordSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordSigningInfo proto.InternalMessageInfo
+
+func (m *RecordSigningInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (m *Record) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *RecordSigningInfo) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Record) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RecordSigningInfo) GetLastEpoch() uint64 {
+	if m != nil {
+		return m.LastEpoch
+	}
+	return 0
 }
 
-func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MerkleRoot) > 0 {
-		i -= len(m.MerkleRoot)
-		copy(dAtA[i:], m.MerkleRoot)
-		i = encodeVarintRecord(dAtA, i, uint64(len(m.MerkleRoot)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Status != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+func (m *RecordSigningInfo) GetMissedEpochsCounter() uint64 {
+	if m != nil {
+		return m.MissedEpochsCounter
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+// RecordVote is a single verifier's vote on a pending record
+type RecordVote struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Verifier string `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Approved bool   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	// power is the verifier's bonded stake at the time of the vote
+	Power       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+	BlockHeight uint64                `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *RecordVote) Reset()         { *m = RecordVote{} }
+func (m *RecordVote) String() string { return proto.CompactTextString(m) }
+func (*RecordVote) ProtoMessage()    {}
+func (*RecordVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{3}
+}
+func (m *RecordVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintRecord(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *RecordVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordVote.Merge(m, src)
+}
+func (m *RecordVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordVote proto.InternalMessageInfo
+
+func (m *RecordVote) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *RecordVote) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintRecord(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RecordVote) GetApproved() bool {
+	if m != nil {
+		return m.Approved
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ValidatorRecordStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecordVote) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorRecordStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VerificationCommit is a verifier's sealed vote on a record, committed as
+// sha256(record_id | verifier | approved | salt) and opened during the reveal
+// phase
+type VerificationCommit struct {
+	RecordId   string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Verifier   string `protobuf:"bytes,2,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// power is the verifier's bonded stake at the time of the commit
+	Power       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+	BlockHeight uint64                `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// reveal_start_height and reveal_end_height bound the reveal phase; a reveal
+	// is accepted at heights in [reveal_start_height, reveal_end_height)
+	RevealStartHeight uint64 `protobuf:"varint,6,opt,name=reveal_start_height,json=revealStartHeight,proto3" json:"reveal_start_height,omitempty"`
+	RevealEndHeight   uint64 `protobuf:"varint,7,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
+	Revealed          bool   `protobuf:"varint,8,opt,name=revealed,proto3" json:"revealed,omitempty"`
 }
 
-func (m *ValidatorRecordStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextRequiredRecordTime != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.NextRequiredRecordTime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.IsEligible {
-		i--
-		if m.IsEligible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *VerificationCommit) Reset()         { *m = VerificationCommit{} }
+func (m *VerificationCommit) String() string { return proto.CompactTextString(m) }
+func (*VerificationCommit) ProtoMessage()    {}
+func (*VerificationCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{4}
+}
+func (m *VerificationCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.LastRecordTime != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.LastRecordTime))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *VerificationCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationCommit.Merge(m, src)
+}
+func (m *VerificationCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationCommit proto.InternalMessageInfo
+
+func (m *VerificationCommit) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	if m.RejectedRecords != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.RejectedRecords))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *VerificationCommit) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
 	}
-	if m.VerifiedRecords != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.VerifiedRecords))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *VerificationCommit) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
 	}
-	if m.TotalRecords != 0 {
-		i = encodeVarintRecord(dAtA, i, uint64(m.TotalRecords))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *VerificationCommit) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *VerificationCommit) GetRevealStartHeight() uint64 {
+	if m != nil {
+		return m.RevealStartHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRecord(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VerificationCommit) GetRevealEndHeight() uint64 {
+	if m != nil {
+		return m.RevealEndHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Record) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *VerificationCommit) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovRecord(uint64(l))
+	return false
+}
+
+// MerkleProof proves that a chunk of a record's data is included under the
+// record's merkle root. Chunks are hashed into an RFC 6962 tree: leaves are
+// sha256(0x00 | chunk) and inner nodes sha256(0x01 | left | right).
+type MerkleProof struct {
+	// total is the number of chunks in the record
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index is the position of the proven chunk
+	Index    uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	LeafHash []byte `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// aunts are the sibling hashes from the leaf up to the root
+	Aunts [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *MerkleProof) Reset()         { *m = MerkleProof{} }
+func (m *MerkleProof) String() string { return proto.CompactTextString(m) }
+func (*MerkleProof) ProtoMessage()    {}
+func (*MerkleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0857de429b972bc, []int{5}
+}
+func (m *MerkleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovRecord(uint64(l))
+}
+func (m *MerkleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProof.Merge(m, src)
+}
+func (m *MerkleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProof proto.InternalMessageInfo
+
+func (m *MerkleProof) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovRecord(uint64(l))
+	return 0
+}
+
+func (m *MerkleProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovRecord(uint64(m.Timestamp))
+	return 0
+}
+
+func (m *MerkleProof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
 	}
-	if m.Status != 0 {
-		n += 1 + sovRecord(uint64(m.Status))
+	return nil
+}
+
+func (m *MerkleProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
 	}
-	l = len(m.MerkleRoot)
-	if l > 0 {
-		n += 1 + l + sovRecord(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("pos.pos.v1.ContentEncoding", ContentEncoding_name, ContentEncoding_value)
+	proto.RegisterEnum("pos.pos.v1.RecordStatus", RecordStatus_name, RecordStatus_value)
+	proto.RegisterEnum("pos.pos.v1.InvalidRecordPenalty", InvalidRecordPenalty_name, InvalidRecordPenalty_value)
+	proto.RegisterEnum("pos.pos.v1.BondForfeiture", BondForfeiture_name, BondForfeiture_value)
+	proto.RegisterType((*Record)(nil), "pos.pos.v1.Record")
+	proto.RegisterType((*ValidatorRecordStats)(nil), "pos.pos.v1.ValidatorRecordStats")
+	proto.RegisterType((*RecordSigningInfo)(nil), "pos.pos.v1.RecordSigningInfo")
+	proto.RegisterType((*RecordVote)(nil), "pos.pos.v1.RecordVote")
+	proto.RegisterType((*VerificationCommit)(nil), "pos.pos.v1.VerificationCommit")
+	proto.RegisterType((*MerkleProof)(nil), "pos.pos.v1.MerkleProof")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/record.proto", fileDescriptor_c0857de429b972bc) }
+
+var fileDescriptor_c0857de429b972bc = []byte{
+	// 1724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0x90, 0x94, 0x4c, 0xb5, 0x5e, 0xd4, 0x58, 0x8f, 0x11, 0x65, 0x93, 0x5c, 0x39, 0xc8,
+	0x32, 0x0a, 0x4c, 0xae, 0x9d, 0xc7, 0x06, 0x0e, 0x36, 0x81, 0x48, 0x8e, 0xec, 0x59, 0xc8, 0x14,
+	0xd1, 0xa4, 0x84, 0xd8, 0x97, 0xc1, 0x70, 0xa6, 0x45, 0xf6, 0x7a, 0xa6, 0x9b, 0x99, 0x6e, 0x32,
+	0x92, 0xcf, 0x39, 0x04, 0x02, 0x02, 0xe4, 0x0f, 0x08, 0x08, 0x90, 0x4b, 0x90, 0x53, 0x0e, 0x0b,
+	0xe4, 0x90, 0x3f, 0xb0, 0x97, 0x00, 0x9b, 0x3d, 0x05, 0x09, 0xa2, 0x04, 0xf6, 0x21, 0x39, 0xef,
+	0x2f, 0x08, 0xfa, 0x41, 0x69, 0x28, 0x6a, 0xf7, 0x60, 0x20, 0x7b, 0x90, 0xcd, 0xfe, 0xaa, 0xbe,
+	0xea, 0xaf, 0x8b, 0x55, 0xd5, 0x4d, 0xb0, 0x39, 0xa0, 0xac, 0x2a, 0xfe, 0x46, 0x8f, 0xaa, 0x31,
+	0xf2, 0x69, 0x1c, 0x54, 0x06, 0x31, 0xe5, 0xd4, 0x04, 0x03, 0xca, 0x2a, 0xe2, 0x6f, 0xf4, 0x28,
+	0xbf, 0xea, 0x45, 0x98, 0xd0, 0xaa, 0xfc, 0x57, 0x99, 0xf3, 0x05, 0x9f, 0xb2, 0x88, 0xb2, 0x6a,
+	0xd7, 0x63, 0xa8, 0x3a, 0x7a, 0xd4, 0x45, 0xdc, 0x7b, 0x54, 0xf5, 0x29, 0x26, 0xda, 0xbe, 0xa5,
+	0xec, 0xae, 0x5c, 0x55, 0xd5, 0x42, 0x9b, 0xd6, 0x7a, 0xb4, 0x47, 0x15, 0x2e, 0x3e, 0x29, 0x74,
+	0xe7, 0x4f, 0x77, 0xc0, 0x1c, 0x94, 0x02, 0xcc, 0x65, 0x90, 0xc2, 0x81, 0x65, 0x94, 0x8c, 0xf2,
+	0x3c, 0x4c, 0xe1, 0xc0, 0x74, 0xc0, 0xea, 0xc8, 0x0b, 0x71, 0xe0, 0x71, 0x1a, 0xbb, 0x5e, 0x10,
+	0xc4, 0x88, 0x31, 0x2b, 0x25, 0xcc, 0xb5, 0x7b, 0x5f, 0x5e, 0x16, 0xad, 0x33, 0x2f, 0x0a, 0x9f,
+	0xec, 0x4c, 0xb9, 0xec, 0xc0, 0xdc, 0x15, 0xb6, 0xa7, 0x20, 0xd3, 0x04, 0x99, 0xc0, 0xe3, 0x9e,
+	0x95, 0x2e, 0x19, 0xe5, 0x45, 0x28, 0x3f, 0x9b, 0xf7, 0xc0, 0x3c, 0xc7, 0x11, 0x62, 0xdc, 0x8b,
+	0x06, 0x56, 0xa6, 0x64, 0x94, 0xd3, 0xf0, 0x1a, 0x30, 0x3f, 0x00, 0x73, 0x8c, 0x7b, 0x7c, 0xc8,
+	0xac, 0xd9, 0x92, 0x51, 0x5e, 0x7e, 0x6c, 0x55, 0xae, 0x13, 0x53, 0x51, 0x82, 0xdb, 0xd2, 0x0e,
+	0xb5, 0x9f, 0xf9, 0x21, 0x58, 0x88, 0x50, 0xfc, 0x2a, 0x44, 0x6e, 0x4c, 0x29, 0xb7, 0xe6, 0xa4,
+	0xd0, 0x8d, 0x2f, 0x2f, 0x8b, 0xa6, 0x12, 0x9a, 0x30, 0xee, 0x40, 0xa0, 0x56, 0x90, 0x52, 0x6e,
+	0xbe, 0x07, 0x16, 0xbb, 0x21, 0xf5, 0x5f, 0xb9, 0x7d, 0x84, 0x7b, 0x7d, 0x6e, 0xdd, 0x29, 0x19,
+	0xe5, 0x0c, 0x5c, 0x90, 0xd8, 0x33, 0x09, 0x99, 0x6b, 0x60, 0x16, 0x0d, 0xa8, 0xdf, 0xb7, 0xb2,
+	0xd2, 0xa6, 0x16, 0xe6, 0x36, 0x98, 0x17, 0x27, 0x71, 0x19, 0x7e, 0x8d, 0xac, 0x79, 0x69, 0xc9,
+	0x0a, 0xa0, 0x8d, 0x5f, 0x23, 0x11, 0x55, 0x1a, 0x43, 0xea, 0x8b, 0x4c, 0x58, 0x40, 0xe6, 0x75,
+	0x41, 0x60, 0x07, 0x0a, 0x32, 0x7f, 0x08, 0x36, 0x03, 0xcc, 0x06, 0x43, 0x8e, 0xdc, 0x00, 0x79,
+	0x41, 0x88, 0x09, 0x1a, 0x6b, 0x58, 0x90, 0xd1, 0xd6, 0xb5, 0xb9, 0xa1, 0xad, 0x5a, 0x4d, 0x11,
+	0xc8, 0x30, 0xee, 0x20, 0x1e, 0x12, 0x14, 0x58, 0x8b, 0x25, 0xa3, 0x9c, 0x85, 0x40, 0x40, 0x2d,
+	0x89, 0x98, 0x0f, 0xc0, 0x92, 0xb2, 0x8d, 0xc3, 0x2d, 0xc9, 0x70, 0x8b, 0x0a, 0xbc, 0x8e, 0xa2,
+	0x2a, 0xcf, 0xe5, 0x67, 0x03, 0x64, 0x2d, 0x4b, 0x7d, 0x40, 0x41, 0x9d, 0xb3, 0x01, 0x32, 0xf7,
+	0x41, 0xce, 0xa7, 0x84, 0x23, 0xc2, 0x5d, 0x44, 0x7c, 0x1a, 0x60, 0xd2, 0xb3, 0x56, 0xe4, 0x97,
+	0xb1, 0x9d, 0xfc, 0x32, 0xea, 0xca, 0xc7, 0xd6, 0x2e, 0x70, 0xc5, 0x9f, 0x04, 0x64, 0x26, 0x90,
+	0x4f, 0x03, 0x14, 0xa8, 0x4c, 0xe5, 0x54, 0x7e, 0x35, 0x26, 0x93, 0xf5, 0x00, 0x2c, 0xb1, 0xe1,
+	0x00, 0xc5, 0x0c, 0x05, 0x88, 0xb9, 0x38, 0xb0, 0x56, 0xa5, 0x9a, 0xc5, 0x6b, 0xd0, 0x09, 0x26,
+	0x9c, 0x02, 0xb7, 0x7b, 0x66, 0x99, 0x37, 0x9c, 0x82, 0xda, 0x99, 0x69, 0x81, 0x3b, 0x23, 0x14,
+	0x33, 0x4c, 0x89, 0x75, 0x57, 0xee, 0x33, 0x5e, 0x9a, 0x5d, 0x90, 0x3e, 0x41, 0xc8, 0x5a, 0x2b,
+	0xa5, 0xcb, 0x0b, 0x8f, 0xb7, 0x2a, 0xba, 0x37, 0x44, 0x23, 0x55, 0x74, 0x23, 0x55, 0xea, 0x14,
+	0x93, 0xda, 0x0f, 0x3e, 0xbb, 0x2c, 0xce, 0xfc, 0xe1, 0x5f, 0xc5, 0x72, 0x0f, 0xf3, 0xfe, 0xb0,
+	0x5b, 0xf1, 0x69, 0xa4, 0x1b, 0x49, 0xff, 0xf7, 0x90, 0x05, 0xaf, 0xaa, 0x22, 0x67, 0x4c, 0x12,
+	0xd8, 0xef, 0xff, 0xf3, 0xc7, 0x5d, 0x03, 0x8a, 0xe0, 0x66, 0x00, 0x32, 0x5d, 0x4a, 0x02, 0x6b,
+	0xfd, 0xff, 0xb4, 0x89, 0x8c, 0xfe, 0x24, 0xf3, 0xdf, 0xdf, 0x16, 0x8d, 0x9d, 0x5f, 0xce, 0x81,
+	0xb5, 0xe3, 0x71, 0xa3, 0x5d, 0x77, 0x04, 0xbb, 0xbd, 0x6f, 0x8d, 0x77, 0xea, 0xdb, 0x07, 0x60,
+	0x89, 0x53, 0xee, 0x85, 0xae, 0x2a, 0x0b, 0xd5, 0xfe, 0x19, 0xb8, 0x28, 0x41, 0xb5, 0x27, 0x33,
+	0xbf, 0x03, 0x72, 0x23, 0x14, 0xe3, 0x13, 0x8c, 0x82, 0x2b, 0xbf, 0xb4, 0xf4, 0x5b, 0x19, 0xe3,
+	0x09, 0xd7, 0x18, 0x7d, 0x82, 0x7c, 0x9e, 0x70, 0xcd, 0x28, 0xd7, 0x31, 0x3e, 0x76, 0x2d, 0x83,
+	0x5c, 0xe8, 0x31, 0xee, 0x8e, 0x6b, 0x14, 0x47, 0x48, 0x8e, 0x82, 0x34, 0x5c, 0x16, 0xb8, 0x72,
+	0xeb, 0xe0, 0x08, 0x89, 0x42, 0xc6, 0xcc, 0x45, 0x21, 0xee, 0xe1, 0x6e, 0x88, 0x64, 0xe3, 0x67,
+	0x21, 0xc0, 0xcc, 0xd6, 0x88, 0xf9, 0x11, 0xd8, 0x22, 0xe8, 0x54, 0x84, 0xfa, 0xf9, 0x10, 0xc7,
+	0x57, 0x5b, 0xab, 0x98, 0xa2, 0xdb, 0xd3, 0xb5, 0x94, 0x65, 0xc0, 0x0d, 0xe1, 0x04, 0xb5, 0x4f,
+	0x22, 0xfe, 0xfb, 0x60, 0x05, 0x9d, 0x0e, 0x12, 0x44, 0xa6, 0xc7, 0xc0, 0xb2, 0x86, 0xc7, 0x92,
+	0xdf, 0x07, 0x2b, 0x5e, 0x84, 0x48, 0x90, 0x70, 0x54, 0x53, 0x61, 0x59, 0xc3, 0x63, 0xc7, 0x5d,
+	0xb0, 0x9a, 0x3c, 0x9b, 0x1a, 0x2d, 0x40, 0xe5, 0xe1, 0xfa, 0x70, 0xb6, 0x1c, 0x32, 0x3f, 0x06,
+	0xf9, 0x5b, 0xc5, 0x2b, 0x92, 0x9a, 0x13, 0x9b, 0xd3, 0xca, 0x15, 0xf9, 0x09, 0xd8, 0xf2, 0x29,
+	0x61, 0xc8, 0x1f, 0x72, 0x3c, 0x42, 0x6e, 0x84, 0x19, 0x43, 0x9a, 0xca, 0xe4, 0xdc, 0xc8, 0xc0,
+	0xcd, 0x84, 0xc3, 0x73, 0x69, 0x97, 0x54, 0x26, 0xda, 0xf6, 0x13, 0x0f, 0x87, 0x28, 0x70, 0x87,
+	0x84, 0xe3, 0x50, 0xce, 0x90, 0x34, 0x5c, 0x50, 0xd8, 0x91, 0x80, 0xcc, 0x6f, 0x83, 0x95, 0x5e,
+	0xec, 0xf9, 0xc8, 0x45, 0x64, 0x2c, 0x68, 0x59, 0x06, 0x5d, 0x92, 0xb0, 0x4d, 0xb4, 0x8c, 0x6d,
+	0x30, 0xaf, 0x43, 0x79, 0x5c, 0x8e, 0x90, 0x34, 0xcc, 0x2a, 0x60, 0x8f, 0x9b, 0x1f, 0x02, 0xeb,
+	0x66, 0xf9, 0xb8, 0x1e, 0x77, 0x85, 0x5d, 0x8f, 0x8a, 0xf5, 0x1b, 0x65, 0xb4, 0xc7, 0x3f, 0xf6,
+	0x70, 0xa8, 0xdb, 0xe0, 0x9f, 0x06, 0x58, 0xd5, 0xd5, 0x8f, 0x7b, 0x04, 0x93, 0x9e, 0x43, 0x4e,
+	0xa8, 0xd9, 0xfc, 0xea, 0x1e, 0x78, 0xef, 0x8b, 0x4f, 0x1f, 0xde, 0xd7, 0x8d, 0x79, 0x7c, 0xa3,
+	0xe0, 0xdb, 0x3c, 0x16, 0x23, 0x6c, 0xba, 0x11, 0x8a, 0x60, 0x81, 0x71, 0x2f, 0xe6, 0xfa, 0x94,
+	0xaa, 0x0d, 0x80, 0x84, 0xd4, 0x11, 0xef, 0x03, 0x20, 0xbf, 0x52, 0x65, 0x57, 0xe5, 0x3f, 0x2f,
+	0x10, 0x65, 0x7e, 0x0c, 0xd6, 0x27, 0x92, 0xef, 0xfa, 0x74, 0x48, 0x38, 0x8a, 0x75, 0xf5, 0xdf,
+	0x8d, 0x12, 0x99, 0xaf, 0x2b, 0x93, 0x3e, 0xdf, 0x5f, 0x0c, 0x00, 0xd4, 0xf9, 0x8e, 0x29, 0x47,
+	0x22, 0x95, 0xba, 0x00, 0xae, 0xee, 0xea, 0xac, 0x02, 0x9c, 0xc0, 0xcc, 0x83, 0xac, 0x4e, 0x55,
+	0xac, 0x2e, 0x6a, 0x78, 0xb5, 0x16, 0x36, 0x6f, 0x30, 0x88, 0xe9, 0x08, 0x05, 0x52, 0x5e, 0x16,
+	0x5e, 0xad, 0xcd, 0x3d, 0x30, 0x3b, 0xa0, 0xbf, 0xd0, 0x6a, 0xe6, 0x6b, 0xdf, 0x15, 0xc3, 0xe9,
+	0xef, 0x97, 0xc5, 0x75, 0x95, 0x25, 0x16, 0xbc, 0xaa, 0x60, 0x5a, 0x8d, 0x3c, 0xde, 0xaf, 0x38,
+	0x84, 0x7f, 0xf1, 0xe9, 0x43, 0xa0, 0xd3, 0xe7, 0x10, 0x0e, 0x15, 0x73, 0xea, 0x12, 0x9d, 0x9d,
+	0xba, 0x44, 0xf5, 0x79, 0xfe, 0x9a, 0x02, 0xe6, 0xb1, 0x14, 0xe5, 0x7b, 0x1c, 0x53, 0x52, 0xa7,
+	0x51, 0x84, 0xf9, 0xbb, 0x9f, 0xab, 0x00, 0x80, 0x2f, 0x43, 0x44, 0x88, 0x70, 0xfd, 0xc0, 0x48,
+	0x20, 0xdf, 0xcc, 0xd9, 0xcc, 0x0a, 0xb8, 0x1b, 0xa3, 0x11, 0xf2, 0x42, 0x57, 0x95, 0x89, 0xf6,
+	0x9c, 0x93, 0x9e, 0xab, 0xca, 0xd4, 0x16, 0x16, 0xed, 0xbf, 0x0b, 0x34, 0x28, 0x5b, 0x67, 0xe2,
+	0xe1, 0xb1, 0xa2, 0x0c, 0x36, 0x19, 0x5f, 0xd4, 0x79, 0x90, 0x55, 0x10, 0x0a, 0xe4, 0xe0, 0xc9,
+	0xc2, 0xab, 0xb5, 0xce, 0x69, 0x0c, 0x16, 0x9e, 0xcb, 0xf7, 0x4c, 0x2b, 0xa6, 0xf4, 0x44, 0xbc,
+	0x56, 0xe4, 0x80, 0x96, 0x79, 0xcc, 0x40, 0xb5, 0x10, 0x28, 0x26, 0x01, 0x3a, 0xd5, 0xc5, 0xab,
+	0x16, 0x22, 0xef, 0x21, 0xf2, 0x4e, 0xdc, 0xbe, 0xc7, 0xfa, 0x3a, 0x7b, 0x59, 0x01, 0x3c, 0xf3,
+	0x58, 0x5f, 0x50, 0xbc, 0x21, 0xe1, 0x62, 0x46, 0xa7, 0xcb, 0x8b, 0x50, 0x2d, 0xd4, 0x9e, 0xbb,
+	0x7f, 0x36, 0xc0, 0xca, 0x8d, 0xab, 0x5f, 0x8c, 0x9b, 0xfa, 0x61, 0xb3, 0x63, 0x37, 0x3b, 0xae,
+	0xdd, 0xac, 0x1f, 0x36, 0x9c, 0xe6, 0x53, 0xd7, 0x69, 0xd8, 0xcd, 0x8e, 0xd3, 0x79, 0x91, 0x9b,
+	0xc9, 0x6f, 0x9f, 0x5f, 0x94, 0x36, 0x6f, 0x70, 0x9c, 0x00, 0x11, 0x8e, 0xf9, 0x99, 0xe8, 0x90,
+	0x29, 0xee, 0xd3, 0x97, 0x4e, 0x2b, 0x67, 0xe4, 0x37, 0xcf, 0x2f, 0x4a, 0x77, 0x6f, 0xf0, 0x9e,
+	0xbe, 0xc6, 0x83, 0x5b, 0x39, 0x2f, 0xdb, 0x9d, 0x46, 0x2e, 0x75, 0x2b, 0xe7, 0x25, 0xe3, 0x41,
+	0x3e, 0xf3, 0xab, 0xdf, 0x15, 0x66, 0x76, 0x2f, 0x53, 0x60, 0x31, 0xf9, 0x8a, 0x14, 0xd2, 0xa1,
+	0x5d, 0x3f, 0x84, 0x0d, 0xb7, 0xdd, 0xd9, 0xeb, 0x1c, 0xb5, 0xdd, 0xa3, 0x66, 0xbb, 0x65, 0xd7,
+	0x9d, 0x7d, 0xc7, 0x6e, 0x8c, 0xa5, 0x27, 0x09, 0x47, 0x84, 0x0d, 0x90, 0x2f, 0xa7, 0x92, 0x90,
+	0x31, 0xc9, 0x6d, 0xd9, 0x4d, 0xa1, 0x65, 0x2c, 0x3d, 0xc9, 0x6b, 0x21, 0x22, 0x53, 0xf5, 0x7d,
+	0xb0, 0x31, 0xc9, 0x39, 0xb6, 0xa1, 0xda, 0x2c, 0x95, 0xb7, 0xce, 0x2f, 0x4a, 0x6b, 0x49, 0xd2,
+	0xb1, 0x9e, 0x7f, 0xd3, 0x2c, 0x68, 0x7f, 0x6c, 0xd7, 0x3b, 0x76, 0x23, 0x97, 0x9e, 0x66, 0x41,
+	0x7d, 0xa3, 0x4e, 0xeb, 0xb3, 0x7f, 0xd6, 0x72, 0xa0, 0xdd, 0xc8, 0x65, 0xa6, 0xf5, 0xd9, 0xea,
+	0x4a, 0x33, 0x7f, 0x04, 0xac, 0x49, 0x4e, 0xfb, 0xa8, 0x65, 0xc3, 0xb6, 0xdd, 0xb0, 0x1b, 0xb9,
+	0xd9, 0x7c, 0xfe, 0xfc, 0xa2, 0xb4, 0x91, 0xa4, 0xb5, 0xaf, 0xde, 0x60, 0x3a, 0xc1, 0xff, 0x30,
+	0xc0, 0x9a, 0x43, 0xe4, 0x1c, 0x55, 0x7e, 0x2d, 0x44, 0xbc, 0x90, 0x9f, 0x99, 0x3f, 0x05, 0xf7,
+	0x9c, 0xe6, 0xf1, 0xde, 0x81, 0xd3, 0x70, 0xf5, 0x06, 0x2d, 0xbb, 0xb9, 0x77, 0xd0, 0x79, 0xe1,
+	0xb6, 0x0f, 0xf6, 0xda, 0xcf, 0x72, 0x33, 0xf9, 0xfb, 0xe7, 0x17, 0xa5, 0xad, 0xdb, 0xb8, 0xed,
+	0x50, 0x14, 0xe5, 0x47, 0x60, 0xfb, 0x2b, 0x02, 0xd4, 0x0e, 0x9b, 0x8d, 0x9c, 0x91, 0xbf, 0x77,
+	0x7e, 0x51, 0xb2, 0x6e, 0xe3, 0xd7, 0x28, 0x09, 0xbe, 0x96, 0xde, 0x79, 0x96, 0x4b, 0x7d, 0x1d,
+	0x9d, 0xf7, 0xf5, 0xe9, 0x7e, 0x6d, 0x80, 0x65, 0x11, 0x6d, 0x9f, 0xc6, 0x27, 0x08, 0xf3, 0x61,
+	0x8c, 0xcc, 0x0f, 0xc0, 0x9a, 0xd8, 0xdf, 0xdd, 0x3f, 0x84, 0xfb, 0xb6, 0xd3, 0x39, 0x82, 0xb6,
+	0x5b, 0x3b, 0x82, 0xcd, 0xdc, 0x4c, 0x7e, 0xe3, 0xfc, 0xa2, 0x64, 0x4e, 0x7a, 0xd7, 0x86, 0x31,
+	0x31, 0xeb, 0xa0, 0x70, 0x93, 0x51, 0x3f, 0x7c, 0xfe, 0xfc, 0xa8, 0xe9, 0x74, 0x5e, 0xb8, 0xad,
+	0xc3, 0xc3, 0x83, 0x9c, 0x91, 0x2f, 0x9e, 0x5f, 0x94, 0xb6, 0x27, 0xb9, 0x62, 0x60, 0x0e, 0x09,
+	0xe6, 0x67, 0x2d, 0x4a, 0x43, 0xa5, 0xa7, 0xf6, 0x93, 0xcf, 0xde, 0x14, 0x8c, 0xcf, 0xdf, 0x14,
+	0x8c, 0x7f, 0xbf, 0x29, 0x18, 0xbf, 0x79, 0x5b, 0x98, 0xf9, 0xfc, 0x6d, 0x61, 0xe6, 0x6f, 0x6f,
+	0x0b, 0x33, 0x2f, 0xbf, 0x95, 0x78, 0x60, 0x36, 0x11, 0x8d, 0xda, 0x88, 0x30, 0x54, 0x6d, 0xd1,
+	0x76, 0xf5, 0x54, 0xfe, 0xfe, 0x94, 0x4f, 0xcc, 0xee, 0x9c, 0xfc, 0x31, 0xf8, 0xbd, 0xff, 0x05,
+	0x00, 0x00, 0xff, 0xff, 0xd6, 0x56, 0xb5, 0x36, 0x97, 0x0e, 0x00, 0x00,
+}
+
+func (this *Record) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovRecord(uint64(m.BlockHeight))
+
+	that1, ok := that.(*Record)
+	if !ok {
+		that2, ok := that.(Record)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.MerkleRoot != that1.MerkleRoot {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.DataSize != that1.DataSize {
+		return false
+	}
+	if this.DataLocator != that1.DataLocator {
+		return false
+	}
+	if this.DisputeDeadlineHeight != that1.DisputeDeadlineHeight {
+		return false
+	}
+	if this.DataPruned != that1.DataPruned {
+		return false
+	}
+	if this.PrunedHeight != that1.PrunedHeight {
+		return false
+	}
+	if this.RecordType != that1.RecordType {
+		return false
+	}
+	if this.ContentEncoding != that1.ContentEncoding {
+		return false
+	}
+	if this.DecodedSize != that1.DecodedSize {
+		return false
+	}
+	if this.SupersedesId != that1.SupersedesId {
+		return false
+	}
+	if this.SupersededBy != that1.SupersededBy {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	if len(this.Bond) != len(that1.Bond) {
+		return false
+	}
+	for i := range this.Bond {
+		if !this.Bond[i].Equal(&that1.Bond[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorRecordStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRecordStats)
+	if !ok {
+		that2, ok := that.(ValidatorRecordStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.TotalRecords != that1.TotalRecords {
+		return false
+	}
+	if this.VerifiedRecords != that1.VerifiedRecords {
+		return false
+	}
+	if this.RejectedRecords != that1.RejectedRecords {
+		return false
+	}
+	if this.LastRecordTime != that1.LastRecordTime {
+		return false
+	}
+	if this.IsEligible != that1.IsEligible {
+		return false
+	}
+	if this.NextRequiredRecordTime != that1.NextRequiredRecordTime {
+		return false
+	}
+	if this.ExpiredRecords != that1.ExpiredRecords {
+		return false
+	}
+	if this.AmendedRecords != that1.AmendedRecords {
+		return false
+	}
+	if this.LastRecordEpoch != that1.LastRecordEpoch {
+		return false
+	}
+	if this.NextRequiredRecordEpoch != that1.NextRequiredRecordEpoch {
+		return false
+	}
+	if this.ConsecutiveMissedEpochs != that1.ConsecutiveMissedEpochs {
+		return false
+	}
+	if this.JailedUntil != that1.JailedUntil {
+		return false
+	}
+	if this.GraceEndEpoch != that1.GraceEndEpoch {
+		return false
+	}
+	if this.JailedAt != that1.JailedAt {
+		return false
+	}
+	if this.VerifiedRecordsAtJail != that1.VerifiedRecordsAtJail {
+		return false
+	}
+	return true
+}
+func (this *RecordSigningInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordSigningInfo)
+	if !ok {
+		that2, ok := that.(RecordSigningInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.StartEpoch != that1.StartEpoch {
+		return false
+	}
+	if this.LastEpoch != that1.LastEpoch {
+		return false
+	}
+	if this.MissedEpochsCounter != that1.MissedEpochsCounter {
+		return false
+	}
+	return true
+}
+func (this *RecordVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordVote)
+	if !ok {
+		that2, ok := that.(RecordVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.Verifier != that1.Verifier {
+		return false
+	}
+	if this.Approved != that1.Approved {
+		return false
+	}
+	if !this.Power.Equal(that1.Power) {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	return true
+}
+func (this *VerificationCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerificationCommit)
+	if !ok {
+		that2, ok := that.(VerificationCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.Verifier != that1.Verifier {
+		return false
+	}
+	if !bytes.Equal(this.Commitment, that1.Commitment) {
+		return false
+	}
+	if !this.Power.Equal(that1.Power) {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.RevealStartHeight != that1.RevealStartHeight {
+		return false
+	}
+	if this.RevealEndHeight != that1.RevealEndHeight {
+		return false
+	}
+	if this.Revealed != that1.Revealed {
+		return false
+	}
+	return true
+}
+func (this *MerkleProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MerkleProof)
+	if !ok {
+		that2, ok := that.(MerkleProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.LeafHash, that1.LeafHash) {
+		return false
+	}
+	if len(this.Aunts) != len(that1.Aunts) {
+		return false
+	}
+	for i := range this.Aunts {
+		if !bytes.Equal(this.Aunts[i], that1.Aunts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Record) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.SupersedesId) > 0 {
+		i -= len(m.SupersedesId)
+		copy(dAtA[i:], m.SupersedesId)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.SupersedesId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DecodedSize != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.DecodedSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ContentEncoding != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.ContentEncoding))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PrunedHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.PrunedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DataPruned {
+		i--
+		if m.DataPruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DisputeDeadlineHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.DisputeDeadlineHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DataLocator) > 0 {
+		i -= len(m.DataLocator)
+		copy(dAtA[i:], m.DataLocator)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.DataLocator)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DataSize != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRecordStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRecordStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRecordStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifiedRecordsAtJail != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.VerifiedRecordsAtJail))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.JailedAt != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.JailedAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GraceEndEpoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.GraceEndEpoch))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ConsecutiveMissedEpochs != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.ConsecutiveMissedEpochs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NextRequiredRecordEpoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.NextRequiredRecordEpoch))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LastRecordEpoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.LastRecordEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AmendedRecords != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.AmendedRecords))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiredRecords != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.ExpiredRecords))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextRequiredRecordTime != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.NextRequiredRecordTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsEligible {
+		i--
+		if m.IsEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastRecordTime != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.LastRecordTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RejectedRecords != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.RejectedRecords))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VerifiedRecords != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.VerifiedRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalRecords != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.TotalRecords))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedEpochsCounter != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.MissedEpochsCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEpoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RevealStartHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.RevealStartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintRecord(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Record) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRecord(uint64(m.Timestamp))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRecord(uint64(m.Status))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRecord(uint64(m.BlockHeight))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRecord(uint64(m.Epoch))
+	}
+	if m.DataSize != 0 {
+		n += 1 + sovRecord(uint64(m.DataSize))
+	}
+	l = len(m.DataLocator)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.DisputeDeadlineHeight != 0 {
+		n += 1 + sovRecord(uint64(m.DisputeDeadlineHeight))
+	}
+	if m.DataPruned {
+		n += 2
+	}
+	if m.PrunedHeight != 0 {
+		n += 1 + sovRecord(uint64(m.PrunedHeight))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.ContentEncoding != 0 {
+		n += 1 + sovRecord(uint64(m.ContentEncoding))
+	}
+	if m.DecodedSize != 0 {
+		n += 2 + sovRecord(uint64(m.DecodedSize))
+	}
+	l = len(m.SupersedesId)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 2 + sovRecord(uint64(m.Version))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 2 + l + sovRecord(uint64(l))
+		}
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 2 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRecordStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.TotalRecords != 0 {
+		n += 1 + sovRecord(uint64(m.TotalRecords))
+	}
+	if m.VerifiedRecords != 0 {
+		n += 1 + sovRecord(uint64(m.VerifiedRecords))
+	}
+	if m.RejectedRecords != 0 {
+		n += 1 + sovRecord(uint64(m.RejectedRecords))
+	}
+	if m.LastRecordTime != 0 {
+		n += 1 + sovRecord(uint64(m.LastRecordTime))
+	}
+	if m.IsEligible {
+		n += 2
+	}
+	if m.NextRequiredRecordTime != 0 {
+		n += 1 + sovRecord(uint64(m.NextRequiredRecordTime))
+	}
+	if m.ExpiredRecords != 0 {
+		n += 1 + sovRecord(uint64(m.ExpiredRecords))
+	}
+	if m.AmendedRecords != 0 {
+		n += 1 + sovRecord(uint64(m.AmendedRecords))
+	}
+	if m.LastRecordEpoch != 0 {
+		n += 1 + sovRecord(uint64(m.LastRecordEpoch))
+	}
+	if m.NextRequiredRecordEpoch != 0 {
+		n += 1 + sovRecord(uint64(m.NextRequiredRecordEpoch))
+	}
+	if m.ConsecutiveMissedEpochs != 0 {
+		n += 1 + sovRecord(uint64(m.ConsecutiveMissedEpochs))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovRecord(uint64(m.JailedUntil))
+	}
+	if m.GraceEndEpoch != 0 {
+		n += 1 + sovRecord(uint64(m.GraceEndEpoch))
+	}
+	if m.JailedAt != 0 {
+		n += 1 + sovRecord(uint64(m.JailedAt))
+	}
+	if m.VerifiedRecordsAtJail != 0 {
+		n += 2 + sovRecord(uint64(m.VerifiedRecordsAtJail))
+	}
+	return n
+}
+
+func (m *RecordSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovRecord(uint64(m.StartEpoch))
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovRecord(uint64(m.LastEpoch))
+	}
+	if m.MissedEpochsCounter != 0 {
+		n += 1 + sovRecord(uint64(m.MissedEpochsCounter))
+	}
+	return n
+}
+
+func (m *RecordVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovRecord(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovRecord(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *VerificationCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovRecord(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovRecord(uint64(m.BlockHeight))
+	}
+	if m.RevealStartHeight != 0 {
+		n += 1 + sovRecord(uint64(m.RevealStartHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovRecord(uint64(m.RevealEndHeight))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MerkleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovRecord(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRecord(uint64(m.Index))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecord(x uint64) (n int) {
+	return sovRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Record) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadlineHeight", wireType)
+			}
+			m.DisputeDeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeDeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DataPruned = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedHeight", wireType)
+			}
+			m.PrunedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			m.ContentEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentEncoding |= ContentEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedSize", wireType)
+			}
+			m.DecodedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecodedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersedesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersedesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRecordStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRecordStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRecords", wireType)
+			}
+			m.TotalRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecords", wireType)
+			}
+			m.VerifiedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRecords", wireType)
+			}
+			m.RejectedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordTime", wireType)
+			}
+			m.LastRecordTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRequiredRecordTime", wireType)
+			}
+			m.NextRequiredRecordTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRequiredRecordTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredRecords", wireType)
+			}
+			m.ExpiredRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendedRecords", wireType)
+			}
+			m.AmendedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmendedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordEpoch", wireType)
+			}
+			m.LastRecordEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecordEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRequiredRecordEpoch", wireType)
+			}
+			m.NextRequiredRecordEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRequiredRecordEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedEpochs", wireType)
+			}
+			m.ConsecutiveMissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceEndEpoch", wireType)
+			}
+			m.GraceEndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraceEndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAt", wireType)
+			}
+			m.JailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecordsAtJail", wireType)
+			}
+			m.VerifiedRecordsAtJail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecordsAtJail |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochsCounter", wireType)
+			}
+			m.MissedEpochsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ValidatorRecordStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovRecord(uint64(l))
-	}
-	if m.TotalRecords != 0 {
-		n += 1 + sovRecord(uint64(m.TotalRecords))
-	}
-	if m.VerifiedRecords != 0 {
-		n += 1 + sovRecord(uint64(m.VerifiedRecords))
-	}
-	if m.RejectedRecords != 0 {
-		n += 1 + sovRecord(uint64(m.RejectedRecords))
-	}
-	if m.LastRecordTime != 0 {
-		n += 1 + sovRecord(uint64(m.LastRecordTime))
-	}
-	if m.IsEligible {
-		n += 2
-	}
-	if m.NextRequiredRecordTime != 0 {
-		n += 1 + sovRecord(uint64(m.NextRequiredRecordTime))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovRecord(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRecord(x uint64) (n int) {
-	return sovRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Record) Unmarshal(dAtA []byte) error {
+func (m *VerificationCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -614,15 +3434,15 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Record: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Record: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -650,11 +3470,11 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -682,11 +3502,11 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Verifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -713,16 +3533,16 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -732,16 +3552,31 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Status = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -751,16 +3586,16 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RecordStatus(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealStartHeight", wireType)
 			}
-			var stringLen uint64
+			m.RevealStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -770,29 +3605,35 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RevealStartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRecord
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRecord
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
 			}
-			m.BlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -802,11 +3643,12 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Revealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -828,7 +3670,7 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
+func (m *MerkleProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -851,17 +3693,17 @@ func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorRecordStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: MerkleProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorRecordStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MerkleProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -871,29 +3713,16 @@ func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRecord
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRecord
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.TotalRecords = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -903,16 +3732,16 @@ func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalRecords |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecords", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
-			m.VerifiedRecords = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -922,54 +3751,31 @@ func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerifiedRecords |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RejectedRecords", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
 			}
-			m.RejectedRecords = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRecord
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RejectedRecords |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRecordTime", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastRecordTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRecord
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRecordTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRecord
@@ -979,31 +3785,24 @@ func (m *ValidatorRecordStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsEligible = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextRequiredRecordTime", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
 			}
-			m.NextRequiredRecordTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRecord
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextRequiredRecordTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
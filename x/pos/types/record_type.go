@@ -0,0 +1,66 @@
+package types
+
+import (
+	"regexp"
+)
+
+const (
+	// MaxRecordTypeIDLength is the maximum length of a record type id
+	MaxRecordTypeIDLength = 64
+
+	// MaxRecordTypeSchemaLength is the maximum length of a record type JSON schema
+	MaxRecordTypeSchemaLength = 64 * 1024
+)
+
+var recordTypeIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._/-]*$`)
+
+// Validate checks that the record type is well formed and its schema compiles.
+func (rt RecordType) Validate() error {
+	if len(rt.Id) > MaxRecordTypeIDLength || !recordTypeIDPattern.MatchString(rt.Id) {
+		return ErrInvalidRecordType.Wrapf(
+			"id %q must be at most %d lowercase letters, digits, '.', '_', '/' or '-'",
+			rt.Id,
+			MaxRecordTypeIDLength,
+		)
+	}
+
+	if rt.MaxDataSize != 0 && rt.MinDataSize > rt.MaxDataSize {
+		return ErrInvalidRecordType.Wrapf(
+			"min data size %d is greater than max data size %d",
+			rt.MinDataSize,
+			rt.MaxDataSize,
+		)
+	}
+
+	if len(rt.Schema) > MaxRecordTypeSchemaLength {
+		return ErrInvalidRecordType.Wrapf("schema is longer than %d bytes", MaxRecordTypeSchemaLength)
+	}
+
+	_, err := rt.CompileSchema()
+	return err
+}
+
+// CompileSchema compiles the record type JSON schema. An empty schema accepts
+// any JSON document.
+func (rt RecordType) CompileSchema() (*RecordSchema, error) {
+	if rt.Schema == "" {
+		return &RecordSchema{}, nil
+	}
+
+	return CompileRecordSchema(rt.Schema)
+}
+
+// ValidateSize checks the data size against the record type size limits.
+func (rt RecordType) ValidateSize(dataSize uint64) error {
+	if dataSize < rt.MinDataSize || (rt.MaxDataSize != 0 && dataSize > rt.MaxDataSize) {
+		return ErrInvalidRecordSize.Wrapf(
+			"record size %d is not within the bounds of record type %s [%d, %d]",
+			dataSize,
+			rt.Id,
+			rt.MinDataSize,
+			rt.MaxDataSize,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/reward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardPool is the part of the pos module account paid out to eligible
+// validators as epochs end. It is funded by a share of the collected fees and
+// by direct deposits; the rest of the module account holds escrowed bonds.
+type RewardPool struct {
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *RewardPool) Reset()         { *m = RewardPool{} }
+func (m *RewardPool) String() string { return proto.CompactTextString(m) }
+func (*RewardPool) ProtoMessage()    {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b04710d5c460f3, []int{0}
+}
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
+}
+func (m *RewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
+
+func (m *RewardPool) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// RewardPayout is the reward of a validator for an epoch, in proportion to the
+// records it got verified in the epoch
+type RewardPayout struct {
+	Epoch            uint64                                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorAddress string                                   `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	VerifiedRecords  uint64                                   `protobuf:"varint,3,opt,name=verified_records,json=verifiedRecords,proto3" json:"verified_records,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// recipient is the distribution withdraw address of the validator operator
+	// the reward is sent to; it is empty for pending payouts
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *RewardPayout) Reset()         { *m = RewardPayout{} }
+func (m *RewardPayout) String() string { return proto.CompactTextString(m) }
+func (*RewardPayout) ProtoMessage()    {}
+func (*RewardPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5b04710d5c460f3, []int{1}
+}
+func (m *RewardPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPayout.Merge(m, src)
+}
+func (m *RewardPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPayout proto.InternalMessageInfo
+
+func (m *RewardPayout) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RewardPayout) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RewardPayout) GetVerifiedRecords() uint64 {
+	if m != nil {
+		return m.VerifiedRecords
+	}
+	return 0
+}
+
+func (m *RewardPayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RewardPayout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RewardPool)(nil), "pos.pos.v1.RewardPool")
+	proto.RegisterType((*RewardPayout)(nil), "pos.pos.v1.RewardPayout")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/reward.proto", fileDescriptor_c5b04710d5c460f3) }
+
+var fileDescriptor_c5b04710d5c460f3 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0x77, 0xb9, 0x43, 0xb7, 0x20, 0x71, 0x67, 0x45, 0xc2, 0x77, 0x12, 0x4e, 0x38,
+	0x51, 0x18, 0xa4, 0x78, 0x65, 0x10, 0x14, 0x14, 0x48, 0x84, 0xfe, 0x74, 0x72, 0x24, 0x0a, 0x9a,
+	0x68, 0x6d, 0x2f, 0xce, 0x42, 0xbc, 0x63, 0xed, 0x6e, 0xcc, 0xe5, 0x2d, 0x78, 0x04, 0x4a, 0x44,
+	0x45, 0x91, 0x77, 0x20, 0x65, 0x94, 0x8a, 0x0a, 0x50, 0x52, 0xc0, 0x63, 0x20, 0xef, 0x6e, 0xc4,
+	0x9f, 0x9e, 0x62, 0x6d, 0xcf, 0xf7, 0x8d, 0xe7, 0x37, 0xb3, 0x1a, 0x7c, 0xab, 0x06, 0x45, 0xda,
+	0xd3, 0x24, 0x44, 0xb2, 0xb7, 0x54, 0x16, 0x71, 0x2d, 0x41, 0x83, 0x8f, 0x6b, 0x50, 0x71, 0x7b,
+	0x9a, 0xe4, 0xec, 0x84, 0x56, 0x5c, 0x00, 0x31, 0x4f, 0x6b, 0x9f, 0x85, 0x39, 0xa8, 0x0a, 0x14,
+	0xc9, 0xa8, 0x62, 0xa4, 0x49, 0x32, 0xa6, 0x69, 0x42, 0x72, 0xe0, 0xc2, 0xf9, 0xa7, 0xd6, 0x1f,
+	0x9b, 0x88, 0xd8, 0xc0, 0x59, 0xdd, 0x12, 0x4a, 0xb0, 0x7a, 0xfb, 0x65, 0xd5, 0xf3, 0x2b, 0x8c,
+	0x53, 0xc3, 0xbf, 0x04, 0x98, 0xfa, 0xaf, 0xf1, 0xb5, 0x8c, 0x4e, 0xa9, 0xc8, 0x59, 0x80, 0xfa,
+	0xfb, 0xd1, 0xf5, 0x07, 0xa7, 0xb1, 0xab, 0xd1, 0x02, 0x63, 0x07, 0x8c, 0x9f, 0x03, 0x17, 0xc3,
+	0x47, 0xcb, 0xaf, 0x3d, 0xef, 0xe3, 0xb7, 0x5e, 0x54, 0x72, 0x3d, 0x99, 0x65, 0x71, 0x0e, 0x95,
+	0x03, 0xba, 0xd7, 0x40, 0x15, 0x6f, 0x88, 0x9e, 0xd7, 0x4c, 0x99, 0x1f, 0xd4, 0x87, 0x1f, 0x9f,
+	0xee, 0xa3, 0x74, 0x07, 0x38, 0xff, 0xbc, 0x87, 0x6f, 0x38, 0x34, 0x9d, 0xc3, 0x4c, 0xfb, 0x5d,
+	0x7c, 0xc0, 0x6a, 0xc8, 0x27, 0x01, 0xea, 0xa3, 0xa8, 0x93, 0xda, 0xc0, 0xbf, 0xc0, 0x27, 0x0d,
+	0x9d, 0xf2, 0x82, 0x6a, 0x90, 0x63, 0x5a, 0x14, 0x92, 0x29, 0x15, 0xec, 0xf5, 0x51, 0x74, 0x34,
+	0xbc, 0xb3, 0x5e, 0x0c, 0x6e, 0xbb, 0xfe, 0x5e, 0xec, 0x72, 0x9e, 0xd9, 0x94, 0x91, 0x96, 0x5c,
+	0x94, 0xe9, 0x71, 0xf3, 0x8f, 0xee, 0xdf, 0xc3, 0xc7, 0x0d, 0x93, 0xfc, 0x15, 0x67, 0xc5, 0x58,
+	0xb2, 0x1c, 0x64, 0xa1, 0x82, 0x7d, 0x03, 0xbc, 0xb9, 0xd3, 0x53, 0x2b, 0xfb, 0x13, 0x7c, 0x48,
+	0x2b, 0x98, 0x09, 0x1d, 0x74, 0xfe, 0xd3, 0x65, 0xb8, 0xfa, 0xfe, 0x63, 0x7c, 0x24, 0x59, 0xce,
+	0x6b, 0xce, 0x84, 0x0e, 0x0e, 0xcc, 0x70, 0xc1, 0x7a, 0x31, 0xe8, 0x3a, 0xde, 0xdf, 0x33, 0xfd,
+	0x4e, 0x7d, 0xd2, 0xf9, 0xf9, 0xbe, 0x87, 0x86, 0x4f, 0x97, 0x9b, 0x10, 0xad, 0x36, 0x21, 0xfa,
+	0xbe, 0x09, 0xd1, 0xbb, 0x6d, 0xe8, 0xad, 0xb6, 0xa1, 0xf7, 0x65, 0x1b, 0x7a, 0x2f, 0xef, 0xfe,
+	0xd1, 0xce, 0x05, 0x83, 0x6a, 0xc4, 0x84, 0x62, 0xe4, 0x12, 0x46, 0xe4, 0xca, 0x6c, 0x9f, 0x69,
+	0x28, 0x3b, 0x34, 0xab, 0xf0, 0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x68, 0x20, 0xd3,
+	0x95, 0x02, 0x00, 0x00,
+}
+
+func (this *RewardPayout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardPayout)
+	if !ok {
+		that2, ok := that.(RewardPayout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.VerifiedRecords != that1.VerifiedRecords {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	return true
+}
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.VerifiedRecords != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.VerifiedRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovReward(uint64(m.Epoch))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	if m.VerifiedRecords != 0 {
+		n += 1 + sovReward(uint64(m.VerifiedRecords))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	return n
+}
+
+func sovReward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReward(x uint64) (n int) {
+	return sovReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRecords", wireType)
+			}
+			m.VerifiedRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,514 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RecordSchema is a compiled record type JSON schema.
+//
+// Only the subset of JSON Schema that can be checked deterministically is
+// supported: type, enum, const, properties, required, additionalProperties,
+// items, minItems, maxItems, minLength, maxLength, pattern, minimum, maximum,
+// exclusiveMinimum and exclusiveMaximum. The annotation keywords $schema, $id,
+// title, description and examples are ignored. Any other keyword is rejected
+// so a schema never silently promises more than the chain enforces.
+type RecordSchema struct {
+	types []string
+
+	enum     []any
+	hasConst bool
+	constant any
+
+	properties           map[string]*RecordSchema
+	required             []string
+	additionalProperties *RecordSchema
+	noAdditional         bool
+
+	items    *RecordSchema
+	minItems *uint64
+	maxItems *uint64
+
+	minLength *uint64
+	maxLength *uint64
+	pattern   *regexp.Regexp
+
+	minimum          *big.Rat
+	maximum          *big.Rat
+	exclusiveMinimum *big.Rat
+	exclusiveMaximum *big.Rat
+}
+
+const (
+	// maxSchemaDepth bounds the nesting of schemas and validated documents
+	maxSchemaDepth = 32
+
+	// maxNumberExponent bounds the exponent of numbers compared exactly, which
+	// would otherwise let a short number like 1e999999999 exhaust memory
+	maxNumberExponent = 400
+)
+
+var schemaTypes = map[string]bool{
+	"object":  true,
+	"array":   true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"null":    true,
+}
+
+var schemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"title":       true,
+	"description": true,
+	"examples":    true,
+}
+
+// CompileRecordSchema parses a record type JSON schema.
+func CompileRecordSchema(schema string) (*RecordSchema, error) {
+	doc, err := decodeJSON([]byte(schema))
+	if err != nil {
+		return nil, ErrInvalidRecordType.Wrapf("schema is not valid JSON: %s", err)
+	}
+
+	compiled, err := compileSchema(doc, "#", 0)
+	if err != nil {
+		return nil, ErrInvalidRecordType.Wrap(err.Error())
+	}
+
+	return compiled, nil
+}
+
+// Validate checks that data is a JSON document satisfying the schema.
+func (s *RecordSchema) Validate(data []byte) error {
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return ErrSchemaValidation.Wrapf("data is not valid JSON: %s", err)
+	}
+
+	if err := s.validate(doc, "$", 0); err != nil {
+		return ErrSchemaValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// decodeJSON decodes a single JSON document, keeping numbers exact
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+
+	return doc, nil
+}
+
+func compileSchema(doc any, path string, depth int) (*RecordSchema, error) {
+	if depth > maxSchemaDepth {
+		return nil, fmt.Errorf("%s: schema is nested deeper than %d levels", path, maxSchemaDepth)
+	}
+
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be a JSON object", path)
+	}
+
+	s := &RecordSchema{}
+
+	// Walk keywords in a fixed order so the reported error is deterministic
+	keywords := make([]string, 0, len(obj))
+	for keyword := range obj {
+		keywords = append(keywords, keyword)
+	}
+	sort.Strings(keywords)
+
+	var err error
+	for _, keyword := range keywords {
+		value := obj[keyword]
+		at := path + "/" + keyword
+
+		switch keyword {
+		case "type":
+			s.types, err = compileTypes(value, at)
+		case "enum":
+			values, isArray := value.([]any)
+			if !isArray || len(values) == 0 {
+				err = fmt.Errorf("%s: must be a non-empty array", at)
+			}
+			s.enum = values
+		case "const":
+			s.hasConst, s.constant = true, value
+		case "properties":
+			props, isObject := value.(map[string]any)
+			if !isObject {
+				return nil, fmt.Errorf("%s: must be an object", at)
+			}
+			names := make([]string, 0, len(props))
+			for name := range props {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			s.properties = make(map[string]*RecordSchema, len(props))
+			for _, name := range names {
+				if s.properties[name], err = compileSchema(props[name], at+"/"+name, depth+1); err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			s.required, err = compileStrings(value, at)
+		case "additionalProperties":
+			if allowed, isBool := value.(bool); isBool {
+				s.noAdditional = !allowed
+			} else {
+				s.additionalProperties, err = compileSchema(value, at, depth+1)
+			}
+		case "items":
+			s.items, err = compileSchema(value, at, depth+1)
+		case "minItems":
+			s.minItems, err = compileCount(value, at)
+		case "maxItems":
+			s.maxItems, err = compileCount(value, at)
+		case "minLength":
+			s.minLength, err = compileCount(value, at)
+		case "maxLength":
+			s.maxLength, err = compileCount(value, at)
+		case "pattern":
+			pattern, isString := value.(string)
+			if !isString {
+				return nil, fmt.Errorf("%s: must be a string", at)
+			}
+			if s.pattern, err = regexp.Compile(pattern); err != nil {
+				err = fmt.Errorf("%s: %w", at, err)
+			}
+		case "minimum":
+			s.minimum, err = compileNumber(value, at)
+		case "maximum":
+			s.maximum, err = compileNumber(value, at)
+		case "exclusiveMinimum":
+			s.exclusiveMinimum, err = compileNumber(value, at)
+		case "exclusiveMaximum":
+			s.exclusiveMaximum, err = compileNumber(value, at)
+		default:
+			if !schemaAnnotations[keyword] {
+				err = fmt.Errorf("%s: unsupported keyword", at)
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func compileTypes(value any, path string) ([]string, error) {
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []any:
+		var err error
+		if names, err = compileStrings(v, path); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%s: must be a string or an array of strings", path)
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s: must name at least one type", path)
+	}
+	for _, name := range names {
+		if !schemaTypes[name] {
+			return nil, fmt.Errorf("%s: unknown type %q", path, name)
+		}
+	}
+
+	return names, nil
+}
+
+func compileStrings(value any, path string) ([]string, error) {
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an array of strings", path)
+	}
+
+	strs := make([]string, len(values))
+	for i, v := range values {
+		if strs[i], ok = v.(string); !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+	}
+
+	return strs, nil
+}
+
+func compileCount(value any, path string) (*uint64, error) {
+	n, err := compileNumber(value, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !n.IsInt() || n.Sign() < 0 || !n.Num().IsUint64() {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+
+	count := n.Num().Uint64()
+	return &count, nil
+}
+
+func compileNumber(value any, path string) (*big.Rat, error) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+
+	r, ok := parseNumber(num)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid number %s", path, num)
+	}
+
+	return r, nil
+}
+
+// parseNumber parses a JSON number exactly, rejecting numbers whose exponent
+// is out of range
+func parseNumber(num json.Number) (*big.Rat, bool) {
+	str := num.String()
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxNumberExponent || exp < -maxNumberExponent {
+			return nil, false
+		}
+	}
+
+	return new(big.Rat).SetString(str)
+}
+
+func (s *RecordSchema) validate(value any, path string, depth int) error {
+	if depth > maxSchemaDepth {
+		return fmt.Errorf("%s: document is nested deeper than %d levels", path, maxSchemaDepth)
+	}
+
+	if len(s.types) > 0 && !s.matchesType(value) {
+		return fmt.Errorf("%s: expected %v", path, s.types)
+	}
+
+	if len(s.enum) > 0 {
+		found := false
+		for _, allowed := range s.enum {
+			if jsonEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	if s.hasConst && !jsonEqual(value, s.constant) {
+		return fmt.Errorf("%s: value does not match the constant", path)
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return s.validateObject(v, path, depth)
+	case []any:
+		return s.validateArray(v, path, depth)
+	case string:
+		return s.validateString(v, path)
+	case json.Number:
+		return s.validateNumber(v, path)
+	}
+
+	return nil
+}
+
+func (s *RecordSchema) validateObject(obj map[string]any, path string, depth int) error {
+	for _, name := range s.required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing required property %q", path, name)
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		at := path + "." + name
+
+		prop, ok := s.properties[name]
+		switch {
+		case ok:
+		case s.noAdditional:
+			return fmt.Errorf("%s: property is not allowed", at)
+		case s.additionalProperties != nil:
+			prop = s.additionalProperties
+		default:
+			continue
+		}
+
+		if err := prop.validate(obj[name], at, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *RecordSchema) validateArray(arr []any, path string, depth int) error {
+	n := uint64(len(arr))
+	if s.minItems != nil && n < *s.minItems {
+		return fmt.Errorf("%s: expected at least %d items, got %d", path, *s.minItems, n)
+	}
+	if s.maxItems != nil && n > *s.maxItems {
+		return fmt.Errorf("%s: expected at most %d items, got %d", path, *s.maxItems, n)
+	}
+
+	if s.items == nil {
+		return nil
+	}
+	for i, item := range arr {
+		if err := s.items.validate(item, fmt.Sprintf("%s[%d]", path, i), depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *RecordSchema) validateString(str, path string) error {
+	n := uint64(utf8.RuneCountInString(str))
+	if s.minLength != nil && n < *s.minLength {
+		return fmt.Errorf("%s: expected at least %d characters, got %d", path, *s.minLength, n)
+	}
+	if s.maxLength != nil && n > *s.maxLength {
+		return fmt.Errorf("%s: expected at most %d characters, got %d", path, *s.maxLength, n)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		return fmt.Errorf("%s: does not match pattern %s", path, s.pattern)
+	}
+
+	return nil
+}
+
+func (s *RecordSchema) validateNumber(num json.Number, path string) error {
+	r, ok := parseNumber(num)
+	if !ok {
+		return fmt.Errorf("%s: invalid number %s", path, num)
+	}
+
+	if s.minimum != nil && r.Cmp(s.minimum) < 0 {
+		return fmt.Errorf("%s: %s is less than %s", path, num, s.minimum.RatString())
+	}
+	if s.maximum != nil && r.Cmp(s.maximum) > 0 {
+		return fmt.Errorf("%s: %s is greater than %s", path, num, s.maximum.RatString())
+	}
+	if s.exclusiveMinimum != nil && r.Cmp(s.exclusiveMinimum) <= 0 {
+		return fmt.Errorf("%s: %s is not greater than %s", path, num, s.exclusiveMinimum.RatString())
+	}
+	if s.exclusiveMaximum != nil && r.Cmp(s.exclusiveMaximum) >= 0 {
+		return fmt.Errorf("%s: %s is not less than %s", path, num, s.exclusiveMaximum.RatString())
+	}
+
+	return nil
+}
+
+func (s *RecordSchema) matchesType(value any) bool {
+	for _, name := range s.types {
+		switch v := value.(type) {
+		case map[string]any:
+			if name == "object" {
+				return true
+			}
+		case []any:
+			if name == "array" {
+				return true
+			}
+		case string:
+			if name == "string" {
+				return true
+			}
+		case bool:
+			if name == "boolean" {
+				return true
+			}
+		case nil:
+			if name == "null" {
+				return true
+			}
+		case json.Number:
+			if name == "number" {
+				return true
+			}
+			if name == "integer" {
+				if r, ok := parseNumber(v); ok && r.IsInt() {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// jsonEqual compares decoded JSON values, treating numbers by value
+func jsonEqual(a, b any) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ar, aok := parseNumber(av)
+		br, bok := parseNumber(bv)
+		return aok && bok && ar.Cmp(br) == 0
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if other, ok := bv[k]; !ok || !jsonEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
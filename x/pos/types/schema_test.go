@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NeomSense/PoS/x/pos/types"
+)
+
+const sensorSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "sensor reading",
+	"type": "object",
+	"required": ["sensor", "value"],
+	"additionalProperties": false,
+	"properties": {
+		"sensor": {"type": "string", "pattern": "^s-[0-9]+$", "maxLength": 16},
+		"value": {"type": "number", "minimum": -40, "exclusiveMaximum": 125.5},
+		"unit": {"enum": ["C", "F"]},
+		"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+		"count": {"type": "integer"}
+	}
+}`
+
+func TestRecordSchemaValidate(t *testing.T) {
+	schema, err := types.CompileRecordSchema(sensorSchema)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc  string
+		data  string
+		valid bool
+	}{
+		{desc: "valid", data: `{"sensor": "s-1", "value": 21.5, "unit": "C", "tags": ["a"], "count": 3.0}`, valid: true},
+		{desc: "not json", data: `sensor=s-1`},
+		{desc: "trailing data", data: `{"sensor": "s-1", "value": 1} {}`},
+		{desc: "wrong type", data: `["s-1", 1]`},
+		{desc: "missing required", data: `{"sensor": "s-1"}`},
+		{desc: "additional property", data: `{"sensor": "s-1", "value": 1, "extra": true}`},
+		{desc: "pattern mismatch", data: `{"sensor": "x-1", "value": 1}`},
+		{desc: "below minimum", data: `{"sensor": "s-1", "value": -40.0001}`},
+		{desc: "at exclusive maximum", data: `{"sensor": "s-1", "value": 125.5}`},
+		{desc: "not in enum", data: `{"sensor": "s-1", "value": 1, "unit": "K"}`},
+		{desc: "too many items", data: `{"sensor": "s-1", "value": 1, "tags": ["a", "b", "c"]}`},
+		{desc: "bad item", data: `{"sensor": "s-1", "value": 1, "tags": [1]}`},
+		{desc: "not an integer", data: `{"sensor": "s-1", "value": 1, "count": 1.5}`},
+		{desc: "huge exponent", data: `{"sensor": "s-1", "value": 1e999999999}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := schema.Validate([]byte(tc.data))
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrSchemaValidation)
+			}
+		})
+	}
+}
+
+func TestCompileRecordSchema(t *testing.T) {
+	tests := []struct {
+		desc   string
+		schema string
+		valid  bool
+	}{
+		{desc: "empty object", schema: `{}`, valid: true},
+		{desc: "not json", schema: `{"type":`},
+		{desc: "not an object", schema: `["object"]`},
+		{desc: "unknown type", schema: `{"type": "date"}`},
+		{desc: "unsupported keyword", schema: `{"oneOf": [{"type": "string"}]}`},
+		{desc: "invalid pattern", schema: `{"pattern": "("}`},
+		{desc: "negative count", schema: `{"maxLength": -1}`},
+		{desc: "empty enum", schema: `{"enum": []}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := types.CompileRecordSchema(tc.schema)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRecordType)
+			}
+		})
+	}
+	// the first invalid property by name is always the one reported
+	schema := `{"properties": {"b": {"type": "date"}, "a": {"type": "time"}, "c": {"pattern": "("}}}`
+	for i := 0; i < 20; i++ {
+		_, err := types.CompileRecordSchema(schema)
+		require.ErrorContains(t, err, "/properties/a")
+	}
+}
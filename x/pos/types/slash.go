@@ -0,0 +1,5 @@
+package types
+
+// MaxSlashHeightRange is the largest number of blocks the slash history can be
+// queried for at once
+const MaxSlashHeightRange = 10000
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/slash.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashEvent is a slash x/pos applied to a validator, kept as slash history
+type SlashEvent struct {
+	Id               uint64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string                      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Reason           string                      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Fraction         cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// amount is the amount of tokens burned, as returned by x/staking
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// record_id is the record the slash relates to, if any
+	RecordId string `protobuf:"bytes,6,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// epoch is the epoch the slash was applied in; missed record slashes are
+	// applied as the epoch they relate to ends
+	Epoch       uint64 `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime   int64  `protobuf:"varint,9,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7909e03bfd6a7914, []int{0}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+func (m *SlashEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SlashEvent)(nil), "pos.pos.v1.SlashEvent")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/slash.proto", fileDescriptor_7909e03bfd6a7914) }
+
+var fileDescriptor_7909e03bfd6a7914 = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6a, 0x13, 0x41,
+	0x18, 0xc7, 0x33, 0x49, 0x1a, 0x93, 0x51, 0x44, 0x87, 0x5a, 0xc6, 0x96, 0x6e, 0x52, 0xf1, 0x10,
+	0x90, 0xee, 0x12, 0xbc, 0x79, 0x10, 0xac, 0x15, 0x0c, 0x68, 0x91, 0x8d, 0x78, 0xf0, 0x12, 0x26,
+	0x33, 0xe3, 0xee, 0xd0, 0xec, 0x7c, 0xcb, 0xcc, 0x74, 0xb1, 0x6f, 0x21, 0xf8, 0x02, 0x3e, 0x44,
+	0x1f, 0xa2, 0xc7, 0xd2, 0x93, 0x78, 0x08, 0x92, 0x5c, 0x7c, 0x0c, 0xd9, 0x99, 0x55, 0xc4, 0x1e,
+	0x06, 0xf6, 0xfb, 0x7d, 0xff, 0xfd, 0x7d, 0x1f, 0xbb, 0x83, 0x77, 0x4a, 0xb0, 0x49, 0x7d, 0xaa,
+	0x49, 0x62, 0x97, 0xcc, 0xe6, 0x71, 0x69, 0xc0, 0x01, 0xc1, 0x25, 0xd8, 0xb8, 0x3e, 0xd5, 0x64,
+	0xf7, 0x21, 0x07, 0x5b, 0x80, 0x9d, 0xfb, 0x4e, 0x12, 0x8a, 0x10, 0xdb, 0xdd, 0xce, 0x20, 0x83,
+	0xc0, 0xeb, 0xa7, 0x40, 0x1f, 0x7d, 0xed, 0x60, 0x3c, 0xab, 0x65, 0xaf, 0x2a, 0xa9, 0x1d, 0xb9,
+	0x8b, 0xdb, 0x4a, 0x50, 0x34, 0x42, 0xe3, 0x6e, 0xda, 0x56, 0x82, 0x9c, 0xe0, 0xfb, 0x15, 0x5b,
+	0x2a, 0xc1, 0x1c, 0x98, 0x39, 0x13, 0xc2, 0x48, 0x6b, 0x69, 0x7b, 0x84, 0xc6, 0x83, 0xa3, 0x83,
+	0xeb, 0x8b, 0xc3, 0xfd, 0x66, 0xc2, 0x87, 0x3f, 0x99, 0x17, 0x21, 0x32, 0x73, 0x46, 0xe9, 0x2c,
+	0xbd, 0x57, 0xfd, 0xc7, 0xc9, 0x0e, 0xee, 0x19, 0xc9, 0x2c, 0x68, 0xda, 0xa9, 0x25, 0x69, 0x53,
+	0x91, 0xb7, 0xb8, 0xff, 0xc9, 0x30, 0xee, 0x14, 0x68, 0xda, 0xf5, 0xfa, 0xc9, 0xe5, 0x6a, 0xd8,
+	0xfa, 0xb1, 0x1a, 0xee, 0x85, 0x11, 0x56, 0x9c, 0xc6, 0x0a, 0x92, 0x82, 0xb9, 0x3c, 0x7e, 0x23,
+	0x33, 0xc6, 0xcf, 0x8f, 0x25, 0xbf, 0xbe, 0x38, 0xc4, 0xcd, 0x06, 0xc7, 0x92, 0xa7, 0x7f, 0x15,
+	0xe4, 0x25, 0xee, 0xb1, 0x02, 0xce, 0xb4, 0xa3, 0x5b, 0x5e, 0xf6, 0xa4, 0x91, 0x3d, 0xb8, 0x29,
+	0x9b, 0x6a, 0xf7, 0x8f, 0x66, 0xaa, 0x5d, 0xda, 0xbc, 0x4a, 0xf6, 0xf0, 0xc0, 0x48, 0x0e, 0x46,
+	0xcc, 0x95, 0xa0, 0x3d, 0xbf, 0x6e, 0x3f, 0x80, 0xa9, 0x20, 0xdb, 0x78, 0x4b, 0x96, 0xc0, 0x73,
+	0x7a, 0xcb, 0x7f, 0xab, 0x50, 0x90, 0x03, 0x7c, 0x67, 0xb1, 0x04, 0x7e, 0x3a, 0xcf, 0xa5, 0xca,
+	0x72, 0x47, 0xfb, 0xbe, 0x79, 0xdb, 0xb3, 0xd7, 0x1e, 0x91, 0x7d, 0x8c, 0x43, 0xc4, 0xa9, 0x42,
+	0xd2, 0xc1, 0x08, 0x8d, 0x3b, 0xe9, 0xc0, 0x93, 0xf7, 0xaa, 0x90, 0xcf, 0xba, 0xbf, 0xbe, 0x0d,
+	0xd1, 0xd1, 0xf3, 0xcb, 0x75, 0x84, 0xae, 0xd6, 0x11, 0xfa, 0xb9, 0x8e, 0xd0, 0x97, 0x4d, 0xd4,
+	0xba, 0xda, 0x44, 0xad, 0xef, 0x9b, 0xa8, 0xf5, 0xf1, 0x71, 0xa6, 0x5c, 0x7e, 0xb6, 0x88, 0x39,
+	0x14, 0xc9, 0x89, 0x84, 0x62, 0x26, 0xb5, 0x95, 0xc9, 0x3b, 0x98, 0x25, 0x9f, 0xfd, 0xdd, 0x70,
+	0xe7, 0xa5, 0xb4, 0x8b, 0x9e, 0xff, 0xb9, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x75, 0x66,
+	0xf2, 0x1e, 0x33, 0x02, 0x00, 0x00,
+}
+
+func (this *SlashEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashEvent)
+	if !ok {
+		that2, ok := that.(SlashEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.RecordId != that1.RecordId {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.BlockTime != that1.BlockTime {
+		return false
+	}
+	return true
+}
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlash(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSlash(uint64(m.Epoch))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSlash(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovSlash(uint64(m.BlockTime))
+	}
+	return n
+}
+
+func sovSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlash(x uint64) (n int) {
+	return sovSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlash = fmt.Errorf("proto: unexpected end of group")
+)
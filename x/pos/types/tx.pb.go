@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -131,6 +133,16 @@ type MsgSubmitRecord struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Data             []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	MerkleRoot       string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// data_locator, when set, submits an off-chain record: data must be empty and
+	// only merkle_root, data_size and data_locator are stored
+	DataLocator string `protobuf:"bytes,4,opt,name=data_locator,json=dataLocator,proto3" json:"data_locator,omitempty"`
+	DataSize    uint64 `protobuf:"varint,5,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	// record_type, when set, names the registered record type the data must
+	// conform to
+	RecordType string `protobuf:"bytes,6,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// content_encoding declares the compression applied to data. Off-chain
+	// records must not be encoded.
+	ContentEncoding ContentEncoding `protobuf:"varint,7,opt,name=content_encoding,json=contentEncoding,proto3,enum=pos.pos.v1.ContentEncoding" json:"content_encoding,omitempty"`
 }
 
 func (m *MsgSubmitRecord) Reset()         { *m = MsgSubmitRecord{} }
@@ -187,6 +199,34 @@ func (m *MsgSubmitRecord) GetMerkleRoot() string {
 	return ""
 }
 
+func (m *MsgSubmitRecord) GetDataLocator() string {
+	if m != nil {
+		return m.DataLocator
+	}
+	return ""
+}
+
+func (m *MsgSubmitRecord) GetDataSize() uint64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+func (m *MsgSubmitRecord) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *MsgSubmitRecord) GetContentEncoding() ContentEncoding {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ContentEncodingIdentity
+}
+
 // MsgSubmitRecordResponse defines the response for MsgSubmitRecord
 type MsgSubmitRecordResponse struct {
 	RecordId  string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
@@ -303,6 +343,9 @@ func (m *MsgVerifyRecord) GetApproved() bool {
 
 // MsgVerifyRecordResponse defines the response for MsgVerifyRecord
 type MsgVerifyRecordResponse struct {
+	// status is the record status after the vote was tallied. It stays pending
+	// until quorum is reached.
+	Status RecordStatus `protobuf:"varint,1,opt,name=status,proto3,enum=pos.pos.v1.RecordStatus" json:"status,omitempty"`
 }
 
 func (m *MsgVerifyRecordResponse) Reset()         { *m = MsgVerifyRecordResponse{} }
@@ -338,569 +381,7111 @@ func (m *MsgVerifyRecordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVerifyRecordResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "pos.pos.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pos.pos.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSubmitRecord)(nil), "pos.pos.v1.MsgSubmitRecord")
-	proto.RegisterType((*MsgSubmitRecordResponse)(nil), "pos.pos.v1.MsgSubmitRecordResponse")
-	proto.RegisterType((*MsgVerifyRecord)(nil), "pos.pos.v1.MsgVerifyRecord")
-	proto.RegisterType((*MsgVerifyRecordResponse)(nil), "pos.pos.v1.MsgVerifyRecordResponse")
+func (m *MsgVerifyRecordResponse) GetStatus() RecordStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RecordStatusUnspecified
 }
 
-func init() { proto.RegisterFile("pos/pos/v1/tx.proto", fileDescriptor_817de2ffe3d88e09) }
+// MsgCommitVerification is the message for committing to a sealed vote on a
+// record
+type MsgCommitVerification struct {
+	Verifier string `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	RecordId string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// commitment is sha256(record_id | verifier | approved | salt), see
+	// types.VerificationCommitment
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
 
-var fileDescriptor_817de2ffe3d88e09 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x6f, 0x12, 0x41,
-	0x18, 0x65, 0x8b, 0x36, 0x30, 0x25, 0xd1, 0x8e, 0x4d, 0x0a, 0x54, 0xb7, 0x15, 0x3d, 0x34, 0x68,
-	0xd9, 0xb4, 0x56, 0x0f, 0x1c, 0x4c, 0xe4, 0xe6, 0xa1, 0x0d, 0x59, 0xb4, 0x07, 0x2f, 0x64, 0x60,
-	0xc7, 0xed, 0xc6, 0x0e, 0xdf, 0x66, 0x66, 0xd8, 0x94, 0x9b, 0xe9, 0xd1, 0x93, 0x3f, 0xc3, 0x8b,
-	0x86, 0x43, 0x7f, 0x44, 0x0f, 0x1e, 0x9a, 0x9e, 0x3c, 0x19, 0x03, 0x07, 0xfe, 0x86, 0xd9, 0x99,
-	0x01, 0x96, 0xa5, 0xd1, 0xc3, 0x92, 0x9d, 0xf7, 0x3e, 0xde, 0xbc, 0xf7, 0x66, 0x00, 0x3d, 0x08,
-	0x41, 0x38, 0xf1, 0x13, 0xed, 0x3b, 0xf2, 0xbc, 0x16, 0x72, 0x90, 0x80, 0x51, 0x08, 0xa2, 0x16,
-	0x3f, 0xd1, 0x7e, 0x79, 0x9d, 0xb0, 0xa0, 0x07, 0x8e, 0xfa, 0xd4, 0x74, 0x79, 0xb3, 0x0b, 0x82,
-	0x81, 0x70, 0x98, 0xf0, 0xe3, 0xaf, 0x31, 0xe1, 0x1b, 0xa2, 0xa4, 0x89, 0xb6, 0x5a, 0x39, 0x7a,
-	0x61, 0xa8, 0x0d, 0x1f, 0x7c, 0xd0, 0x78, 0xfc, 0x36, 0x55, 0x4a, 0xec, 0x1e, 0x12, 0x4e, 0x98,
-	0x19, 0xaf, 0xfc, 0xb0, 0xd0, 0xbd, 0x23, 0xe1, 0xbf, 0x0f, 0x3d, 0x22, 0x69, 0x53, 0x31, 0xf8,
-	0x15, 0xca, 0x93, 0xbe, 0x3c, 0x05, 0x1e, 0xc8, 0x41, 0xd1, 0xda, 0xb1, 0x76, 0xf3, 0x8d, 0xe2,
-	0xcd, 0xe5, 0xde, 0x86, 0xd9, 0xe7, 0x8d, 0xe7, 0x71, 0x2a, 0x44, 0x4b, 0xf2, 0xa0, 0xe7, 0xbb,
-	0xf3, 0x51, 0xfc, 0x12, 0xad, 0x6a, 0xed, 0xe2, 0xca, 0x8e, 0xb5, 0xbb, 0x76, 0x80, 0x6b, 0xf3,
-	0x78, 0x35, 0xad, 0xdd, 0xc8, 0x5f, 0xfd, 0xde, 0xce, 0x7c, 0x9b, 0x0c, 0xab, 0x96, 0x6b, 0x86,
-	0xeb, 0xcf, 0x2f, 0x26, 0xc3, 0xea, 0x5c, 0xe6, 0xcb, 0x64, 0x58, 0x2d, 0xc5, 0x56, 0xcf, 0x95,
-	0xe1, 0x94, 0xb9, 0x4a, 0x09, 0x6d, 0xa6, 0x20, 0x97, 0x8a, 0x10, 0x7a, 0x82, 0x56, 0x7e, 0xea,
-	0x2c, 0xad, 0x7e, 0x87, 0x05, 0xd2, 0xa5, 0x5d, 0xe0, 0x1e, 0x3e, 0x46, 0xeb, 0x11, 0x39, 0x0b,
-	0x3c, 0x22, 0x81, 0xb7, 0x89, 0x76, 0x6e, 0x32, 0x3d, 0xbe, 0xb9, 0xdc, 0x7b, 0x64, 0x32, 0x9d,
-	0x4c, 0x67, 0x16, 0xc3, 0xdd, 0x8f, 0x52, 0x38, 0xc6, 0xe8, 0x8e, 0x47, 0x24, 0x51, 0x09, 0x0b,
-	0xae, 0x7a, 0xc7, 0xdb, 0x68, 0x8d, 0x51, 0xfe, 0xe9, 0x8c, 0xb6, 0x39, 0x80, 0x2c, 0x66, 0x63,
-	0x75, 0x17, 0x69, 0xc8, 0x05, 0x90, 0xf5, 0xc3, 0x38, 0xe1, 0xb2, 0x8f, 0xe5, 0xa4, 0x49, 0xeb,
-	0x95, 0x77, 0x2a, 0x69, 0x12, 0x9a, 0x26, 0xc5, 0x5b, 0x28, 0xcf, 0x15, 0xd2, 0x0e, 0x3c, 0x9d,
-	0xc6, 0xcd, 0x69, 0xe0, 0xad, 0x87, 0x1f, 0xa2, 0xbc, 0x0c, 0x18, 0x15, 0x92, 0xb0, 0x50, 0xf9,
-	0xcc, 0xba, 0x73, 0xa0, 0xf2, 0x5d, 0x97, 0x74, 0x42, 0x79, 0xf0, 0x71, 0x60, 0x4a, 0x3a, 0x44,
-	0xb9, 0x28, 0x5e, 0x07, 0x94, 0xff, 0xf7, 0xbc, 0x67, 0x93, 0x8b, 0x26, 0x56, 0x52, 0x26, 0xca,
-	0x28, 0x47, 0xc2, 0x90, 0x43, 0x44, 0x3d, 0x55, 0x48, 0xce, 0x9d, 0xad, 0xeb, 0xcf, 0xe2, 0x3a,
-	0x66, 0x3a, 0xcb, 0x2d, 0x24, 0xbd, 0x99, 0xf3, 0x4e, 0x42, 0xd3, 0x16, 0x0e, 0x2e, 0x56, 0x50,
-	0xf6, 0x48, 0xf8, 0xb8, 0x89, 0x0a, 0x0b, 0xf7, 0x77, 0x2b, 0x79, 0xef, 0x52, 0x97, 0xa5, 0xfc,
-	0xe4, 0x1f, 0xe4, 0xac, 0xdf, 0x26, 0x2a, 0x2c, 0xdc, 0xa2, 0xb4, 0x62, 0x92, 0x5c, 0x52, 0xbc,
-	0xf5, 0xc4, 0x9a, 0xa8, 0xb0, 0x50, 0x79, 0x5a, 0x31, 0x49, 0x2e, 0x29, 0xde, 0x96, 0xbe, 0x7c,
-	0xf7, 0x73, 0xfc, 0x2b, 0x6a, 0xbc, 0xbe, 0x1a, 0xd9, 0xd6, 0xf5, 0xc8, 0xb6, 0xfe, 0x8c, 0x6c,
-	0xeb, 0xeb, 0xd8, 0xce, 0x5c, 0x8f, 0xed, 0xcc, 0xaf, 0xb1, 0x9d, 0xf9, 0xf0, 0xd4, 0x0f, 0xe4,
-	0x69, 0xbf, 0x53, 0xeb, 0x02, 0x73, 0x8e, 0x29, 0xb0, 0x16, 0xed, 0x09, 0xea, 0x34, 0xa1, 0x65,
-	0x9a, 0x96, 0x83, 0x90, 0x8a, 0xce, 0xaa, 0xfa, 0x1f, 0x78, 0xf1, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x13, 0xcf, 0x4c, 0xe2, 0xa0, 0x04, 0x00, 0x00,
+func (m *MsgCommitVerification) Reset()         { *m = MsgCommitVerification{} }
+func (m *MsgCommitVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVerification) ProtoMessage()    {}
+func (*MsgCommitVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{6}
+}
+func (m *MsgCommitVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVerification.Merge(m, src)
+}
+func (m *MsgCommitVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVerification.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCommitVerification proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCommitVerification) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SubmitRecord allows validators to submit a proof-of-record
-	SubmitRecord(ctx context.Context, in *MsgSubmitRecord, opts ...grpc.CallOption) (*MsgSubmitRecordResponse, error)
-	// VerifyRecord allows verification of a submitted record
-	VerifyRecord(ctx context.Context, in *MsgVerifyRecord, opts ...grpc.CallOption) (*MsgVerifyRecordResponse, error)
+func (m *MsgCommitVerification) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCommitVerification) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgCommitVerificationResponse defines the response for MsgCommitVerification
+type MsgCommitVerificationResponse struct {
+	RevealStartHeight uint64 `protobuf:"varint,1,opt,name=reveal_start_height,json=revealStartHeight,proto3" json:"reveal_start_height,omitempty"`
+	RevealEndHeight   uint64 `protobuf:"varint,2,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCommitVerificationResponse) Reset()         { *m = MsgCommitVerificationResponse{} }
+func (m *MsgCommitVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVerificationResponse) ProtoMessage()    {}
+func (*MsgCommitVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{7}
+}
+func (m *MsgCommitVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCommitVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVerificationResponse.Merge(m, src)
+}
+func (m *MsgCommitVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVerificationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitRecord(ctx context.Context, in *MsgSubmitRecord, opts ...grpc.CallOption) (*MsgSubmitRecordResponse, error) {
-	out := new(MsgSubmitRecordResponse)
-	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/SubmitRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCommitVerificationResponse proto.InternalMessageInfo
+
+func (m *MsgCommitVerificationResponse) GetRevealStartHeight() uint64 {
+	if m != nil {
+		return m.RevealStartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) VerifyRecord(ctx context.Context, in *MsgVerifyRecord, opts ...grpc.CallOption) (*MsgVerifyRecordResponse, error) {
-	out := new(MsgVerifyRecordResponse)
-	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/VerifyRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCommitVerificationResponse) GetRevealEndHeight() uint64 {
+	if m != nil {
+		return m.RevealEndHeight
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SubmitRecord allows validators to submit a proof-of-record
-	SubmitRecord(context.Context, *MsgSubmitRecord) (*MsgSubmitRecordResponse, error)
-	// VerifyRecord allows verification of a submitted record
-	VerifyRecord(context.Context, *MsgVerifyRecord) (*MsgVerifyRecordResponse, error)
+// MsgRevealVerification is the message for opening a committed vote
+type MsgRevealVerification struct {
+	Verifier string `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	RecordId string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Approved bool   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Salt     []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRevealVerification) Reset()         { *m = MsgRevealVerification{} }
+func (m *MsgRevealVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVerification) ProtoMessage()    {}
+func (*MsgRevealVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{8}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRevealVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitRecord(ctx context.Context, req *MsgSubmitRecord) (*MsgSubmitRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitRecord not implemented")
+func (m *MsgRevealVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) VerifyRecord(ctx context.Context, req *MsgVerifyRecord) (*MsgVerifyRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecord not implemented")
+func (m *MsgRevealVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVerification.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRevealVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVerification.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.pos.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgRevealVerification proto.InternalMessageInfo
+
+func (m *MsgRevealVerification) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.pos.v1.Msg/SubmitRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitRecord(ctx, req.(*MsgSubmitRecord))
+func (m *MsgRevealVerification) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_VerifyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVerifyRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VerifyRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.pos.v1.Msg/VerifyRecord",
+func (m *MsgRevealVerification) GetApproved() bool {
+	if m != nil {
+		return m.Approved
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VerifyRecord(ctx, req.(*MsgVerifyRecord))
+	return false
+}
+
+func (m *MsgRevealVerification) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pos.pos.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SubmitRecord",
-			Handler:    _Msg_SubmitRecord_Handler,
-		},
-		{
-			MethodName: "VerifyRecord",
-			Handler:    _Msg_VerifyRecord_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pos/pos/v1/tx.proto",
+// MsgRevealVerificationResponse defines the response for MsgRevealVerification
+type MsgRevealVerificationResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealVerificationResponse) Reset()         { *m = MsgRevealVerificationResponse{} }
+func (m *MsgRevealVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVerificationResponse) ProtoMessage()    {}
+func (*MsgRevealVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{9}
+}
+func (m *MsgRevealVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVerificationResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevealVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealVerificationResponse proto.InternalMessageInfo
+
+// MsgChallengeAvailability is the message for challenging the data
+// availability of an off-chain record
+type MsgChallengeAvailability struct {
+	Challenger string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	RecordId   string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgChallengeAvailability) Reset()         { *m = MsgChallengeAvailability{} }
+func (m *MsgChallengeAvailability) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeAvailability) ProtoMessage()    {}
+func (*MsgChallengeAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{10}
+}
+func (m *MsgChallengeAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeAvailability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgChallengeAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeAvailability.Merge(m, src)
+}
+func (m *MsgChallengeAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeAvailability.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChallengeAvailability proto.InternalMessageInfo
+
+func (m *MsgChallengeAvailability) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgChallengeAvailability) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgChallengeAvailabilityResponse defines the response for MsgChallengeAvailability
+type MsgChallengeAvailabilityResponse struct {
+	ChallengeId    uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	ChunkIndex     uint64 `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	DeadlineHeight uint64 `protobuf:"varint,3,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
 }
 
-func (m *MsgSubmitRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChallengeAvailabilityResponse) Reset()         { *m = MsgChallengeAvailabilityResponse{} }
+func (m *MsgChallengeAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeAvailabilityResponse) ProtoMessage()    {}
+func (*MsgChallengeAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{11}
+}
+func (m *MsgChallengeAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgChallengeAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeAvailabilityResponse.Merge(m, src)
+}
+func (m *MsgChallengeAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeAvailabilityResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MerkleRoot) > 0 {
-		i -= len(m.MerkleRoot)
-		copy(dAtA[i:], m.MerkleRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgChallengeAvailabilityResponse proto.InternalMessageInfo
+
+func (m *MsgChallengeAvailabilityResponse) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgChallengeAvailabilityResponse) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSubmitRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChallengeAvailabilityResponse) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubmitRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRespondAvailabilityChallenge is the message for answering an availability
+// challenge. Either data holds the full record data, or chunk and proof prove
+// the challenged chunk against the record's merkle root.
+type MsgRespondAvailabilityChallenge struct {
+	ValidatorAddress string       `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChallengeId      uint64       `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Data             []byte       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Chunk            []byte       `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Proof            *MerkleProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *MsgSubmitRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.RecordId) > 0 {
-		i -= len(m.RecordId)
-		copy(dAtA[i:], m.RecordId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRespondAvailabilityChallenge) Reset()         { *m = MsgRespondAvailabilityChallenge{} }
+func (m *MsgRespondAvailabilityChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondAvailabilityChallenge) ProtoMessage()    {}
+func (*MsgRespondAvailabilityChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{12}
+}
+func (m *MsgRespondAvailabilityChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondAvailabilityChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondAvailabilityChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRespondAvailabilityChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondAvailabilityChallenge.Merge(m, src)
+}
+func (m *MsgRespondAvailabilityChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondAvailabilityChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondAvailabilityChallenge.DiscardUnknown(m)
 }
 
-func (m *MsgVerifyRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRespondAvailabilityChallenge proto.InternalMessageInfo
+
+func (m *MsgRespondAvailabilityChallenge) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgVerifyRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRespondAvailabilityChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
 }
 
-func (m *MsgVerifyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Approved {
-		i--
-		if m.Approved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.RecordId) > 0 {
-		i -= len(m.RecordId)
-		copy(dAtA[i:], m.RecordId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Verifier) > 0 {
-		i -= len(m.Verifier)
-		copy(dAtA[i:], m.Verifier)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Verifier)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRespondAvailabilityChallenge) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgVerifyRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRespondAvailabilityChallenge) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgVerifyRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRespondAvailabilityChallenge) GetProof() *MerkleProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *MsgVerifyRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRespondAvailabilityChallengeResponse defines the response for
+// MsgRespondAvailabilityChallenge
+type MsgRespondAvailabilityChallengeResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRespondAvailabilityChallengeResponse) Reset() {
+	*m = MsgRespondAvailabilityChallengeResponse{}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgRespondAvailabilityChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondAvailabilityChallengeResponse) ProtoMessage()    {}
+func (*MsgRespondAvailabilityChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{13}
+}
+func (m *MsgRespondAvailabilityChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondAvailabilityChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondAvailabilityChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRespondAvailabilityChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondAvailabilityChallengeResponse.Merge(m, src)
+}
+func (m *MsgRespondAvailabilityChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondAvailabilityChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondAvailabilityChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRespondAvailabilityChallengeResponse proto.InternalMessageInfo
+
+// MsgDisputeRecord is the message for disputing a record under optimistic
+// verification
+type MsgDisputeRecord struct {
+	Disputer string `protobuf:"bytes,1,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	RecordId string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// reason is at most 512 bytes long
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgDisputeRecord) Reset()         { *m = MsgDisputeRecord{} }
+func (m *MsgDisputeRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeRecord) ProtoMessage()    {}
+func (*MsgDisputeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{14}
+}
+func (m *MsgDisputeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgDisputeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeRecord.Merge(m, src)
+}
+func (m *MsgDisputeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeRecord.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitRecord) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgDisputeRecord proto.InternalMessageInfo
+
+func (m *MsgDisputeRecord) GetDisputer() string {
+	if m != nil {
+		return m.Disputer
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDisputeRecord) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDisputeRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.MerkleRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgDisputeRecordResponse defines the response for MsgDisputeRecord
+type MsgDisputeRecordResponse struct {
+}
+
+func (m *MsgDisputeRecordResponse) Reset()         { *m = MsgDisputeRecordResponse{} }
+func (m *MsgDisputeRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeRecordResponse) ProtoMessage()    {}
+func (*MsgDisputeRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{15}
+}
+func (m *MsgDisputeRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgDisputeRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeRecordResponse.Merge(m, src)
+}
+func (m *MsgDisputeRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeRecordResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgDisputeRecordResponse proto.InternalMessageInfo
+
+// MsgSetRecordType is the Msg/SetRecordType request type.
+type MsgSetRecordType struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority  string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RecordType RecordType `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type"`
+}
+
+func (m *MsgSetRecordType) Reset()         { *m = MsgSetRecordType{} }
+func (m *MsgSetRecordType) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRecordType) ProtoMessage()    {}
+func (*MsgSetRecordType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{16}
+}
+func (m *MsgSetRecordType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRecordType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRecordType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.RecordId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetRecordType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRecordType.Merge(m, src)
+}
+func (m *MsgSetRecordType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRecordType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRecordType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRecordType proto.InternalMessageInfo
+
+func (m *MsgSetRecordType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTx(uint64(m.Timestamp))
+	return ""
+}
+
+func (m *MsgSetRecordType) GetRecordType() RecordType {
+	if m != nil {
+		return m.RecordType
 	}
-	return n
+	return RecordType{}
 }
 
-func (m *MsgVerifyRecord) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSetRecordTypeResponse defines the response for MsgSetRecordType
+type MsgSetRecordTypeResponse struct {
+}
+
+func (m *MsgSetRecordTypeResponse) Reset()         { *m = MsgSetRecordTypeResponse{} }
+func (m *MsgSetRecordTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRecordTypeResponse) ProtoMessage()    {}
+func (*MsgSetRecordTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{17}
+}
+func (m *MsgSetRecordTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRecordTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRecordTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Verifier)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetRecordTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRecordTypeResponse.Merge(m, src)
+}
+func (m *MsgSetRecordTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRecordTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRecordTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRecordTypeResponse proto.InternalMessageInfo
+
+// MsgRemoveRecordType is the Msg/RemoveRecordType request type.
+type MsgRemoveRecordType struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRemoveRecordType) Reset()         { *m = MsgRemoveRecordType{} }
+func (m *MsgRemoveRecordType) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRecordType) ProtoMessage()    {}
+func (*MsgRemoveRecordType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{18}
+}
+func (m *MsgRemoveRecordType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRecordType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRecordType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RecordId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRemoveRecordType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRecordType.Merge(m, src)
+}
+func (m *MsgRemoveRecordType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRecordType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRecordType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRecordType proto.InternalMessageInfo
+
+func (m *MsgRemoveRecordType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Approved {
-		n += 2
+	return ""
+}
+
+func (m *MsgRemoveRecordType) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *MsgVerifyRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgRemoveRecordTypeResponse defines the response for MsgRemoveRecordType
+type MsgRemoveRecordTypeResponse struct {
+}
+
+func (m *MsgRemoveRecordTypeResponse) Reset()         { *m = MsgRemoveRecordTypeResponse{} }
+func (m *MsgRemoveRecordTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRecordTypeResponse) ProtoMessage()    {}
+func (*MsgRemoveRecordTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{19}
+}
+func (m *MsgRemoveRecordTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRecordTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRecordTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRemoveRecordTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRecordTypeResponse.Merge(m, src)
+}
+func (m *MsgRemoveRecordTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRecordTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRecordTypeResponse.DiscardUnknown(m)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_MsgRemoveRecordTypeResponse proto.InternalMessageInfo
+
+// MsgBeginRecordUpload is the message for opening a chunked record upload
+type MsgBeginRecordUpload struct {
+	ValidatorAddress string          `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	MerkleRoot       string          `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	TotalSize        uint64          `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	RecordType       string          `protobuf:"bytes,4,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	ContentEncoding  ContentEncoding `protobuf:"varint,5,opt,name=content_encoding,json=contentEncoding,proto3,enum=pos.pos.v1.ContentEncoding" json:"content_encoding,omitempty"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgBeginRecordUpload) Reset()         { *m = MsgBeginRecordUpload{} }
+func (m *MsgBeginRecordUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginRecordUpload) ProtoMessage()    {}
+func (*MsgBeginRecordUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{20}
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+func (m *MsgBeginRecordUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginRecordUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginRecordUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginRecordUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginRecordUpload.Merge(m, src)
+}
+func (m *MsgBeginRecordUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginRecordUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginRecordUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginRecordUpload proto.InternalMessageInfo
+
+func (m *MsgBeginRecordUpload) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgBeginRecordUpload) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *MsgBeginRecordUpload) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *MsgBeginRecordUpload) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *MsgBeginRecordUpload) GetContentEncoding() ContentEncoding {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ContentEncodingIdentity
+}
+
+// MsgBeginRecordUploadResponse defines the response for MsgBeginRecordUpload
+type MsgBeginRecordUploadResponse struct {
+	UploadId       uint64 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	DeadlineHeight uint64 `protobuf:"varint,2,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (m *MsgBeginRecordUploadResponse) Reset()         { *m = MsgBeginRecordUploadResponse{} }
+func (m *MsgBeginRecordUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginRecordUploadResponse) ProtoMessage()    {}
+func (*MsgBeginRecordUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{21}
+}
+func (m *MsgBeginRecordUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginRecordUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginRecordUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginRecordUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginRecordUploadResponse.Merge(m, src)
+}
+func (m *MsgBeginRecordUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginRecordUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginRecordUploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginRecordUploadResponse proto.InternalMessageInfo
+
+func (m *MsgBeginRecordUploadResponse) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+func (m *MsgBeginRecordUploadResponse) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+// MsgUploadRecordChunk is the message for uploading the next chunk of an
+// open record upload. Chunks must be uploaded in order starting at index 0.
+type MsgUploadRecordChunk struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	UploadId         uint64 `protobuf:"varint,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Index            uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Data             []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgUploadRecordChunk) Reset()         { *m = MsgUploadRecordChunk{} }
+func (m *MsgUploadRecordChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadRecordChunk) ProtoMessage()    {}
+func (*MsgUploadRecordChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{22}
+}
+func (m *MsgUploadRecordChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadRecordChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadRecordChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUploadRecordChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadRecordChunk.Merge(m, src)
+}
+func (m *MsgUploadRecordChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadRecordChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadRecordChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUploadRecordChunk proto.InternalMessageInfo
+
+func (m *MsgUploadRecordChunk) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgUploadRecordChunk) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+func (m *MsgUploadRecordChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MsgUploadRecordChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MsgUploadRecordChunkResponse defines the response for MsgUploadRecordChunk
+type MsgUploadRecordChunkResponse struct {
+	ReceivedSize uint64 `protobuf:"varint,1,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+}
+
+func (m *MsgUploadRecordChunkResponse) Reset()         { *m = MsgUploadRecordChunkResponse{} }
+func (m *MsgUploadRecordChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadRecordChunkResponse) ProtoMessage()    {}
+func (*MsgUploadRecordChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{23}
+}
+func (m *MsgUploadRecordChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadRecordChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadRecordChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUploadRecordChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadRecordChunkResponse.Merge(m, src)
+}
+func (m *MsgUploadRecordChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadRecordChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadRecordChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUploadRecordChunkResponse proto.InternalMessageInfo
+
+func (m *MsgUploadRecordChunkResponse) GetReceivedSize() uint64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
+}
+
+// MsgFinalizeRecord is the message for submitting the record assembled by an
+// upload
+type MsgFinalizeRecord struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	UploadId         uint64 `protobuf:"varint,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (m *MsgFinalizeRecord) Reset()         { *m = MsgFinalizeRecord{} }
+func (m *MsgFinalizeRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeRecord) ProtoMessage()    {}
+func (*MsgFinalizeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{24}
+}
+func (m *MsgFinalizeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeRecord.Merge(m, src)
+}
+func (m *MsgFinalizeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeRecord proto.InternalMessageInfo
+
+func (m *MsgFinalizeRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgFinalizeRecord) GetUploadId() uint64 {
+	if m != nil {
+		return m.UploadId
+	}
+	return 0
+}
+
+// MsgFinalizeRecordResponse defines the response for MsgFinalizeRecord
+type MsgFinalizeRecordResponse struct {
+	RecordId  string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *MsgFinalizeRecordResponse) Reset()         { *m = MsgFinalizeRecordResponse{} }
+func (m *MsgFinalizeRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeRecordResponse) ProtoMessage()    {}
+func (*MsgFinalizeRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{25}
+}
+func (m *MsgFinalizeRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeRecordResponse.Merge(m, src)
+}
+func (m *MsgFinalizeRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeRecordResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizeRecordResponse) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *MsgFinalizeRecordResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// MsgAmendRecord is the message for submitting a new version of a record. The
+// new record supersedes the prior one and takes the same data fields as
+// MsgSubmitRecord.
+type MsgAmendRecord struct {
+	ValidatorAddress string          `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SupersedesId     string          `protobuf:"bytes,2,opt,name=supersedes_id,json=supersedesId,proto3" json:"supersedes_id,omitempty"`
+	Data             []byte          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	MerkleRoot       string          `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	DataLocator      string          `protobuf:"bytes,5,opt,name=data_locator,json=dataLocator,proto3" json:"data_locator,omitempty"`
+	DataSize         uint64          `protobuf:"varint,6,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`
+	RecordType       string          `protobuf:"bytes,7,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	ContentEncoding  ContentEncoding `protobuf:"varint,8,opt,name=content_encoding,json=contentEncoding,proto3,enum=pos.pos.v1.ContentEncoding" json:"content_encoding,omitempty"`
+}
+
+func (m *MsgAmendRecord) Reset()         { *m = MsgAmendRecord{} }
+func (m *MsgAmendRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendRecord) ProtoMessage()    {}
+func (*MsgAmendRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{26}
+}
+func (m *MsgAmendRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendRecord.Merge(m, src)
+}
+func (m *MsgAmendRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendRecord proto.InternalMessageInfo
+
+func (m *MsgAmendRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgAmendRecord) GetSupersedesId() string {
+	if m != nil {
+		return m.SupersedesId
+	}
+	return ""
+}
+
+func (m *MsgAmendRecord) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgAmendRecord) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *MsgAmendRecord) GetDataLocator() string {
+	if m != nil {
+		return m.DataLocator
+	}
+	return ""
+}
+
+func (m *MsgAmendRecord) GetDataSize() uint64 {
+	if m != nil {
+		return m.DataSize
+	}
+	return 0
+}
+
+func (m *MsgAmendRecord) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *MsgAmendRecord) GetContentEncoding() ContentEncoding {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ContentEncodingIdentity
+}
+
+// MsgAmendRecordResponse defines the response for MsgAmendRecord
+type MsgAmendRecordResponse struct {
+	RecordId  string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *MsgAmendRecordResponse) Reset()         { *m = MsgAmendRecordResponse{} }
+func (m *MsgAmendRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendRecordResponse) ProtoMessage()    {}
+func (*MsgAmendRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{27}
+}
+func (m *MsgAmendRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendRecordResponse.Merge(m, src)
+}
+func (m *MsgAmendRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendRecordResponse proto.InternalMessageInfo
+
+func (m *MsgAmendRecordResponse) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *MsgAmendRecordResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// MsgFundRewardPool is the message for depositing coins into the reward pool
+type MsgFundRewardPool struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
+func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPool) ProtoMessage()    {}
+func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{28}
+}
+func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPool.Merge(m, src)
+}
+func (m *MsgFundRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPool proto.InternalMessageInfo
+
+func (m *MsgFundRewardPool) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgFundRewardPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundRewardPoolResponse defines the response for MsgFundRewardPool
+type MsgFundRewardPoolResponse struct {
+}
+
+func (m *MsgFundRewardPoolResponse) Reset()         { *m = MsgFundRewardPoolResponse{} }
+func (m *MsgFundRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{29}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPoolResponse proto.InternalMessageInfo
+
+// MsgUnjailForRecords is the message for a validator jailed by x/pos to unjail
+// itself after catching up on verified records
+type MsgUnjailForRecords struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgUnjailForRecords) Reset()         { *m = MsgUnjailForRecords{} }
+func (m *MsgUnjailForRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailForRecords) ProtoMessage()    {}
+func (*MsgUnjailForRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{30}
+}
+func (m *MsgUnjailForRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailForRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailForRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailForRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailForRecords.Merge(m, src)
+}
+func (m *MsgUnjailForRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailForRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailForRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailForRecords proto.InternalMessageInfo
+
+func (m *MsgUnjailForRecords) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgUnjailForRecordsResponse defines the response for MsgUnjailForRecords
+type MsgUnjailForRecordsResponse struct {
+}
+
+func (m *MsgUnjailForRecordsResponse) Reset()         { *m = MsgUnjailForRecordsResponse{} }
+func (m *MsgUnjailForRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailForRecordsResponse) ProtoMessage()    {}
+func (*MsgUnjailForRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817de2ffe3d88e09, []int{31}
+}
+func (m *MsgUnjailForRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailForRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailForRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailForRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailForRecordsResponse.Merge(m, src)
+}
+func (m *MsgUnjailForRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailForRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailForRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailForRecordsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "pos.pos.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pos.pos.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSubmitRecord)(nil), "pos.pos.v1.MsgSubmitRecord")
+	proto.RegisterType((*MsgSubmitRecordResponse)(nil), "pos.pos.v1.MsgSubmitRecordResponse")
+	proto.RegisterType((*MsgVerifyRecord)(nil), "pos.pos.v1.MsgVerifyRecord")
+	proto.RegisterType((*MsgVerifyRecordResponse)(nil), "pos.pos.v1.MsgVerifyRecordResponse")
+	proto.RegisterType((*MsgCommitVerification)(nil), "pos.pos.v1.MsgCommitVerification")
+	proto.RegisterType((*MsgCommitVerificationResponse)(nil), "pos.pos.v1.MsgCommitVerificationResponse")
+	proto.RegisterType((*MsgRevealVerification)(nil), "pos.pos.v1.MsgRevealVerification")
+	proto.RegisterType((*MsgRevealVerificationResponse)(nil), "pos.pos.v1.MsgRevealVerificationResponse")
+	proto.RegisterType((*MsgChallengeAvailability)(nil), "pos.pos.v1.MsgChallengeAvailability")
+	proto.RegisterType((*MsgChallengeAvailabilityResponse)(nil), "pos.pos.v1.MsgChallengeAvailabilityResponse")
+	proto.RegisterType((*MsgRespondAvailabilityChallenge)(nil), "pos.pos.v1.MsgRespondAvailabilityChallenge")
+	proto.RegisterType((*MsgRespondAvailabilityChallengeResponse)(nil), "pos.pos.v1.MsgRespondAvailabilityChallengeResponse")
+	proto.RegisterType((*MsgDisputeRecord)(nil), "pos.pos.v1.MsgDisputeRecord")
+	proto.RegisterType((*MsgDisputeRecordResponse)(nil), "pos.pos.v1.MsgDisputeRecordResponse")
+	proto.RegisterType((*MsgSetRecordType)(nil), "pos.pos.v1.MsgSetRecordType")
+	proto.RegisterType((*MsgSetRecordTypeResponse)(nil), "pos.pos.v1.MsgSetRecordTypeResponse")
+	proto.RegisterType((*MsgRemoveRecordType)(nil), "pos.pos.v1.MsgRemoveRecordType")
+	proto.RegisterType((*MsgRemoveRecordTypeResponse)(nil), "pos.pos.v1.MsgRemoveRecordTypeResponse")
+	proto.RegisterType((*MsgBeginRecordUpload)(nil), "pos.pos.v1.MsgBeginRecordUpload")
+	proto.RegisterType((*MsgBeginRecordUploadResponse)(nil), "pos.pos.v1.MsgBeginRecordUploadResponse")
+	proto.RegisterType((*MsgUploadRecordChunk)(nil), "pos.pos.v1.MsgUploadRecordChunk")
+	proto.RegisterType((*MsgUploadRecordChunkResponse)(nil), "pos.pos.v1.MsgUploadRecordChunkResponse")
+	proto.RegisterType((*MsgFinalizeRecord)(nil), "pos.pos.v1.MsgFinalizeRecord")
+	proto.RegisterType((*MsgFinalizeRecordResponse)(nil), "pos.pos.v1.MsgFinalizeRecordResponse")
+	proto.RegisterType((*MsgAmendRecord)(nil), "pos.pos.v1.MsgAmendRecord")
+	proto.RegisterType((*MsgAmendRecordResponse)(nil), "pos.pos.v1.MsgAmendRecordResponse")
+	proto.RegisterType((*MsgFundRewardPool)(nil), "pos.pos.v1.MsgFundRewardPool")
+	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "pos.pos.v1.MsgFundRewardPoolResponse")
+	proto.RegisterType((*MsgUnjailForRecords)(nil), "pos.pos.v1.MsgUnjailForRecords")
+	proto.RegisterType((*MsgUnjailForRecordsResponse)(nil), "pos.pos.v1.MsgUnjailForRecordsResponse")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/tx.proto", fileDescriptor_817de2ffe3d88e09) }
+
+var fileDescriptor_817de2ffe3d88e09 = []byte{
+	// 1801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x65, 0xd9, 0xb1, 0x57, 0x8a, 0x3f, 0x18, 0x27, 0x91, 0x69, 0x5b, 0xb6, 0x99, 0x3c,
+	0xc4, 0x71, 0x62, 0x29, 0x76, 0xf2, 0x82, 0xf7, 0x04, 0xbc, 0x07, 0xd8, 0x4e, 0x8c, 0x67, 0xbc,
+	0x2a, 0x30, 0xa8, 0xc4, 0x87, 0xa2, 0x80, 0x40, 0x89, 0x1b, 0x9a, 0xb5, 0xc8, 0x25, 0xb8, 0x94,
+	0x1a, 0xa7, 0x97, 0xa0, 0xc7, 0x5e, 0xd2, 0x63, 0xd1, 0x4b, 0xaf, 0x45, 0x8b, 0x16, 0x46, 0x9b,
+	0x43, 0x2f, 0xbd, 0x16, 0x41, 0x4f, 0x41, 0x0e, 0x45, 0x4f, 0xfd, 0x48, 0x0e, 0xee, 0x3f, 0xd0,
+	0x9e, 0x8b, 0xdd, 0xa5, 0x28, 0x2e, 0x97, 0x92, 0x9c, 0xc0, 0xe9, 0xc1, 0xad, 0x38, 0x33, 0x3b,
+	0x3b, 0xf3, 0x9b, 0xd9, 0x99, 0xd9, 0x0d, 0x38, 0xe3, 0x22, 0x5c, 0x24, 0x7f, 0xad, 0xd5, 0xa2,
+	0xff, 0xa0, 0xe0, 0x7a, 0xc8, 0x47, 0x32, 0x70, 0x11, 0x2e, 0x90, 0xbf, 0xd6, 0xaa, 0x32, 0xa9,
+	0xdb, 0x96, 0x83, 0x8a, 0xf4, 0xbf, 0x8c, 0xad, 0xe4, 0xeb, 0x08, 0xdb, 0x08, 0x17, 0x6b, 0x3a,
+	0x86, 0xc5, 0xd6, 0x6a, 0x0d, 0xfa, 0xfa, 0x6a, 0xb1, 0x8e, 0x2c, 0x27, 0xe0, 0x9f, 0x0f, 0xf8,
+	0x36, 0x36, 0x89, 0x5a, 0x1b, 0x9b, 0x01, 0x63, 0x9a, 0x31, 0xaa, 0xf4, 0xab, 0xc8, 0x3e, 0x02,
+	0xd6, 0x94, 0x89, 0x4c, 0xc4, 0xe8, 0xe4, 0x57, 0x5b, 0x53, 0xc4, 0x3a, 0x57, 0xf7, 0x74, 0x1b,
+	0x27, 0x30, 0x3c, 0x58, 0x47, 0x9e, 0x11, 0x30, 0x66, 0x05, 0x46, 0xd5, 0x3f, 0x70, 0x61, 0xc2,
+	0xb2, 0xa6, 0xdb, 0x40, 0x7a, 0xb0, 0x4c, 0xfd, 0x4a, 0x02, 0xe3, 0x65, 0x6c, 0xde, 0x73, 0x0d,
+	0xdd, 0x87, 0x3b, 0x74, 0x27, 0xf9, 0x26, 0x18, 0xd5, 0x9b, 0xfe, 0x1e, 0xf2, 0x2c, 0xff, 0x20,
+	0x27, 0x2d, 0x48, 0x4b, 0xa3, 0x1b, 0xb9, 0xe7, 0x4f, 0x56, 0xa6, 0x02, 0xbb, 0xd7, 0x0d, 0xc3,
+	0x83, 0x18, 0x57, 0x7c, 0xcf, 0x72, 0x4c, 0xad, 0x23, 0x2a, 0xff, 0x13, 0x0c, 0x33, 0x5b, 0x73,
+	0xa9, 0x05, 0x69, 0x29, 0xb3, 0x26, 0x17, 0x3a, 0x70, 0x16, 0x98, 0xee, 0x8d, 0xd1, 0xa7, 0x3f,
+	0xcf, 0x0f, 0x7c, 0x76, 0x74, 0xb8, 0x2c, 0x69, 0x81, 0x70, 0xe9, 0xea, 0x07, 0x47, 0x87, 0xcb,
+	0x1d, 0x35, 0x1f, 0x1e, 0x1d, 0x2e, 0x4f, 0x13, 0x53, 0x1f, 0x50, 0x83, 0x63, 0xc6, 0xa9, 0xd3,
+	0xe0, 0x7c, 0x8c, 0xa4, 0x41, 0xec, 0x22, 0x07, 0x43, 0xf5, 0x8f, 0x14, 0xf5, 0xa5, 0xd2, 0xac,
+	0xd9, 0x96, 0xaf, 0x51, 0x0c, 0xe4, 0x3b, 0x60, 0xb2, 0xa5, 0x37, 0x2c, 0x43, 0xf7, 0x91, 0x57,
+	0xd5, 0x99, 0xe5, 0x81, 0x4f, 0x8b, 0xcf, 0x9f, 0xac, 0xcc, 0x05, 0x3e, 0xed, 0xb6, 0x65, 0x78,
+	0xe7, 0x26, 0x5a, 0x31, 0xba, 0x2c, 0x83, 0xb4, 0xa1, 0xfb, 0x3a, 0xf5, 0x30, 0xab, 0xd1, 0xdf,
+	0xf2, 0x3c, 0xc8, 0xd8, 0xd0, 0xdb, 0x6f, 0xc0, 0xaa, 0x87, 0x90, 0x9f, 0x1b, 0x24, 0xda, 0x35,
+	0xc0, 0x48, 0x1a, 0x42, 0xbe, 0xbc, 0x08, 0xb2, 0x44, 0xb0, 0xda, 0x40, 0x75, 0xa2, 0x2b, 0x97,
+	0xa6, 0x12, 0x19, 0x42, 0x7b, 0x8b, 0x91, 0xe4, 0x19, 0x30, 0x4a, 0x45, 0xb0, 0xf5, 0x10, 0xe6,
+	0x86, 0x16, 0xa4, 0xa5, 0xb4, 0x36, 0x42, 0x08, 0x15, 0xeb, 0x21, 0x24, 0x1b, 0x44, 0x42, 0x9a,
+	0x1b, 0x66, 0x1b, 0x30, 0xd2, 0xdd, 0x03, 0x17, 0xca, 0x5b, 0x60, 0xa2, 0x8e, 0x1c, 0x1f, 0x3a,
+	0x7e, 0x15, 0x3a, 0x75, 0x64, 0x58, 0x8e, 0x99, 0x3b, 0xb5, 0x20, 0x2d, 0x8d, 0xad, 0xcd, 0x44,
+	0x63, 0xb0, 0xc9, 0x64, 0x6e, 0x07, 0x22, 0xda, 0x78, 0x9d, 0x27, 0x94, 0x6e, 0x90, 0x50, 0x88,
+	0x80, 0x89, 0x21, 0x89, 0x62, 0xac, 0xde, 0xa5, 0x21, 0x89, 0x92, 0xda, 0x21, 0x21, 0x6e, 0x05,
+	0x96, 0x5b, 0x06, 0x83, 0x5d, 0x1b, 0x61, 0x84, 0x6d, 0x43, 0x9e, 0x05, 0xa3, 0xbe, 0x65, 0x43,
+	0xec, 0xeb, 0xb6, 0x4b, 0x01, 0x1d, 0xd4, 0x3a, 0x04, 0xf5, 0x4b, 0x96, 0x99, 0xbb, 0xd0, 0xb3,
+	0xee, 0x1f, 0x04, 0xd1, 0xbc, 0x01, 0x46, 0x5a, 0xe4, 0xdb, 0x82, 0x5e, 0xdf, 0xc4, 0x0c, 0x25,
+	0x79, 0x23, 0x52, 0x31, 0x23, 0x14, 0x30, 0xa2, 0xbb, 0xae, 0x87, 0x5a, 0xd0, 0xa0, 0x91, 0x1b,
+	0xd1, 0xc2, 0xef, 0xd2, 0x15, 0x02, 0x47, 0xa8, 0x47, 0x44, 0x21, 0x6a, 0x9b, 0xfa, 0x7f, 0x8a,
+	0x42, 0x94, 0x14, 0xa2, 0x70, 0x0d, 0x0c, 0x63, 0x5f, 0xf7, 0x9b, 0x2c, 0xf3, 0xc6, 0xd6, 0x72,
+	0xd1, 0xa0, 0x30, 0xd9, 0x0a, 0xe5, 0x6b, 0x81, 0x9c, 0xfa, 0xbd, 0x04, 0xce, 0x96, 0xb1, 0xb9,
+	0x89, 0x6c, 0xdb, 0xf2, 0xa9, 0x4e, 0xab, 0xae, 0xfb, 0x16, 0x72, 0xe4, 0xff, 0x08, 0x10, 0x1c,
+	0x23, 0x8f, 0x8f, 0x89, 0x45, 0x1e, 0x80, 0x3a, 0xdd, 0xd1, 0x86, 0x0e, 0xcb, 0xe3, 0xac, 0x16,
+	0xa1, 0x94, 0x56, 0x05, 0x3c, 0xe6, 0x39, 0x3c, 0x44, 0x73, 0xd5, 0xf7, 0xc1, 0x5c, 0x22, 0x23,
+	0xc4, 0xa6, 0x00, 0xce, 0x78, 0xb0, 0x05, 0xf5, 0x46, 0x15, 0xfb, 0xba, 0xe7, 0x57, 0xf7, 0xa0,
+	0x65, 0xee, 0xf9, 0xd4, 0xb5, 0xb4, 0x36, 0xc9, 0x58, 0x15, 0xc2, 0xf9, 0x1f, 0x65, 0xc8, 0xcb,
+	0x20, 0x20, 0x56, 0xa1, 0x63, 0xb4, 0xa5, 0x53, 0x54, 0x7a, 0x9c, 0x31, 0x6e, 0x3b, 0x06, 0x93,
+	0x55, 0x7f, 0x64, 0x28, 0x6a, 0x94, 0xfc, 0xb7, 0xa1, 0xd8, 0x23, 0xa3, 0x48, 0xf9, 0xc0, 0x7a,
+	0xc3, 0xa7, 0x15, 0x20, 0xab, 0xd1, 0xdf, 0x7d, 0x51, 0x15, 0xcd, 0x57, 0xe7, 0x29, 0xaa, 0x22,
+	0x23, 0x2c, 0x85, 0x5f, 0x4b, 0x20, 0x47, 0x70, 0xdf, 0xd3, 0x1b, 0x0d, 0xe8, 0x98, 0x70, 0xbd,
+	0xa5, 0x5b, 0x0d, 0xbd, 0x66, 0x35, 0x48, 0x9d, 0x5e, 0x07, 0xa0, 0xde, 0x66, 0xbc, 0x82, 0xfb,
+	0x91, 0x45, 0x3d, 0x01, 0x28, 0xdd, 0x24, 0x0e, 0x45, 0xa4, 0x89, 0x4b, 0x2a, 0x9f, 0x28, 0x49,
+	0x76, 0xa9, 0x8f, 0x25, 0xb0, 0xd0, 0x8d, 0x19, 0xe6, 0xcb, 0x22, 0xc8, 0x86, 0x9a, 0xdb, 0x45,
+	0x25, 0xad, 0x65, 0x42, 0xda, 0xb6, 0x41, 0xca, 0x65, 0x7d, 0xaf, 0xe9, 0xec, 0x57, 0x2d, 0xc7,
+	0x80, 0x0f, 0x82, 0xe4, 0x00, 0x94, 0xb4, 0x4d, 0x28, 0xf2, 0x25, 0x30, 0x6e, 0x40, 0xdd, 0x68,
+	0x58, 0x0e, 0x6c, 0x67, 0xd0, 0x20, 0x15, 0x1a, 0x6b, 0x93, 0x83, 0x04, 0xfa, 0x26, 0x05, 0xe6,
+	0x29, 0xd0, 0x64, 0x73, 0x23, 0x6a, 0x4f, 0x68, 0xe4, 0x89, 0x77, 0x98, 0xb8, 0x83, 0x29, 0xd1,
+	0xc1, 0x76, 0x13, 0x1a, 0x8c, 0x34, 0xa1, 0x29, 0x30, 0x44, 0x3d, 0x0c, 0x52, 0x8b, 0x7d, 0xc8,
+	0x2b, 0x60, 0xc8, 0xf5, 0x10, 0xba, 0x4f, 0x5b, 0x4a, 0x66, 0xed, 0x7c, 0xb4, 0xf0, 0x94, 0x69,
+	0x83, 0xda, 0x21, 0x6c, 0x8d, 0x49, 0x95, 0x6e, 0x75, 0xaf, 0xff, 0x97, 0x63, 0x39, 0xd9, 0x1d,
+	0x11, 0xf5, 0x32, 0xb8, 0xd4, 0x47, 0x24, 0xcc, 0xd3, 0x2f, 0x24, 0x30, 0x51, 0xc6, 0xe6, 0x2d,
+	0x0b, 0xbb, 0x4d, 0x1f, 0x76, 0xaa, 0xbc, 0xc1, 0x08, 0xc7, 0xa8, 0xf2, 0x6d, 0xc9, 0xde, 0x67,
+	0xf2, 0x1c, 0x18, 0xf6, 0xa0, 0x8e, 0x91, 0x13, 0x74, 0xe7, 0xe0, 0x8b, 0xcd, 0x1e, 0xa1, 0x0e,
+	0xe2, 0xa7, 0xc2, 0xf9, 0xc9, 0x19, 0xa6, 0x2a, 0xf4, 0x50, 0x71, 0xb4, 0xd0, 0x93, 0xef, 0x98,
+	0x27, 0x15, 0x18, 0xb4, 0x40, 0xda, 0x97, 0x5f, 0x77, 0x92, 0xda, 0xe0, 0x1b, 0x3e, 0x1b, 0xa7,
+	0xce, 0x89, 0x5d, 0x83, 0x6c, 0x12, 0x1d, 0xa9, 0x22, 0x33, 0x41, 0x69, 0x45, 0x1c, 0xab, 0x78,
+	0xdf, 0x38, 0x53, 0x03, 0xdf, 0x38, 0x5a, 0xe8, 0xdb, 0x63, 0x09, 0x9c, 0xa1, 0x11, 0xb5, 0x51,
+	0x0b, 0x9e, 0x80, 0x7b, 0x63, 0x20, 0x15, 0xc6, 0x28, 0x65, 0x19, 0xa5, 0x6b, 0xa2, 0xa9, 0x73,
+	0xb1, 0x74, 0xe3, 0x77, 0x56, 0xe7, 0xc0, 0x4c, 0x02, 0x39, 0x34, 0xf8, 0x87, 0x14, 0x98, 0x2a,
+	0x63, 0x73, 0x03, 0x9a, 0x96, 0xc3, 0xd8, 0xf7, 0xe8, 0xd0, 0x7b, 0xe2, 0x87, 0x35, 0x36, 0xfa,
+	0xa5, 0x84, 0xd1, 0x6f, 0x0e, 0x00, 0x1f, 0xf9, 0xa4, 0xbb, 0x91, 0xc1, 0x8e, 0x55, 0x99, 0x51,
+	0x4a, 0x49, 0x9a, 0xec, 0xd2, 0xc7, 0x9a, 0xec, 0x86, 0x5e, 0x63, 0xb2, 0xfb, 0x77, 0xf7, 0x93,
+	0x9d, 0xe7, 0xa0, 0x16, 0x30, 0x53, 0x0d, 0x30, 0x9b, 0x44, 0x8f, 0xce, 0x78, 0xec, 0x4a, 0xd1,
+	0x29, 0xc7, 0x23, 0x8c, 0xb0, 0x6d, 0x24, 0x95, 0xda, 0x54, 0x62, 0xa9, 0xfd, 0x5d, 0xa2, 0x21,
+	0x6b, 0xeb, 0x26, 0xfb, 0x6c, 0xd2, 0x12, 0x76, 0xd2, 0x21, 0xe3, 0xcc, 0x4d, 0xc5, 0xcc, 0x9d,
+	0x02, 0x43, 0xac, 0x69, 0xb0, 0x48, 0xb1, 0x8f, 0xb0, 0xde, 0xa6, 0x3b, 0xf5, 0xf6, 0xf8, 0x80,
+	0x0a, 0x1e, 0xa9, 0x9b, 0x14, 0x50, 0x81, 0x1e, 0x02, 0x7a, 0x01, 0x9c, 0xf6, 0x60, 0x1d, 0x5a,
+	0x2d, 0x68, 0xb0, 0xb4, 0x61, 0xa0, 0x66, 0xdb, 0x44, 0x92, 0x39, 0xea, 0xb7, 0x12, 0x98, 0x2c,
+	0x63, 0x73, 0xcb, 0x72, 0xf4, 0x86, 0xf5, 0x10, 0xbe, 0xa1, 0xeb, 0x4e, 0x2f, 0xb0, 0x58, 0x9f,
+	0x4f, 0x86, 0x60, 0x86, 0x83, 0x80, 0x37, 0x52, 0xdd, 0x05, 0xd3, 0x02, 0xf1, 0x24, 0x6e, 0x0c,
+	0x1f, 0x0f, 0x82, 0xb1, 0x32, 0x36, 0xd7, 0x6d, 0xe8, 0x18, 0x6f, 0x08, 0x8f, 0x0b, 0xe0, 0x34,
+	0x6e, 0xba, 0xd0, 0xc3, 0xd0, 0x80, 0xb8, 0xd3, 0x68, 0xb2, 0x1d, 0x62, 0x97, 0xf6, 0x1c, 0x2b,
+	0x14, 0xe9, 0xbe, 0x77, 0xc4, 0xa1, 0x3e, 0x77, 0xc4, 0xe1, 0xde, 0x77, 0xc4, 0x53, 0xc7, 0xaa,
+	0x24, 0x23, 0xaf, 0x51, 0x49, 0xae, 0x77, 0x8f, 0x7a, 0x8e, 0x8b, 0x7a, 0x24, 0x0e, 0x6a, 0x05,
+	0x9c, 0xe3, 0x29, 0x27, 0x11, 0xef, 0x3f, 0x83, 0x23, 0xd0, 0x24, 0x4a, 0xdf, 0xd3, 0x3d, 0x63,
+	0x07, 0xa1, 0x06, 0x69, 0x4a, 0x06, 0x74, 0x11, 0xb6, 0x08, 0x8a, 0x7d, 0x9b, 0x52, 0x28, 0x2a,
+	0x1f, 0x80, 0x61, 0xdd, 0x46, 0x4d, 0x87, 0x14, 0xa8, 0xc1, 0xa5, 0xcc, 0xda, 0x74, 0x21, 0x58,
+	0x51, 0xd3, 0x31, 0x2c, 0x04, 0xaf, 0x3d, 0x85, 0x4d, 0x64, 0x39, 0x1b, 0x5b, 0xa4, 0xe3, 0x7e,
+	0xfe, 0xcb, 0xfc, 0x92, 0x69, 0xf9, 0x7b, 0xcd, 0x5a, 0xa1, 0x8e, 0xec, 0xe0, 0x51, 0x27, 0xf8,
+	0xdf, 0x0a, 0x36, 0xf6, 0x8b, 0x24, 0x0e, 0x98, 0x2e, 0xc0, 0x9f, 0x1c, 0x1d, 0x2e, 0x67, 0x1b,
+	0xd0, 0xd4, 0xeb, 0x07, 0xd5, 0x3a, 0x21, 0x04, 0x2f, 0x20, 0x6c, 0xc3, 0x52, 0x81, 0xf6, 0xbf,
+	0xd0, 0x94, 0x84, 0x03, 0xc4, 0xb9, 0xa8, 0xce, 0xb0, 0x03, 0xc4, 0x11, 0xc3, 0xde, 0xf7, 0x29,
+	0x6b, 0xd6, 0xf7, 0x9c, 0x77, 0x75, 0xab, 0xb1, 0x85, 0x3c, 0x86, 0x37, 0x3e, 0xe9, 0xa3, 0x50,
+	0xfa, 0x57, 0xf7, 0x3c, 0xe0, 0x9b, 0x77, 0xdc, 0x92, 0xa0, 0x79, 0xc7, 0xc9, 0x6d, 0x07, 0xd6,
+	0x7e, 0xcb, 0x80, 0xc1, 0x32, 0x36, 0xe5, 0x1d, 0x90, 0xe5, 0x9e, 0xa5, 0xb8, 0x34, 0x8d, 0xbd,
+	0x01, 0x29, 0x17, 0x7a, 0x30, 0xc3, 0x5c, 0xdb, 0x01, 0x59, 0xee, 0x71, 0x28, 0xae, 0x31, 0xca,
+	0x14, 0x34, 0x26, 0xbe, 0x6f, 0xec, 0x80, 0x2c, 0xf7, 0x40, 0x11, 0xd7, 0x18, 0x65, 0x0a, 0x1a,
+	0x13, 0xdf, 0x0a, 0x6a, 0x40, 0x4e, 0xb8, 0xf5, 0x2f, 0xc6, 0x96, 0x8a, 0x22, 0xca, 0xe5, 0xbe,
+	0x22, 0xd1, 0x3d, 0x12, 0xee, 0xc4, 0xf1, 0x3d, 0x44, 0x11, 0x61, 0x8f, 0xee, 0x37, 0x50, 0x79,
+	0x1f, 0x9c, 0x4d, 0xbe, 0x7d, 0x5e, 0x8c, 0xdb, 0x99, 0x24, 0xa5, 0x5c, 0x3d, 0x8e, 0x54, 0xb8,
+	0xd9, 0x23, 0x09, 0xcc, 0xf6, 0xbc, 0xa4, 0x5d, 0x11, 0x0c, 0xef, 0x2e, 0xac, 0x5c, 0x7f, 0x05,
+	0xe1, 0xd0, 0x84, 0x0a, 0x38, 0xcd, 0xdf, 0x62, 0x66, 0x63, 0x5a, 0x38, 0xae, 0x72, 0xb1, 0x17,
+	0x37, 0xaa, 0x94, 0xbf, 0x50, 0xc4, 0x95, 0x72, 0x5c, 0x41, 0x69, 0xe2, 0x34, 0x2f, 0xbf, 0x03,
+	0x26, 0x84, 0x49, 0x7e, 0x5e, 0x70, 0x99, 0x17, 0x50, 0x2e, 0xf5, 0x11, 0x08, 0xb5, 0x57, 0xc1,
+	0xa4, 0x38, 0x76, 0x2f, 0xc4, 0x56, 0x0b, 0x12, 0xca, 0x52, 0x3f, 0x89, 0xe8, 0x06, 0xe2, 0x90,
+	0xb8, 0x20, 0x1c, 0xff, 0x98, 0x84, 0xb0, 0x41, 0xf7, 0xf1, 0x6b, 0x17, 0x8c, 0xc5, 0xa6, 0xaa,
+	0xb9, 0xd8, 0x5a, 0x9e, 0xad, 0xfc, 0xa3, 0x27, 0x3b, 0xd4, 0x5b, 0x06, 0x99, 0xe8, 0x68, 0xa2,
+	0xc4, 0x56, 0x45, 0x78, 0x8a, 0xda, 0x9d, 0xc7, 0x99, 0xc9, 0x77, 0x3e, 0xc1, 0x4c, 0x8e, 0x2d,
+	0x9a, 0x99, 0xd8, 0x3f, 0x48, 0x7a, 0x08, 0xbd, 0x23, 0x9e, 0x1e, 0x71, 0x01, 0x21, 0x3d, 0xba,
+	0x15, 0x77, 0x65, 0xe8, 0x11, 0xe9, 0x7c, 0x1b, 0xff, 0x7d, 0xfa, 0x22, 0x2f, 0x3d, 0x7b, 0x91,
+	0x97, 0x7e, 0x7d, 0x91, 0x97, 0x3e, 0x7a, 0x99, 0x1f, 0x78, 0xf6, 0x32, 0x3f, 0xf0, 0xd3, 0xcb,
+	0xfc, 0xc0, 0xdb, 0x17, 0x23, 0x3d, 0xf5, 0x0e, 0x44, 0x76, 0x05, 0x3a, 0x18, 0x16, 0x77, 0x50,
+	0x25, 0x68, 0x28, 0xb4, 0xab, 0xd6, 0x86, 0xe9, 0xbf, 0x5e, 0x5c, 0xff, 0x2b, 0x00, 0x00, 0xff,
+	0xff, 0xb8, 0x50, 0xf6, 0xcf, 0xc6, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SubmitRecord allows validators to submit a proof-of-record
+	SubmitRecord(ctx context.Context, in *MsgSubmitRecord, opts ...grpc.CallOption) (*MsgSubmitRecordResponse, error)
+	// VerifyRecord casts a bonded verifier's stake-weighted vote on a submitted
+	// record
+	VerifyRecord(ctx context.Context, in *MsgVerifyRecord, opts ...grpc.CallOption) (*MsgVerifyRecordResponse, error)
+	// CommitVerification seals a verifier's vote on a record during the commit
+	// phase
+	CommitVerification(ctx context.Context, in *MsgCommitVerification, opts ...grpc.CallOption) (*MsgCommitVerificationResponse, error)
+	// RevealVerification opens a committed vote during the reveal phase
+	RevealVerification(ctx context.Context, in *MsgRevealVerification, opts ...grpc.CallOption) (*MsgRevealVerificationResponse, error)
+	// ChallengeAvailability challenges the submitter of an off-chain record to
+	// prove its data is still available
+	ChallengeAvailability(ctx context.Context, in *MsgChallengeAvailability, opts ...grpc.CallOption) (*MsgChallengeAvailabilityResponse, error)
+	// RespondAvailabilityChallenge answers an availability challenge with the
+	// record data or a proof of the challenged chunk
+	RespondAvailabilityChallenge(ctx context.Context, in *MsgRespondAvailabilityChallenge, opts ...grpc.CallOption) (*MsgRespondAvailabilityChallengeResponse, error)
+	// DisputeRecord disputes a record under optimistic verification, escrowing
+	// the dispute bond until verifiers settle the record
+	DisputeRecord(ctx context.Context, in *MsgDisputeRecord, opts ...grpc.CallOption) (*MsgDisputeRecordResponse, error)
+	// SetRecordType defines a (governance) operation for registering or
+	// replacing a record type
+	SetRecordType(ctx context.Context, in *MsgSetRecordType, opts ...grpc.CallOption) (*MsgSetRecordTypeResponse, error)
+	// RemoveRecordType defines a (governance) operation for removing a record
+	// type. Existing records of the type are kept.
+	RemoveRecordType(ctx context.Context, in *MsgRemoveRecordType, opts ...grpc.CallOption) (*MsgRemoveRecordTypeResponse, error)
+	// BeginRecordUpload opens a chunked upload of a record too large for a
+	// single transaction
+	BeginRecordUpload(ctx context.Context, in *MsgBeginRecordUpload, opts ...grpc.CallOption) (*MsgBeginRecordUploadResponse, error)
+	// UploadRecordChunk appends the next chunk of data to an open upload
+	UploadRecordChunk(ctx context.Context, in *MsgUploadRecordChunk, opts ...grpc.CallOption) (*MsgUploadRecordChunkResponse, error)
+	// FinalizeRecord checks the assembled data of an upload against its declared
+	// merkle root and submits it as a record
+	FinalizeRecord(ctx context.Context, in *MsgFinalizeRecord, opts ...grpc.CallOption) (*MsgFinalizeRecordResponse, error)
+	// AmendRecord submits a new version of a pending or rejected record
+	AmendRecord(ctx context.Context, in *MsgAmendRecord, opts ...grpc.CallOption) (*MsgAmendRecordResponse, error)
+	// FundRewardPool deposits coins into the reward pool paid out to validators
+	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
+	// UnjailForRecords unjails a validator jailed for missing its record
+	// requirement once its jail ended and it has caught up on verified records
+	UnjailForRecords(ctx context.Context, in *MsgUnjailForRecords, opts ...grpc.CallOption) (*MsgUnjailForRecordsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitRecord(ctx context.Context, in *MsgSubmitRecord, opts ...grpc.CallOption) (*MsgSubmitRecordResponse, error) {
+	out := new(MsgSubmitRecordResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/SubmitRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyRecord(ctx context.Context, in *MsgVerifyRecord, opts ...grpc.CallOption) (*MsgVerifyRecordResponse, error) {
+	out := new(MsgVerifyRecordResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/VerifyRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitVerification(ctx context.Context, in *MsgCommitVerification, opts ...grpc.CallOption) (*MsgCommitVerificationResponse, error) {
+	out := new(MsgCommitVerificationResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/CommitVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealVerification(ctx context.Context, in *MsgRevealVerification, opts ...grpc.CallOption) (*MsgRevealVerificationResponse, error) {
+	out := new(MsgRevealVerificationResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/RevealVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChallengeAvailability(ctx context.Context, in *MsgChallengeAvailability, opts ...grpc.CallOption) (*MsgChallengeAvailabilityResponse, error) {
+	out := new(MsgChallengeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/ChallengeAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RespondAvailabilityChallenge(ctx context.Context, in *MsgRespondAvailabilityChallenge, opts ...grpc.CallOption) (*MsgRespondAvailabilityChallengeResponse, error) {
+	out := new(MsgRespondAvailabilityChallengeResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/RespondAvailabilityChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeRecord(ctx context.Context, in *MsgDisputeRecord, opts ...grpc.CallOption) (*MsgDisputeRecordResponse, error) {
+	out := new(MsgDisputeRecordResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/DisputeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRecordType(ctx context.Context, in *MsgSetRecordType, opts ...grpc.CallOption) (*MsgSetRecordTypeResponse, error) {
+	out := new(MsgSetRecordTypeResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/SetRecordType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRecordType(ctx context.Context, in *MsgRemoveRecordType, opts ...grpc.CallOption) (*MsgRemoveRecordTypeResponse, error) {
+	out := new(MsgRemoveRecordTypeResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/RemoveRecordType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BeginRecordUpload(ctx context.Context, in *MsgBeginRecordUpload, opts ...grpc.CallOption) (*MsgBeginRecordUploadResponse, error) {
+	out := new(MsgBeginRecordUploadResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/BeginRecordUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UploadRecordChunk(ctx context.Context, in *MsgUploadRecordChunk, opts ...grpc.CallOption) (*MsgUploadRecordChunkResponse, error) {
+	out := new(MsgUploadRecordChunkResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/UploadRecordChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeRecord(ctx context.Context, in *MsgFinalizeRecord, opts ...grpc.CallOption) (*MsgFinalizeRecordResponse, error) {
+	out := new(MsgFinalizeRecordResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/FinalizeRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendRecord(ctx context.Context, in *MsgAmendRecord, opts ...grpc.CallOption) (*MsgAmendRecordResponse, error) {
+	out := new(MsgAmendRecordResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/AmendRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
+	out := new(MsgFundRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/FundRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailForRecords(ctx context.Context, in *MsgUnjailForRecords, opts ...grpc.CallOption) (*MsgUnjailForRecordsResponse, error) {
+	out := new(MsgUnjailForRecordsResponse)
+	err := c.cc.Invoke(ctx, "/pos.pos.v1.Msg/UnjailForRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SubmitRecord allows validators to submit a proof-of-record
+	SubmitRecord(context.Context, *MsgSubmitRecord) (*MsgSubmitRecordResponse, error)
+	// VerifyRecord casts a bonded verifier's stake-weighted vote on a submitted
+	// record
+	VerifyRecord(context.Context, *MsgVerifyRecord) (*MsgVerifyRecordResponse, error)
+	// CommitVerification seals a verifier's vote on a record during the commit
+	// phase
+	CommitVerification(context.Context, *MsgCommitVerification) (*MsgCommitVerificationResponse, error)
+	// RevealVerification opens a committed vote during the reveal phase
+	RevealVerification(context.Context, *MsgRevealVerification) (*MsgRevealVerificationResponse, error)
+	// ChallengeAvailability challenges the submitter of an off-chain record to
+	// prove its data is still available
+	ChallengeAvailability(context.Context, *MsgChallengeAvailability) (*MsgChallengeAvailabilityResponse, error)
+	// RespondAvailabilityChallenge answers an availability challenge with the
+	// record data or a proof of the challenged chunk
+	RespondAvailabilityChallenge(context.Context, *MsgRespondAvailabilityChallenge) (*MsgRespondAvailabilityChallengeResponse, error)
+	// DisputeRecord disputes a record under optimistic verification, escrowing
+	// the dispute bond until verifiers settle the record
+	DisputeRecord(context.Context, *MsgDisputeRecord) (*MsgDisputeRecordResponse, error)
+	// SetRecordType defines a (governance) operation for registering or
+	// replacing a record type
+	SetRecordType(context.Context, *MsgSetRecordType) (*MsgSetRecordTypeResponse, error)
+	// RemoveRecordType defines a (governance) operation for removing a record
+	// type. Existing records of the type are kept.
+	RemoveRecordType(context.Context, *MsgRemoveRecordType) (*MsgRemoveRecordTypeResponse, error)
+	// BeginRecordUpload opens a chunked upload of a record too large for a
+	// single transaction
+	BeginRecordUpload(context.Context, *MsgBeginRecordUpload) (*MsgBeginRecordUploadResponse, error)
+	// UploadRecordChunk appends the next chunk of data to an open upload
+	UploadRecordChunk(context.Context, *MsgUploadRecordChunk) (*MsgUploadRecordChunkResponse, error)
+	// FinalizeRecord checks the assembled data of an upload against its declared
+	// merkle root and submits it as a record
+	FinalizeRecord(context.Context, *MsgFinalizeRecord) (*MsgFinalizeRecordResponse, error)
+	// AmendRecord submits a new version of a pending or rejected record
+	AmendRecord(context.Context, *MsgAmendRecord) (*MsgAmendRecordResponse, error)
+	// FundRewardPool deposits coins into the reward pool paid out to validators
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	// UnjailForRecords unjails a validator jailed for missing its record
+	// requirement once its jail ended and it has caught up on verified records
+	UnjailForRecords(context.Context, *MsgUnjailForRecords) (*MsgUnjailForRecordsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SubmitRecord(ctx context.Context, req *MsgSubmitRecord) (*MsgSubmitRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRecord not implemented")
+}
+func (*UnimplementedMsgServer) VerifyRecord(ctx context.Context, req *MsgVerifyRecord) (*MsgVerifyRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRecord not implemented")
+}
+func (*UnimplementedMsgServer) CommitVerification(ctx context.Context, req *MsgCommitVerification) (*MsgCommitVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitVerification not implemented")
+}
+func (*UnimplementedMsgServer) RevealVerification(ctx context.Context, req *MsgRevealVerification) (*MsgRevealVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealVerification not implemented")
+}
+func (*UnimplementedMsgServer) ChallengeAvailability(ctx context.Context, req *MsgChallengeAvailability) (*MsgChallengeAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeAvailability not implemented")
+}
+func (*UnimplementedMsgServer) RespondAvailabilityChallenge(ctx context.Context, req *MsgRespondAvailabilityChallenge) (*MsgRespondAvailabilityChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondAvailabilityChallenge not implemented")
+}
+func (*UnimplementedMsgServer) DisputeRecord(ctx context.Context, req *MsgDisputeRecord) (*MsgDisputeRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeRecord not implemented")
+}
+func (*UnimplementedMsgServer) SetRecordType(ctx context.Context, req *MsgSetRecordType) (*MsgSetRecordTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecordType not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRecordType(ctx context.Context, req *MsgRemoveRecordType) (*MsgRemoveRecordTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecordType not implemented")
+}
+func (*UnimplementedMsgServer) BeginRecordUpload(ctx context.Context, req *MsgBeginRecordUpload) (*MsgBeginRecordUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginRecordUpload not implemented")
+}
+func (*UnimplementedMsgServer) UploadRecordChunk(ctx context.Context, req *MsgUploadRecordChunk) (*MsgUploadRecordChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadRecordChunk not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeRecord(ctx context.Context, req *MsgFinalizeRecord) (*MsgFinalizeRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeRecord not implemented")
+}
+func (*UnimplementedMsgServer) AmendRecord(ctx context.Context, req *MsgAmendRecord) (*MsgAmendRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendRecord not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) UnjailForRecords(ctx context.Context, req *MsgUnjailForRecords) (*MsgUnjailForRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailForRecords not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/SubmitRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitRecord(ctx, req.(*MsgSubmitRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/VerifyRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyRecord(ctx, req.(*MsgVerifyRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/CommitVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitVerification(ctx, req.(*MsgCommitVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/RevealVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealVerification(ctx, req.(*MsgRevealVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChallengeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeAvailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/ChallengeAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeAvailability(ctx, req.(*MsgChallengeAvailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RespondAvailabilityChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRespondAvailabilityChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RespondAvailabilityChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/RespondAvailabilityChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RespondAvailabilityChallenge(ctx, req.(*MsgRespondAvailabilityChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/DisputeRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeRecord(ctx, req.(*MsgDisputeRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRecordType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRecordType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRecordType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/SetRecordType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRecordType(ctx, req.(*MsgSetRecordType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRecordType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRecordType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRecordType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/RemoveRecordType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRecordType(ctx, req.(*MsgRemoveRecordType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginRecordUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginRecordUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginRecordUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/BeginRecordUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginRecordUpload(ctx, req.(*MsgBeginRecordUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UploadRecordChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadRecordChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UploadRecordChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/UploadRecordChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadRecordChunk(ctx, req.(*MsgUploadRecordChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/FinalizeRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeRecord(ctx, req.(*MsgFinalizeRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/AmendRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendRecord(ctx, req.(*MsgAmendRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/FundRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailForRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailForRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailForRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.pos.v1.Msg/UnjailForRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailForRecords(ctx, req.(*MsgUnjailForRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.pos.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SubmitRecord",
+			Handler:    _Msg_SubmitRecord_Handler,
+		},
+		{
+			MethodName: "VerifyRecord",
+			Handler:    _Msg_VerifyRecord_Handler,
+		},
+		{
+			MethodName: "CommitVerification",
+			Handler:    _Msg_CommitVerification_Handler,
+		},
+		{
+			MethodName: "RevealVerification",
+			Handler:    _Msg_RevealVerification_Handler,
+		},
+		{
+			MethodName: "ChallengeAvailability",
+			Handler:    _Msg_ChallengeAvailability_Handler,
+		},
+		{
+			MethodName: "RespondAvailabilityChallenge",
+			Handler:    _Msg_RespondAvailabilityChallenge_Handler,
+		},
+		{
+			MethodName: "DisputeRecord",
+			Handler:    _Msg_DisputeRecord_Handler,
+		},
+		{
+			MethodName: "SetRecordType",
+			Handler:    _Msg_SetRecordType_Handler,
+		},
+		{
+			MethodName: "RemoveRecordType",
+			Handler:    _Msg_RemoveRecordType_Handler,
+		},
+		{
+			MethodName: "BeginRecordUpload",
+			Handler:    _Msg_BeginRecordUpload_Handler,
+		},
+		{
+			MethodName: "UploadRecordChunk",
+			Handler:    _Msg_UploadRecordChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeRecord",
+			Handler:    _Msg_FinalizeRecord_Handler,
+		},
+		{
+			MethodName: "AmendRecord",
+			Handler:    _Msg_AmendRecord_Handler,
+		},
+		{
+			MethodName: "FundRewardPool",
+			Handler:    _Msg_FundRewardPool_Handler,
+		},
+		{
+			MethodName: "UnjailForRecords",
+			Handler:    _Msg_UnjailForRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pos/pos/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentEncoding != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContentEncoding))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DataSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DataLocator) > 0 {
+		i -= len(m.DataLocator)
+		copy(dAtA[i:], m.DataLocator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DataLocator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RevealStartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevealStartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondAvailabilityChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondAvailabilityChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondAvailabilityChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondAvailabilityChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondAvailabilityChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondAvailabilityChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Disputer) > 0 {
+		i -= len(m.Disputer)
+		copy(dAtA[i:], m.Disputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Disputer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRecordType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRecordType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRecordType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RecordType.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRecordTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRecordTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRecordTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRecordType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRecordType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRecordType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRecordTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRecordTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRecordTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginRecordUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginRecordUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginRecordUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentEncoding != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContentEncoding))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginRecordUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginRecordUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginRecordUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UploadId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadRecordChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadRecordChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadRecordChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UploadId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadRecordChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadRecordChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadRecordChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceivedSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentEncoding != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContentEncoding))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DataSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DataSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DataLocator) > 0 {
+		i -= len(m.DataLocator)
+		copy(dAtA[i:], m.DataLocator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DataLocator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupersedesId) > 0 {
+		i -= len(m.SupersedesId)
+		copy(dAtA[i:], m.SupersedesId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupersedesId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailForRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailForRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailForRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailForRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailForRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailForRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DataLocator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DataSize != 0 {
+		n += 1 + sovTx(uint64(m.DataSize))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContentEncoding != 0 {
+		n += 1 + sovTx(uint64(m.ContentEncoding))
+	}
+	return n
+}
+
+func (m *MsgSubmitRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgVerifyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgVerifyRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgCommitVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevealStartHeight != 0 {
+		n += 1 + sovTx(uint64(m.RevealStartHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovTx(uint64(m.RevealEndHeight))
+	}
+	return n
+}
+
+func (m *MsgRevealVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChallengeAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChallengeAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovTx(uint64(m.ChunkIndex))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgRespondAvailabilityChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRespondAvailabilityChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Disputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRecordType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RecordType.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRecordTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRecordType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRecordTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBeginRecordUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovTx(uint64(m.TotalSize))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContentEncoding != 0 {
+		n += 1 + sovTx(uint64(m.ContentEncoding))
+	}
+	return n
+}
+
+func (m *MsgBeginRecordUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadId != 0 {
+		n += 1 + sovTx(uint64(m.UploadId))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgUploadRecordChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadId != 0 {
+		n += 1 + sovTx(uint64(m.UploadId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadRecordChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceivedSize != 0 {
+		n += 1 + sovTx(uint64(m.ReceivedSize))
+	}
+	return n
+}
+
+func (m *MsgFinalizeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadId != 0 {
+		n += 1 + sovTx(uint64(m.UploadId))
+	}
+	return n
+}
+
+func (m *MsgFinalizeRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgAmendRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupersedesId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DataLocator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DataSize != 0 {
+		n += 1 + sovTx(uint64(m.DataSize))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContentEncoding != 0 {
+		n += 1 + sovTx(uint64(m.ContentEncoding))
+	}
+	return n
+}
+
+func (m *MsgAmendRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailForRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailForRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			m.ContentEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentEncoding |= ContentEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealStartHeight", wireType)
+			}
+			m.RevealStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+			}
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChallengeAvailability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeAvailability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChallengeAvailabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeAvailabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRespondAvailabilityChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRespondAvailabilityChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRespondAvailabilityChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &MerkleProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRespondAvailabilityChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRespondAvailabilityChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRespondAvailabilityChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRecordType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRecordType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRecordType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRecordTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRecordTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRecordTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRecordType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRecordType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRecordType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRecordTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRecordTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRecordTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRecordUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRecordUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRecordUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			m.ContentEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentEncoding |= ContentEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRecordUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRecordUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRecordUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUploadRecordChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUploadRecordChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUploadRecordChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUploadRecordChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUploadRecordChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUploadRecordChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadId", wireType)
+			}
+			m.UploadId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersedesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersedesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLocator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataLocator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
+			}
+			m.DataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -928,13 +7513,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RecordType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
 			}
-			var msglen int
+			m.ContentEncoding = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -944,25 +7529,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContentEncoding |= ContentEncoding(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -984,7 +7555,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,12 +7578,63 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1034,7 +7656,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,15 +7679,15 @@ func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1093,13 +7715,13 @@ func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1109,57 +7731,25 @@ func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1182,7 +7772,7 @@ func (m *MsgSubmitRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,63 +7795,12 @@ func (m *MsgSubmitRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1283,7 +7822,7 @@ func (m *MsgSubmitRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailForRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1306,47 +7845,15 @@ func (m *MsgVerifyRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailForRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailForRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Verifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,28 +7881,8 @@ func (m *MsgVerifyRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Approved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1417,7 +7904,7 @@ func (m *MsgVerifyRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVerifyRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailForRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1440,10 +7927,10 @@ func (m *MsgVerifyRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailForRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailForRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
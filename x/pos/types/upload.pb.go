@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pos/pos/v1/upload.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecordUpload is a record being uploaded in ordered chunks across several
+// transactions. The record is created when the upload is finalized; an upload
+// not finalized by its deadline is discarded.
+type RecordUpload struct {
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// merkle_root is the declared merkle root of the assembled data
+	MerkleRoot string `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// total_size is the declared size of the assembled data
+	TotalSize       uint64          `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	RecordType      string          `protobuf:"bytes,5,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	ContentEncoding ContentEncoding `protobuf:"varint,6,opt,name=content_encoding,json=contentEncoding,proto3,enum=pos.pos.v1.ContentEncoding" json:"content_encoding,omitempty"`
+	// chunk_count and received_size track the chunks uploaded so far
+	ChunkCount   uint64 `protobuf:"varint,7,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	ReceivedSize uint64 `protobuf:"varint,8,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+	BlockHeight  uint64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// deadline_height is the height from which the upload is discarded
+	DeadlineHeight uint64 `protobuf:"varint,10,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (m *RecordUpload) Reset()         { *m = RecordUpload{} }
+func (m *RecordUpload) String() string { return proto.CompactTextString(m) }
+func (*RecordUpload) ProtoMessage()    {}
+func (*RecordUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4647723e9012a88b, []int{0}
+}
+func (m *RecordUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordUpload.Merge(m, src)
+}
+func (m *RecordUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordUpload proto.InternalMessageInfo
+
+func (m *RecordUpload) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RecordUpload) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *RecordUpload) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *RecordUpload) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *RecordUpload) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *RecordUpload) GetContentEncoding() ContentEncoding {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ContentEncodingIdentity
+}
+
+func (m *RecordUpload) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *RecordUpload) GetReceivedSize() uint64 {
+	if m != nil {
+		return m.ReceivedSize
+	}
+	return 0
+}
+
+func (m *RecordUpload) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RecordUpload) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RecordUpload)(nil), "pos.pos.v1.RecordUpload")
+}
+
+func init() { proto.RegisterFile("pos/pos/v1/upload.proto", fileDescriptor_4647723e9012a88b) }
+
+var fileDescriptor_4647723e9012a88b = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0xe3, 0x34, 0x14, 0xb2, 0x0d, 0x69, 0xb1, 0x90, 0x58, 0x8a, 0xea, 0xa6, 0x80, 0x44,
+	0x2e, 0xd8, 0x2a, 0xdc, 0x38, 0x20, 0xd1, 0x0a, 0xc4, 0xa9, 0x42, 0x0e, 0x70, 0xe0, 0xb2, 0x72,
+	0x76, 0x47, 0xce, 0x2a, 0xce, 0x8e, 0xb5, 0xbb, 0xb1, 0x68, 0x9f, 0x82, 0x47, 0xe0, 0x0d, 0xb8,
+	0xf0, 0x10, 0x1c, 0x23, 0x4e, 0x1c, 0x51, 0x72, 0xe1, 0x31, 0xd0, 0xee, 0xc6, 0xe2, 0xcf, 0x61,
+	0x25, 0xfb, 0xf3, 0xb7, 0x33, 0x3f, 0xcf, 0x90, 0x3b, 0x35, 0x9a, 0xcc, 0x9d, 0xe6, 0x34, 0x5b,
+	0xd6, 0x15, 0x16, 0x22, 0xad, 0x35, 0x5a, 0x8c, 0x49, 0x8d, 0x26, 0x75, 0xa7, 0x39, 0x3d, 0xbc,
+	0xcb, 0xd1, 0x2c, 0xd0, 0x30, 0xff, 0x25, 0x0b, 0x2f, 0x41, 0x3b, 0xbc, 0x5d, 0x62, 0x89, 0x81,
+	0xbb, 0xa7, 0x2d, 0xfd, 0xbb, 0xaa, 0x06, 0x8e, 0x7a, 0x5b, 0xf5, 0xfe, 0x97, 0x1d, 0x32, 0xc8,
+	0x3d, 0x78, 0xe7, 0x9b, 0xc5, 0x43, 0xd2, 0x95, 0x82, 0x46, 0xa3, 0x68, 0xdc, 0xcb, 0xbb, 0x52,
+	0xc4, 0x17, 0xe4, 0x56, 0x53, 0x54, 0x52, 0x14, 0x16, 0x35, 0x2b, 0x84, 0xd0, 0x60, 0x0c, 0xed,
+	0x8e, 0xa2, 0x71, 0xff, 0xec, 0xe4, 0xfb, 0xd7, 0xc7, 0x47, 0xdb, 0xe6, 0xef, 0x5b, 0xe7, 0x45,
+	0x50, 0x26, 0x56, 0x4b, 0x55, 0xe6, 0x07, 0xcd, 0x7f, 0x3c, 0x3e, 0x26, 0x7b, 0x0b, 0xd0, 0xf3,
+	0x0a, 0x98, 0x46, 0xb4, 0x74, 0xc7, 0x55, 0xca, 0x49, 0x40, 0x39, 0xa2, 0x8d, 0x8f, 0x08, 0xb1,
+	0x68, 0x8b, 0x8a, 0x19, 0x79, 0x05, 0xb4, 0xe7, 0x83, 0xf4, 0x3d, 0x99, 0xc8, 0x2b, 0x70, 0xf7,
+	0xc3, 0x0f, 0x30, 0x7b, 0x59, 0x03, 0xbd, 0x16, 0xee, 0x07, 0xf4, 0xf6, 0xb2, 0x86, 0xf8, 0x15,
+	0x39, 0xe0, 0xa8, 0x2c, 0x28, 0xcb, 0x40, 0x71, 0x14, 0x52, 0x95, 0x74, 0x77, 0x14, 0x8d, 0x87,
+	0x4f, 0xee, 0xa5, 0x7f, 0x46, 0x98, 0x9e, 0x07, 0xe7, 0xe5, 0x56, 0xc9, 0xf7, 0xf9, 0xbf, 0xc0,
+	0x35, 0xe2, 0xb3, 0xa5, 0x9a, 0x33, 0x8e, 0x4b, 0x65, 0xe9, 0x75, 0x1f, 0x84, 0x78, 0x74, 0xee,
+	0x48, 0xfc, 0x80, 0xdc, 0xd4, 0xc0, 0x41, 0x36, 0x20, 0x42, 0xd6, 0x1b, 0x5e, 0x19, 0xb4, 0xd0,
+	0xc7, 0x3d, 0x21, 0x83, 0x69, 0x85, 0x7c, 0xce, 0x66, 0x20, 0xcb, 0x99, 0xa5, 0x7d, 0xef, 0xec,
+	0x79, 0xf6, 0xda, 0xa3, 0xf8, 0x11, 0xd9, 0x17, 0x50, 0x88, 0x4a, 0x2a, 0x68, 0x2d, 0xe2, 0xad,
+	0x61, 0x8b, 0x83, 0xf8, 0xac, 0xf7, 0xeb, 0xf3, 0x71, 0x74, 0xf6, 0xfc, 0xdb, 0x3a, 0x89, 0x56,
+	0xeb, 0x24, 0xfa, 0xb9, 0x4e, 0xa2, 0x4f, 0x9b, 0xa4, 0xb3, 0xda, 0x24, 0x9d, 0x1f, 0x9b, 0xa4,
+	0xf3, 0xe1, 0x61, 0x29, 0xed, 0x6c, 0x39, 0x4d, 0x39, 0x2e, 0xb2, 0x0b, 0xc0, 0xc5, 0x04, 0x94,
+	0x81, 0xec, 0x0d, 0x4e, 0xb2, 0x8f, 0x7e, 0xf7, 0x6e, 0x60, 0x66, 0xba, 0xeb, 0x17, 0xff, 0xf4,
+	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x7f, 0x6a, 0xd6, 0x69, 0x02, 0x00, 0x00,
+}
+
+func (this *RecordUpload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordUpload)
+	if !ok {
+		that2, ok := that.(RecordUpload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.MerkleRoot != that1.MerkleRoot {
+		return false
+	}
+	if this.TotalSize != that1.TotalSize {
+		return false
+	}
+	if this.RecordType != that1.RecordType {
+		return false
+	}
+	if this.ContentEncoding != that1.ContentEncoding {
+		return false
+	}
+	if this.ChunkCount != that1.ChunkCount {
+		return false
+	}
+	if this.ReceivedSize != that1.ReceivedSize {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	return true
+}
+func (m *RecordUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReceivedSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContentEncoding != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.ContentEncoding))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpload(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintUpload(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUpload(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovUpload(uint64(m.TotalSize))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovUpload(uint64(l))
+	}
+	if m.ContentEncoding != 0 {
+		n += 1 + sovUpload(uint64(m.ContentEncoding))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovUpload(uint64(m.ChunkCount))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovUpload(uint64(m.ReceivedSize))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovUpload(uint64(m.BlockHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovUpload(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func sovUpload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpload(x uint64) (n int) {
+	return sovUpload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecordUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			m.ContentEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentEncoding |= ContentEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpload = fmt.Errorf("proto: unexpected end of group")
+)